@@ -0,0 +1,154 @@
+package tempemail
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+/*
+ * 结构化地址
+ * Email.From / Email.To 保留渠道返回的原始字符串（兼容旧版本），归一化层额外解析出
+ * FromAddress、ToAddresses、Cc、ReplyTo：优先取渠道提供的原始邮件头（Email.Headers 来源），
+ * 其次取渠道返回的结构化字段（{name, address} 对象或数组），最后解析 "Name <a@b>" 形式的字符串。
+ */
+
+/* Address 邮件地址及显示名称 */
+type Address struct {
+	/* 显示名称，可能为空 */
+	Name string `json:"name,omitempty"`
+	/* 邮箱地址 */
+	Address string `json:"address"`
+}
+
+/* String 格式化为 "Name <address>"，无显示名称时只返回地址 */
+func (a Address) String() string {
+	if a.Name == "" {
+		return a.Address
+	}
+	return a.Name + " <" + a.Address + ">"
+}
+
+/* addrEmailRe 从不规范的地址字符串中提取邮箱 */
+var addrEmailRe = regexp.MustCompile(`[A-Za-z0-9._%+\-=]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+/*
+ * parseAddressList 解析地址字符串："Name <a@b>, c@d"；
+ * 不符合 RFC 5322 时退回按分隔符拆分并提取邮箱，尽量保留尖括号前的名称
+ */
+func parseAddressList(s string) []Address {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	if list, err := mail.ParseAddressList(s); err == nil {
+		out := make([]Address, 0, len(list))
+		for _, a := range list {
+			out = append(out, Address{Name: a.Name, Address: a.Address})
+		}
+		return out
+	}
+	var out []Address
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' }) {
+		addr := addrEmailRe.FindString(part)
+		if addr == "" {
+			continue
+		}
+		name := ""
+		if lt := strings.Index(part, "<"); lt > 0 {
+			name = strings.Trim(strings.TrimSpace(part[:lt]), `"'`)
+		}
+		out = append(out, Address{Name: name, Address: addr})
+	}
+	return out
+}
+
+/*
+ * parseAddressValue 解析渠道返回的地址值：字符串、{name, address} 对象、
+ * mailparser 风格的 {value: [...]} 对象或上述类型的数组
+ */
+func parseAddressValue(v interface{}) []Address {
+	switch val := v.(type) {
+	case string:
+		return parseAddressList(val)
+	case []string:
+		var out []Address
+		for _, s := range val {
+			out = append(out, parseAddressList(s)...)
+		}
+		return out
+	case []interface{}:
+		var out []Address
+		for _, item := range val {
+			out = append(out, parseAddressValue(item)...)
+		}
+		return out
+	case map[string]interface{}:
+		if inner, ok := val["value"]; ok {
+			return parseAddressValue(inner)
+		}
+		addr := getStr(val, "address", "email", "addr")
+		if addr == "" {
+			return nil
+		}
+		return []Address{{Name: getStr(val, "name", "display_name", "displayName"), Address: addr}}
+	}
+	return nil
+}
+
+/* headerValue 在原始邮件头（raw["headers"]）中不区分大小写地查找 key */
+func headerValue(raw map[string]interface{}, key string) interface{} {
+	switch h := raw["headers"].(type) {
+	case map[string]interface{}:
+		for k, v := range h {
+			if strings.EqualFold(k, key) {
+				return v
+			}
+		}
+	case map[string][]string:
+		for k, v := range h {
+			if strings.EqualFold(k, key) {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+/* firstAddresses 依次尝试候选值，返回第一个能解析出地址的结果 */
+func firstAddresses(candidates ...interface{}) []Address {
+	for _, c := range candidates {
+		if c == nil {
+			continue
+		}
+		if list := parseAddressValue(c); len(list) > 0 {
+			return list
+		}
+	}
+	return nil
+}
+
+/* normalizeAddresses 为归一化后的邮件填充结构化地址字段 */
+func normalizeAddresses(e *Email, raw map[string]interface{}) {
+	if from := firstAddresses(headerValue(raw, "from"), raw["header_from"], raw["from"], e.From); len(from) > 0 {
+		e.FromAddress = from[0]
+		if e.FromAddress.Name == "" {
+			e.FromAddress.Name = getStr(raw, "from_name", "fromName", "sender_name")
+		}
+	}
+	e.ToAddresses = firstAddresses(headerValue(raw, "to"), raw["to_list"], raw["to"], e.To)
+	e.Cc = firstAddresses(headerValue(raw, "cc"), raw["cc"])
+	e.ReplyTo = firstAddresses(headerValue(raw, "reply-to"), raw["reply_to"], raw["replyTo"])
+}
+
+/* fillAddresses 渠道直接构造 Email 时，由 From / To 字符串补全结构化地址 */
+func fillAddresses(e *Email) {
+	if e.FromAddress.Address == "" {
+		if from := parseAddressList(e.From); len(from) > 0 {
+			e.FromAddress = from[0]
+		}
+	}
+	if len(e.ToAddresses) == 0 {
+		e.ToAddresses = parseAddressList(e.To)
+	}
+}
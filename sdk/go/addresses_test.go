@@ -0,0 +1,48 @@
+package tempemail
+
+import (
+	"testing"
+
+	"github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
+)
+
+/*
+ * TestStructuredAddresses 校验结构化地址：原始邮件头优先、{name, address} 对象与
+ * mailparser {value: [...]} 对象、"Name <a@b>" 字符串与不规范字符串的解析，
+ * 以及渠道直接构造 NormEmail 时由 From / To 字符串补全
+ */
+func TestStructuredAddresses(t *testing.T) {
+	e := normalizeRawEmail(map[string]interface{}{
+		"from":      "a@x.com",
+		"from_name": "Alice",
+		"to_list": []interface{}{
+			map[string]interface{}{"name": "Bob", "address": "b@x.com"},
+			map[string]interface{}{"address": "c@x.com"},
+		},
+		"cc":       map[string]interface{}{"value": []interface{}{map[string]interface{}{"address": "d@x.com", "name": "Dan"}}},
+		"reply_to": `"Support Team" <help@x.com>`,
+	}, "me@x.com")
+	if e.From != "a@x.com" || e.FromAddress != (Address{Name: "Alice", Address: "a@x.com"}) {
+		t.Fatalf("From: %q %+v", e.From, e.FromAddress)
+	}
+	if len(e.ToAddresses) != 2 || e.ToAddresses[0].String() != "Bob <b@x.com>" || e.To != "me@x.com" {
+		t.Fatalf("To: %q %+v", e.To, e.ToAddresses)
+	}
+	if len(e.Cc) != 1 || e.Cc[0].Name != "Dan" || len(e.ReplyTo) != 1 || e.ReplyTo[0].Name != "Support Team" {
+		t.Fatalf("Cc / ReplyTo: %+v %+v", e.Cc, e.ReplyTo)
+	}
+
+	e = normalizeRawEmail(map[string]interface{}{
+		"from":    "ignored@x.com",
+		"headers": map[string][]string{"From": {"Carol <carol@x.com>"}},
+		"to":      "Team: x@x.com; y@x.com",
+	}, "me@x.com")
+	if e.FromAddress.Address != "carol@x.com" || len(e.ToAddresses) != 2 {
+		t.Fatalf("邮件头优先 / 不规范收件人: %+v %+v", e.FromAddress, e.ToAddresses)
+	}
+
+	got := normToEmail(provider.NormEmail{From: "Eve <eve@x.com>", To: "me@x.com"})
+	if got.FromAddress.Name != "Eve" || len(got.ToAddresses) != 1 || got.ToAddresses[0].Address != "me@x.com" {
+		t.Fatalf("补全结构化地址: %+v %+v", got.FromAddress, got.ToAddresses)
+	}
+}
@@ -0,0 +1,67 @@
+package tempemail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	prov "github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
+)
+
+/*
+ * 附件下载
+ * 许多附件地址需要渠道的会话凭据（mail.tm / duckmail 的 Bearer Token、freecustom 的匿名 JWT 等），
+ * 直接 GET 会失败。DownloadAttachment 使用与收信相同的 TLS 指纹客户端、代理与凭据下载；
+ * 附件已内嵌内容（EmailAttachment.Content，如 anonbox）时直接返回，不发请求。
+ * emailnator 等渠道的接口只返回渲染后的 HTML 正文、不提供附件元数据，不解析附件，
+ * 也不声明 CapAttachments，不在附件下载的支持范围内。
+ *
+ * 示例:
+ *   for _, att := range email.Attachments {
+ *       rc, err := DownloadAttachment(ctx, info, att)
+ *       if err != nil { continue }
+ *       data, _ := io.ReadAll(rc)
+ *       rc.Close()
+ *   }
+ */
+
+/*
+ * DownloadAttachment 下载附件，调用方负责关闭返回的 ReadCloser
+ * 读取过程受 ctx 控制；建立连接阶段的网络错误与 5xx 按 RetryOptions 默认值重试。
+ * 附件既无内容也无 URL 时返回 ErrUnsupported
+ */
+func DownloadAttachment(ctx context.Context, info *EmailInfo, att EmailAttachment) (io.ReadCloser, error) {
+	return instanceOf(info).downloadAttachment(ctx, info, att)
+}
+
+func (in *instance) downloadAttachment(ctx context.Context, info *EmailInfo, att EmailAttachment) (io.ReadCloser, error) {
+	spec, err := specFor(info)
+	if err != nil {
+		return nil, err
+	}
+	if len(att.Content) > 0 {
+		return io.NopCloser(bytes.NewReader(att.Content)), nil
+	}
+	if att.URL == "" {
+		return nil, fmt.Errorf("附件 %q 没有下载地址：%w", att.Filename, ErrUnsupported)
+	}
+
+	ctx = withInstance(ctx, in)
+	rc, _, err := withRetryAndAttempts(ctx, func() (io.ReadCloser, error) {
+		if spec.DownloadAttachment != nil {
+			return spec.DownloadAttachment(ctx, info.Email, info.token, att)
+		}
+		return prov.DownloadAttachmentURL(ctx, att.URL, nil)
+	}, nil)
+	if err != nil {
+		in.log().Warn("下载附件失败", "channel", string(info.Channel), "filename", att.Filename, "error", err.Error())
+		return nil, fmt.Errorf("下载附件失败：%w", err)
+	}
+	return rc, nil
+}
+
+/* DownloadAttachment 下载该邮箱中的附件，见 DownloadAttachment */
+func (m *Mailbox) DownloadAttachment(ctx context.Context, att EmailAttachment) (io.ReadCloser, error) {
+	return DownloadAttachment(ctx, m.info, att)
+}
@@ -0,0 +1,63 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+/*
+ * TestDownloadAttachment 校验附件下载：内嵌内容直接返回、渠道下载实现收到会话令牌、
+ * 既无内容也无 URL 时返回 ErrUnsupported；仅标明 base64 编码的内嵌内容经归一化后解码
+ */
+func TestDownloadAttachment(t *testing.T) {
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-attach",
+		DownloadAttachment: func(ctx context.Context, email, token string, att EmailAttachment) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(token + ":" + att.URL)), nil
+		},
+	})
+
+	ctx := context.Background()
+	m := newOfflineClient().AddMailbox(&EmailInfo{Channel: "test-attach", Email: "a@x", token: "tok"}, nil)
+	read := func(att EmailAttachment) string {
+		rc, err := m.DownloadAttachment(ctx, att)
+		if err != nil {
+			t.Fatalf("DownloadAttachment(%+v): %v", att, err)
+		}
+		defer rc.Close()
+		data, _ := io.ReadAll(rc)
+		return string(data)
+	}
+	if got := read(EmailAttachment{URL: "https://x/a.pdf"}); got != "tok:https://x/a.pdf" {
+		t.Fatalf("渠道下载应携带令牌，实际 %q", got)
+	}
+	if got := read(EmailAttachment{URL: "https://x/a.pdf", Content: []byte("inline")}); got != "inline" {
+		t.Fatalf("应优先返回内嵌内容，实际 %q", got)
+	}
+	if _, err := m.DownloadAttachment(ctx, EmailAttachment{Filename: "a.pdf"}); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("期望 ErrUnsupported，实际 %v", err)
+	}
+
+	atts := normalizeAttachments(map[string]interface{}{
+		"attachments": []interface{}{
+			map[string]interface{}{"name": "b.txt", "content": "aGVsbG8=", "encoding": "base64"},
+			map[string]interface{}{"name": "c.txt", "content": "aGVsbG8=", "base64": true},
+			/* 未标明编码：恰好可按 base64 解码的原文不应被解码 */
+			map[string]interface{}{"name": "d.txt", "content": "abcd"},
+		},
+	})
+	if len(atts) != 3 {
+		t.Fatalf("期望 3 个附件，实际 %+v", atts)
+	}
+	for _, att := range atts[:2] {
+		if string(att.Content) != "hello" || att.Size != 5 {
+			t.Fatalf("标明 base64 的内嵌内容未解码: %+v", att)
+		}
+	}
+	if atts[2].Content != nil {
+		t.Fatalf("未标明编码的内容不应解码: %+v", atts[2])
+	}
+}
@@ -1,116 +1,342 @@
 package tempemail
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 )
 
-var backendGroups = map[string][]Channel{
-	"mailinator": {
-		ChannelMailinator,
-		"sogetthis-com", "bobmail-info", "suremail-info", "binkmail-com",
-		"veryrealemail-com", "chammy-info", "thisisnotmyrealemail-com",
-		"notmailinator-com", "spamhereplease-com", "sendspamhere-com",
-		"sendfree-org",
-	},
-	"getnada": {
-		ChannelGetnada,
-		"getnada-com", "getnada-email", "getnada-net",
-	},
-	"guerrillamail": {
-		ChannelGuerrillaMail,
-		"sharklasers",
-	},
-	"moakt": {
-		ChannelMoakt,
-		"drmail-in", "teml-net", "tmpeml-com", "tmpbox-net",
-		"moakt-cc", "disbox-net", "tmpmail-org", "tmpmail-net",
-		"tmails-net", "disbox-org", "moakt-co", "moakt-ws",
-		"tmail-ws", "bareed-ws",
-	},
-	"catchmail": {
-		ChannelCatchmail,
-		"catchmail-mailistry", "catchmail-zeppost",
-	},
-	"mailforspam": {
-		ChannelMailforspam,
-		"mailforspam-tempmail-io", "mailforspam-disposable",
-	},
-	"tempmail-plus": {
-		ChannelTempmailPlus,
-		"fexpost-com", "fexbox-org", "mailbox-in-ua",
-		"rover-info", "chitthi-in", "fextemp-com",
-		"any-pink", "merepost-com",
-	},
-	"mail-cx": {
-		ChannelMailCx,
-		"ddker-com",
-	},
-	"fake-legal": {
-		ChannelFakeLegal,
-		"imgui-de", "pulsewebmenu-de",
-	},
-	"neighbours": {
-		"neighbours-sh", "neighbours",
-	},
-}
-
-var channelToBackend map[Channel]string
-
-func init() {
-	channelToBackend = make(map[Channel]string)
-	for backend, channels := range backendGroups {
-		for _, ch := range channels {
-			channelToBackend[ch] = backend
-		}
+/*
+ * channelToBackend 声明了 ChannelSpec.Backend 的渠道到后端的映射，由 registerChannel 填充；
+ * 其中的渠道在 GenerateEmail 中按后端去重（同一后端失败后跳过其余渠道），失败时自动熔断
+ */
+var channelToBackend = map[Channel]string{}
+
+/* backendOf 渠道所属后端；未声明 Backend 的渠道自成一个后端 */
+func backendOf(ch Channel) string {
+	if b := channelToBackend[ch]; b != "" {
+		return b
 	}
+	return string(ch)
+}
+
+/*
+ * 后端熔断
+ * 每个实例按后端（见 backendOf，与 ListChannels 报告的 Backend 一致）维护熔断状态：
+ * 声明了 ChannelSpec.Backend 的后端记录连续失败并自动熔断（同一后端的渠道共享服务端，一个失败通常意味着全部失败）；
+ * 未声明后端的独立渠道自成一个后端，closed 时失败不自动熔断（由健康度排序处理），可手动熔断：
+ *   - closed：正常放行
+ *   - open：创建邮箱或收信（重试耗尽后）失败即熔断（独立渠道除外），或由 TripBackend 手动熔断；
+ *     冷却期内 GenerateEmail 跳过该后端的所有渠道，GetEmails 不发请求、直接返回 *ErrBackendOpen；冷却期从 SDKConfig.BreakerCooldown（默认 60 秒）起，
+ *     每次连续失败翻倍，上限 SDKConfig.BreakerMaxCooldown（默认 5 分钟）
+ *   - half_open：冷却期结束后只放行一个试探请求，成功则恢复 closed，失败则重新熔断并延长冷却期
+ * 邮箱过期、令牌无效、渠道不支持与调用方取消不计为后端失败。
+ * BackendStatus 查看各后端状态，TripBackend / ResetBackend 手动熔断与恢复。
+ *
+ * 示例:
+ *   tempemail.TripBackend("mailinator", 10*time.Minute) // 维护期间不再使用
+ *   for _, s := range tempemail.BackendStatus() {
+ *       if s.State != tempemail.CircuitClosed {
+ *           fmt.Println(s.Backend, s.State, s.FailCount, s.ReopenAt)
+ *       }
+ *   }
+ */
+
+/* CircuitState 后端熔断状态 */
+type CircuitState string
+
+const (
+	/* 正常放行 */
+	CircuitClosed CircuitState = "closed"
+	/* 熔断中，冷却期内不放行 */
+	CircuitOpen CircuitState = "open"
+	/* 冷却期已过，等待或正在进行试探请求 */
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+const (
+	/* defaultCooldown 首次熔断的默认冷却期 */
+	defaultCooldown = 60 * time.Second
+	/* maxCooldown 连续失败时冷却期的默认上限 */
+	maxCooldown = 5 * time.Minute
+)
+
+/* BackendCircuit 单个后端的熔断状态 */
+type BackendCircuit struct {
+	/* 后端标识 */
+	Backend string `json:"backend"`
+	/* 属于该后端的渠道 */
+	Channels []Channel `json:"channels"`
+	/* 熔断状态 */
+	State CircuitState `json:"state"`
+	/* 连续失败次数 */
+	FailCount int `json:"failCount"`
+	/* 最近一次失败时间 */
+	LastFailure time.Time `json:"lastFailure,omitempty"`
+	/* 熔断中时冷却期结束（转为 half_open）的时间 */
+	ReopenAt time.Time `json:"reopenAt,omitempty"`
+	/* 是否由 TripBackend 手动熔断 */
+	Manual bool `json:"manual,omitempty"`
 }
 
 type circuitState struct {
-	lastFailure time.Time
 	failCount   int
+	lastFailure time.Time
+	openUntil   time.Time
+	manual      bool
+	probing     bool      /* half_open 试探请求进行中 */
+	probeStart  time.Time /* 试探请求开始时间，超过一个冷却期未回报视为丢失 */
 }
 
-var (
-	circuitMu  sync.Mutex
-	circuitMap = make(map[string]*circuitState)
-)
+/* circuitBreaker 按后端熔断；每个实例独立持有，并发安全 */
+type circuitBreaker struct {
+	mu    sync.Mutex
+	state map[string]*circuitState
+}
+
+/* breakerCooldowns 冷却期配置，未设置时使用默认值 */
+func breakerCooldowns(cfg SDKConfig) (base, limit time.Duration) {
+	base, limit = cfg.BreakerCooldown, cfg.BreakerMaxCooldown
+	if base <= 0 {
+		base = defaultCooldown
+	}
+	if limit <= 0 {
+		limit = maxCooldown
+	}
+	if limit < base {
+		limit = base
+	}
+	return base, limit
+}
 
-const defaultCooldown = 60 * time.Second
-const maxCooldown = 5 * time.Minute
+/* cooldownFor 第 failCount 次连续失败后的冷却期：base × 2^(failCount-1)，不超过 limit */
+func cooldownFor(failCount int, base, limit time.Duration) time.Duration {
+	d := base
+	for i := 1; i < failCount && d < limit; i++ {
+		d *= 2
+	}
+	return min(d, limit)
+}
+
+/*
+ * allow 判断是否放行该后端的请求；不放行时返回建议的等待时长
+ * 冷却期结束后只放行一个试探请求，试探超过 probeTimeout 未回报时再放行下一个
+ */
+func (b *circuitBreaker) allow(backend string, now time.Time, probeTimeout time.Duration) (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.state[backend]
+	if !ok || s.openUntil.IsZero() {
+		return true, 0
+	}
+	if now.Before(s.openUntil) {
+		return false, s.openUntil.Sub(now)
+	}
+	if s.probing && now.Sub(s.probeStart) < probeTimeout {
+		return false, probeTimeout - now.Sub(s.probeStart)
+	}
+	s.probing = true
+	s.probeStart = now
+	return true, 0
+}
+
+/* release 放弃已放行但未得出结果的试探请求（如被取消），允许下一个请求试探 */
+func (b *circuitBreaker) release(backend string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if s, ok := b.state[backend]; ok {
+		s.probing = false
+	}
+}
 
-func isBackendOpen(backend string) bool {
-	circuitMu.Lock()
-	defer circuitMu.Unlock()
-	state, ok := circuitMap[backend]
+/* recordFailure 记录一次失败；熔断中途返回的迟到失败只更新时间，不延长冷却期 */
+func (b *circuitBreaker) recordFailure(backend string, now time.Time, base, limit time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == nil {
+		b.state = make(map[string]*circuitState)
+	}
+	s, ok := b.state[backend]
 	if !ok {
-		return true
+		s = &circuitState{}
+		b.state[backend] = s
 	}
-	cooldown := defaultCooldown * time.Duration(1<<(state.failCount-1))
-	if cooldown > maxCooldown {
-		cooldown = maxCooldown
+	s.lastFailure = now
+	if now.Before(s.openUntil) {
+		return
 	}
-	if time.Since(state.lastFailure) >= cooldown {
-		delete(circuitMap, backend)
-		return true
+	s.failCount++
+	s.openUntil = now.Add(cooldownFor(s.failCount, base, limit))
+	s.manual = false
+	s.probing = false
+}
+
+/* recordSuccess 记录一次成功并恢复 closed；手动熔断在到期前不受迟到的成功影响 */
+func (b *circuitBreaker) recordSuccess(backend string, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if s, ok := b.state[backend]; ok && s.manual && now.Before(s.openUntil) {
+		return
 	}
-	return false
+	delete(b.state, backend)
 }
 
-func recordBackendFailure(backend string) {
-	circuitMu.Lock()
-	defer circuitMu.Unlock()
-	state, ok := circuitMap[backend]
-	if ok {
-		state.lastFailure = time.Now()
-		state.failCount++
-	} else {
-		circuitMap[backend] = &circuitState{lastFailure: time.Now(), failCount: 1}
+/* trip 手动熔断到 until */
+func (b *circuitBreaker) trip(backend string, until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == nil {
+		b.state = make(map[string]*circuitState)
 	}
+	s, ok := b.state[backend]
+	if !ok {
+		s = &circuitState{}
+		b.state[backend] = s
+	}
+	s.openUntil = until
+	s.manual = true
+	s.probing = false
+}
+
+/* tripped 后端是否有熔断记录（熔断中、half_open 或手动熔断过） */
+func (b *circuitBreaker) tripped(backend string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.state[backend]
+	return ok && !s.openUntil.IsZero()
+}
+
+func (b *circuitBreaker) reset(backend string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.state, backend)
+}
+
+/* status 单个后端的熔断状态（不含 Channels） */
+func (b *circuitBreaker) status(backend string, now time.Time) BackendCircuit {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := BackendCircuit{Backend: backend, State: CircuitClosed}
+	s, ok := b.state[backend]
+	if !ok {
+		return c
+	}
+	c.FailCount = s.failCount
+	c.LastFailure = s.lastFailure
+	c.Manual = s.manual
+	switch {
+	case s.openUntil.IsZero():
+	case now.Before(s.openUntil):
+		c.State = CircuitOpen
+		c.ReopenAt = s.openUntil
+	default:
+		c.State = CircuitHalfOpen
+	}
+	return c
+}
+
+/* backendChannels 全部后端到其渠道的映射，渠道按注册顺序；独立渠道自成一个后端 */
+func backendChannels() map[string][]Channel {
+	out := make(map[string][]Channel)
+	for _, ch := range allChannels {
+		b := backendOf(ch)
+		out[b] = append(out[b], ch)
+	}
+	return out
+}
+
+/* backendAllowed 按实例配置判断是否放行该后端 */
+func (in *instance) backendAllowed(backend string) (bool, time.Duration) {
+	base, _ := breakerCooldowns(in.getConfig())
+	return in.breaker.allow(backend, time.Now(), base)
+}
+
+/*
+ * recordBackendResult 将渠道一次创建或收信的结果计入其后端的熔断；调用方取消与邮箱自身的问题不计为失败。
+ * 独立渠道的失败不会自动熔断 closed 的后端，但已熔断（如 TripBackend 后的 half_open 试探失败）时重新熔断
+ */
+func (in *instance) recordBackendResult(ch Channel, err error) {
+	backend := backendOf(ch)
+	if err == nil {
+		in.breaker.recordSuccess(backend, time.Now())
+		return
+	}
+	if errors.Is(err, context.Canceled) || isMailboxGone(err) || errors.Is(err, ErrUnsupported) ||
+		channelToBackend[ch] == "" && !in.breaker.tripped(backend) {
+		in.breaker.release(backend)
+		return
+	}
+	base, limit := breakerCooldowns(in.getConfig())
+	in.breaker.recordFailure(backend, time.Now(), base, limit)
+	in.log().Debug("后端失败，进入熔断", "backend", backend, "error", err.Error())
+}
+
+func (in *instance) backendStatus() []BackendCircuit {
+	now := time.Now()
+	groups := backendChannels()
+	out := make([]BackendCircuit, 0, len(groups))
+	for backend, chs := range groups {
+		c := in.breaker.status(backend, now)
+		c.Channels = chs
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Backend < out[j].Backend })
+	return out
+}
+
+func (in *instance) tripBackend(backend string, d time.Duration) error {
+	if _, ok := backendChannels()[backend]; !ok {
+		return fmt.Errorf("unknown backend: %s", backend)
+	}
+	if d <= 0 {
+		d, _ = breakerCooldowns(in.getConfig())
+	}
+	in.breaker.trip(backend, time.Now().Add(d))
+	in.log().Info("手动熔断后端", "backend", backend, "duration", d.String())
+	return nil
+}
+
+func (in *instance) resetBackend(backend string) error {
+	if _, ok := backendChannels()[backend]; !ok {
+		return fmt.Errorf("unknown backend: %s", backend)
+	}
+	in.breaker.reset(backend)
+	in.log().Info("恢复后端", "backend", backend)
+	return nil
+}
+
+/* BackendStatus 默认实例中全部后端（含独立渠道）的状态，按后端标识排序 */
+func BackendStatus() []BackendCircuit {
+	return defaultInstance.backendStatus()
+}
+
+/* BackendStatus 该客户端所属实例的后端熔断状态，见 BackendStatus */
+func (c *Client) BackendStatus() []BackendCircuit {
+	return c.instance().backendStatus()
+}
+
+/*
+ * TripBackend 手动熔断后端 d 时长（d <= 0 使用 SDKConfig.BreakerCooldown），到期后转为 half_open 试探；
+ * backend 为 BackendStatus / ListChannels 中的后端标识，独立渠道即其渠道标识
+ */
+func TripBackend(backend string, d time.Duration) error {
+	return defaultInstance.tripBackend(backend, d)
+}
+
+/* TripBackend 在该客户端所属实例上手动熔断后端，见 TripBackend */
+func (c *Client) TripBackend(backend string, d time.Duration) error {
+	return c.instance().tripBackend(backend, d)
+}
+
+/* ResetBackend 立即恢复后端为 closed 并清零失败计数 */
+func ResetBackend(backend string) error {
+	return defaultInstance.resetBackend(backend)
 }
 
-func recordBackendSuccess(backend string) {
-	circuitMu.Lock()
-	defer circuitMu.Unlock()
-	delete(circuitMap, backend)
+/* ResetBackend 在该客户端所属实例上恢复后端，见 ResetBackend */
+func (c *Client) ResetBackend(backend string) error {
+	return c.instance().resetBackend(backend)
 }
@@ -0,0 +1,389 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/*
+ * TestChannelBackends 校验后端归属由渠道注册声明：镜像与固定域名变体归入同一后端、
+ * 未声明的渠道自成一个后端，ListChannels 报告的后端与熔断使用的一致
+ */
+func TestChannelBackends(t *testing.T) {
+	same := map[string][]Channel{
+		"guerrillamail": {ChannelGuerrillaMail, ChannelSharklasers, ChannelGrrLa, ChannelGuerrillamailInfo},
+		"getnada":       {ChannelGetnada, ChannelAbematvCom, ChannelRiauNet},
+		"10minute-one":  {Channel10minuteOne, ChannelXghffCom},
+		"mail-tm":       {ChannelMailTm, ChannelWebLibraryNet},
+	}
+	for backend, channels := range same {
+		for _, ch := range channels {
+			info, ok := GetChannelInfo(ch)
+			if !ok || info.Backend != backend || backendOf(ch) != backend {
+				t.Fatalf("%s: 期望后端 %s，实际 %q", ch, backend, info.Backend)
+			}
+		}
+	}
+	if info, _ := GetChannelInfo(ChannelDropmail); info.Backend != string(ChannelDropmail) {
+		t.Fatalf("未声明后端的渠道应自成一个后端，实际 %q", info.Backend)
+	}
+	for _, info := range ListChannels() {
+		if info.Backend == "" {
+			t.Fatalf("%s: ListChannels 未报告后端", info.Channel)
+		}
+	}
+	/* 共用同一 provider 根函数（同一服务端）的渠道必须声明同一后端 */
+	for root, channels := range sharedProviderGroups(t) {
+		for _, ch := range channels[1:] {
+			if backendOf(ch) != backendOf(channels[0]) {
+				t.Errorf("%s: %s 的后端 %q 与 %s 的 %q 不一致", root, ch, backendOf(ch), channels[0], backendOf(channels[0]))
+			}
+		}
+	}
+}
+
+/* TestCircuitBreakerHalfOpen 校验冷却期翻倍与上限、迟到失败不延长冷却期、half_open 只放行一个试探请求 */
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	var b circuitBreaker
+	base, limit := 10*time.Second, 25*time.Second
+	t0 := time.Now()
+
+	b.recordFailure("x", t0, base, limit)
+	b.recordFailure("x", t0.Add(time.Second), base, limit) /* 熔断中的迟到失败 */
+	if ok, wait := b.allow("x", t0.Add(5*time.Second), base); ok || wait != 5*time.Second {
+		t.Fatalf("冷却期内应拒绝并剩余 5s，实际 %v %v", ok, wait)
+	}
+	if s := b.status("x", t0.Add(5*time.Second)); s.State != CircuitOpen || s.FailCount != 1 || !s.ReopenAt.Equal(t0.Add(base)) {
+		t.Fatalf("状态不符: %+v", s)
+	}
+
+	t1 := t0.Add(base)
+	if s := b.status("x", t1); s.State != CircuitHalfOpen {
+		t.Fatalf("冷却期结束应为 half_open，实际 %s", s.State)
+	}
+	if ok, _ := b.allow("x", t1, base); !ok {
+		t.Fatal("half_open 应放行一个试探请求")
+	}
+	if ok, _ := b.allow("x", t1, base); ok {
+		t.Fatal("试探进行中不应放行第二个请求")
+	}
+	b.recordFailure("x", t1, base, limit)
+	if ok, wait := b.allow("x", t1, base); ok || wait != 2*base {
+		t.Fatalf("试探失败后冷却期应翻倍为 20s，实际 %v %v", ok, wait)
+	}
+
+	t2 := t1.Add(2 * base)
+	b.allow("x", t2, base)
+	b.recordFailure("x", t2, base, limit)
+	if _, wait := b.allow("x", t2, base); wait != limit {
+		t.Fatalf("冷却期不应超过上限 25s，实际 %v", wait)
+	}
+
+	t3 := t2.Add(limit)
+	b.allow("x", t3, base)
+	b.release("x")
+	if ok, _ := b.allow("x", t3, base); !ok {
+		t.Fatal("释放试探名额后应再次放行")
+	}
+	b.recordSuccess("x", t3)
+	if s := b.status("x", t3); s.State != CircuitClosed || s.FailCount != 0 {
+		t.Fatalf("试探成功应恢复 closed，实际 %+v", s)
+	}
+}
+
+/*
+ * TestBackendTripResetOnGetEmails 校验 GetEmails 受后端熔断控制：手动熔断时不发请求并返回 *ErrBackendOpen，
+ * 邮箱自身失效不计入熔断，后端失败后熔断，ResetBackend 立即恢复
+ */
+func TestBackendTripResetOnGetEmails(t *testing.T) {
+	const ch Channel = "test-breaker"
+	const backend = "test-breaker-backend"
+	var calls atomic.Int32
+	var fail atomic.Value
+	registerFakeChannel(t, ChannelSpec{
+		Channel: ch,
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			calls.Add(1)
+			if err, _ := fail.Load().(error); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		},
+	})
+	channelToBackend[ch] = backend
+	savedChannels := allChannels
+	allChannels = append(append([]Channel(nil), allChannels...), ch)
+	t.Cleanup(func() {
+		delete(channelToBackend, ch)
+		allChannels = savedChannels
+	})
+
+	client := newOfflineClient()
+	in := client.instance()
+	info := &EmailInfo{Channel: ch, Email: "a@test.invalid", inst: in}
+	read := func() *GetEmailsResult {
+		t.Helper()
+		res, err := in.getEmails(context.Background(), info, nil)
+		if err != nil {
+			t.Fatalf("getEmails: %v", err)
+		}
+		return res
+	}
+	status := func() BackendCircuit {
+		for _, s := range client.BackendStatus() {
+			if s.Backend == backend {
+				return s
+			}
+		}
+		t.Fatalf("BackendStatus 缺少 %s", backend)
+		return BackendCircuit{}
+	}
+
+	if err := client.TripBackend(backend, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if s := status(); s.State != CircuitOpen || !s.Manual || len(s.Channels) != 1 || s.Channels[0] != ch {
+		t.Fatalf("手动熔断状态不符: %+v", s)
+	}
+	res := read()
+	var open *ErrBackendOpen
+	if res.Success || !errors.As(res.Err, &open) || open.Backend != backend || !errors.Is(res.Err, ErrChannelUnavailable) {
+		t.Fatalf("熔断中应返回 ErrBackendOpen，实际 %+v", res)
+	}
+	if calls.Load() != 0 {
+		t.Fatal("熔断中不应请求渠道")
+	}
+
+	if err := client.ResetBackend(backend); err != nil {
+		t.Fatal(err)
+	}
+	fail.Store(error(ErrMailboxExpired))
+	if res := read(); res.Success || status().State != CircuitClosed {
+		t.Fatalf("邮箱失效不应触发熔断: %+v", status())
+	}
+	fail.Store(error(ErrCaptchaRequired))
+	read()
+	if s := status(); s.State != CircuitOpen || s.FailCount != 1 {
+		t.Fatalf("后端失败后应熔断: %+v", s)
+	}
+	if err := ResetBackend("no-such-backend"); err == nil {
+		t.Fatal("未知后端应返回错误")
+	}
+}
+
+/*
+ * TestIndependentChannelBackend 校验独立渠道自成一个后端：出现在 BackendStatus 中、可手动熔断与恢复，
+ * 失败不自动熔断（由健康度排序处理）
+ */
+func TestIndependentChannelBackend(t *testing.T) {
+	const ch Channel = "test-independent"
+	var calls atomic.Int32
+	registerFakeChannel(t, ChannelSpec{
+		Channel: ch,
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			calls.Add(1)
+			return nil, ErrCaptchaRequired
+		},
+	})
+	savedChannels := allChannels
+	allChannels = append(append([]Channel(nil), allChannels...), ch)
+	t.Cleanup(func() { allChannels = savedChannels })
+
+	client := newOfflineClient()
+	in := client.instance()
+	info := &EmailInfo{Channel: ch, Email: "a@test.invalid", inst: in}
+
+	listed := map[string]bool{}
+	for _, s := range client.BackendStatus() {
+		listed[s.Backend] = true
+	}
+	for _, c := range ListChannels() {
+		if !listed[c.Backend] {
+			t.Fatalf("%s: ListChannels 报告的后端 %q 不在 BackendStatus 中", c.Channel, c.Backend)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if res, _ := in.getEmails(context.Background(), info, nil); res.Success || errors.Is(res.Err, ErrChannelUnavailable) {
+			t.Fatalf("独立渠道失败不应自动熔断: %+v", res)
+		}
+	}
+	if n := calls.Load(); n < 2 {
+		t.Fatalf("期望每次都发出请求，实际 %d 次", n)
+	}
+
+	if err := client.TripBackend(string(ch), time.Minute); err != nil {
+		t.Fatalf("独立渠道应能手动熔断: %v", err)
+	}
+	before := calls.Load()
+	var open *ErrBackendOpen
+	if res, _ := in.getEmails(context.Background(), info, nil); !errors.As(res.Err, &open) || open.Backend != string(ch) || calls.Load() != before {
+		t.Fatalf("手动熔断中应返回 ErrBackendOpen 且不发请求: %+v", res)
+	}
+	if err := client.ResetBackend(string(ch)); err != nil {
+		t.Fatalf("ResetBackend: %v", err)
+	}
+	if res, _ := in.getEmails(context.Background(), info, nil); errors.As(res.Err, &open) || calls.Load() == before {
+		t.Fatalf("恢复后应重新发出请求: %+v", res)
+	}
+	if err := client.TripBackend(string(ChannelDropmail), 0); err != nil {
+		t.Fatalf("TripBackend(dropmail): %v", err)
+	}
+
+	/* 手动熔断到期后的试探失败应重新熔断，而不是停留在 half_open */
+	if err := client.TripBackend(string(ch), 20*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(30 * time.Millisecond)
+	before = calls.Load()
+	if res, _ := in.getEmails(context.Background(), info, nil); errors.As(res.Err, &open) || calls.Load() == before {
+		t.Fatalf("half_open 应放行试探请求: %+v", res)
+	}
+	for _, s := range client.BackendStatus() {
+		if s.Backend == string(ch) && (s.State != CircuitOpen || s.FailCount != 1) {
+			t.Fatalf("试探失败后应重新熔断: %+v", s)
+		}
+	}
+}
+
+/*
+ * sharedProviderGroups 解析源码，按渠道 Generate / GetEmails 最终调用的 provider 根函数分组：
+ * 只做一层 return 转发的包装函数（如 BSmellyCcGetEmails → MailinatorGetEmails）归到被转发的函数，
+ * 转发到包级实例方法的（如 mjjCmProvider.GetEmails）归到构造该实例的函数
+ */
+func sharedProviderGroups(t *testing.T) map[string][]Channel {
+	t.Helper()
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "provider", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forward := map[string]string{}
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				switch d := decl.(type) {
+				case *ast.FuncDecl:
+					if d.Recv != nil || d.Body == nil || len(d.Body.List) != 1 {
+						continue
+					}
+					ret, ok := d.Body.List[0].(*ast.ReturnStmt)
+					if !ok || len(ret.Results) == 0 {
+						continue
+					}
+					if call, ok := ret.Results[0].(*ast.CallExpr); ok {
+						switch fn := call.Fun.(type) {
+						case *ast.Ident:
+							forward[d.Name.Name] = fn.Name
+						case *ast.SelectorExpr:
+							if x, ok := fn.X.(*ast.Ident); ok {
+								forward[d.Name.Name] = "var:" + x.Name
+							}
+						}
+					}
+				case *ast.GenDecl:
+					for _, spec := range d.Specs {
+						vs, ok := spec.(*ast.ValueSpec)
+						if !ok {
+							continue
+						}
+						for i, name := range vs.Names {
+							if i < len(vs.Values) {
+								if call, ok := vs.Values[i].(*ast.CallExpr); ok {
+									if fn, ok := call.Fun.(*ast.Ident); ok {
+										forward["var:"+name.Name] = fn.Name
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	root := func(name string) string {
+		for i := 0; i < 10; i++ {
+			next, ok := forward[name]
+			if !ok {
+				break
+			}
+			name = next
+		}
+		return name
+	}
+
+	consts := map[string]Channel{}
+	tf, err := parser.ParseFile(fset, "types.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ast.Inspect(tf, func(n ast.Node) bool {
+		if vs, ok := n.(*ast.ValueSpec); ok && len(vs.Values) == 1 {
+			if lit, ok := vs.Values[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				v, _ := strconv.Unquote(lit.Value)
+				consts[vs.Names[0].Name] = Channel(v)
+			}
+		}
+		return true
+	})
+
+	rf, err := parser.ParseFile(fset, "registry_channels.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups := map[string][]Channel{}
+	ast.Inspect(rf, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		if id, ok := lit.Type.(*ast.Ident); !ok || id.Name != "ChannelSpec" {
+			return true
+		}
+		var ch Channel
+		hooks := map[string]ast.Node{}
+		for _, el := range lit.Elts {
+			kv, ok := el.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			key := kv.Key.(*ast.Ident).Name
+			switch key {
+			case "Channel":
+				if id, ok := kv.Value.(*ast.Ident); ok {
+					ch = consts[id.Name]
+				}
+			case "Generate", "GetEmails":
+				hooks[key] = kv.Value
+			}
+		}
+		if ch == "" {
+			return false
+		}
+		for key, hook := range hooks {
+			var fn string
+			ast.Inspect(hook, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok && fn == "" {
+					if x, ok := sel.X.(*ast.Ident); ok && x.Name == "prov" && strings.HasPrefix(sel.Sel.Name, strings.ToUpper(sel.Sel.Name[:1])) {
+						fn = sel.Sel.Name
+					}
+				}
+				return fn == ""
+			})
+			if fn != "" {
+				r := key + ":" + root(fn)
+				groups[r] = append(groups[r], ch)
+			}
+		}
+		return false
+	})
+	return groups
+}
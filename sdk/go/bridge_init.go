@@ -1,23 +1,39 @@
 package tempemail
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
 	tls_client "github.com/bogdanfinn/tls-client"
 )
 
+/*
+ * 桥接函数从 ctx 取出当前实例（见 instance.go），provider 的请求因此使用发起调用的实例的
+ * 配置、TLS 客户端与 UA；ctx 未携带实例时回退默认实例
+ */
 func init() {
-	provider.HTTPClient = func() tls_client.HttpClient {
-		return HTTPClient()
+	provider.HTTPClient = func(ctx context.Context) tls_client.HttpClient {
+		return instanceFromContext(ctx).httpClient()
+	}
+	provider.HTTPClientTenmailWangtz = func(ctx context.Context) tls_client.HttpClient {
+		return instanceFromContext(ctx).httpClientTenmailWangtz()
+	}
+	provider.HTTPClientNoRedirect = func(ctx context.Context) tls_client.HttpClient {
+		return instanceFromContext(ctx).httpClientNoRedirect()
+	}
+	provider.HTTPClientNoCookieJar = func(ctx context.Context) tls_client.HttpClient {
+		return instanceFromContext(ctx).httpClientNoCookieJar()
+	}
+	provider.HTTPClientHooks = func(ctx context.Context) []tls_client.HttpClientOption {
+		return instanceFromContext(ctx).rateLimitHooks()
 	}
-	provider.HTTPClientTenmailWangtz = HTTPClientTenmailWangtz
-	provider.HTTPClientNoRedirect = HTTPClientNoRedirect
-	provider.HTTPClientNoCookieJar = HTTPClientNoCookieJar
 	provider.CheckHTTPStatus = checkHTTPStatus
-	provider.GetCurrentUA = GetCurrentUA
-	provider.GetConfigSnapshot = func() provider.ConfigSnapshot {
-		c := GetConfig()
+	provider.GetCurrentUA = func(ctx context.Context) string {
+		return instanceFromContext(ctx).currentBrowserConfig().UA
+	}
+	provider.GetConfigSnapshot = func(ctx context.Context) provider.ConfigSnapshot {
+		c := instanceFromContext(ctx).getConfig()
 		return provider.ConfigSnapshot{
 			Proxy:                    c.Proxy,
 			Timeout:                  int64(c.Timeout),
@@ -53,18 +69,24 @@ func emailToNorm(e Email) provider.NormEmail {
 			Size:        a.Size,
 			ContentType: a.ContentType,
 			URL:         a.URL,
+			Content:     a.Content,
 		}
 	}
 	return provider.NormEmail{
 		ID:          e.ID,
 		From:        e.From,
 		To:          e.To,
+		FromAddress: provider.NormAddress(e.FromAddress),
+		ToAddresses: toNormAddresses(e.ToAddresses),
+		Cc:          toNormAddresses(e.Cc),
+		ReplyTo:     toNormAddresses(e.ReplyTo),
 		Subject:     e.Subject,
 		Text:        e.Text,
 		HTML:        e.HTML,
 		Date:        e.Date,
 		IsRead:      e.IsRead,
 		Attachments: atts,
+		Headers:     e.Headers,
 	}
 }
 
@@ -76,19 +98,50 @@ func normToEmail(n provider.NormEmail) Email {
 			Size:        a.Size,
 			ContentType: a.ContentType,
 			URL:         a.URL,
+			Content:     a.Content,
 		}
 	}
-	return Email{
+	e := Email{
 		ID:          n.ID,
 		From:        n.From,
 		To:          n.To,
+		FromAddress: Address(n.FromAddress),
+		ToAddresses: fromNormAddresses(n.ToAddresses),
+		Cc:          fromNormAddresses(n.Cc),
+		ReplyTo:     fromNormAddresses(n.ReplyTo),
 		Subject:     n.Subject,
 		Text:        n.Text,
 		HTML:        n.HTML,
 		Date:        n.Date,
 		IsRead:      n.IsRead,
 		Attachments: atts,
+		Headers:     n.Headers,
+	}
+	e.ReceivedAt = normalizeReceivedAt(e.Date)
+	fillAddresses(&e)
+	return e
+}
+
+func toNormAddresses(list []Address) []provider.NormAddress {
+	if list == nil {
+		return nil
+	}
+	out := make([]provider.NormAddress, len(list))
+	for i, a := range list {
+		out[i] = provider.NormAddress(a)
+	}
+	return out
+}
+
+func fromNormAddresses(list []provider.NormAddress) []Address {
+	if list == nil {
+		return nil
 	}
+	out := make([]Address, len(list))
+	for i, a := range list {
+		out[i] = Address(a)
+	}
+	return out
 }
 
 func normSliceToEmails(ns []provider.NormEmail) []Email {
@@ -104,11 +157,12 @@ func mailboxToEmailInfo(m *provider.CreatedMailbox) *EmailInfo {
 		return nil
 	}
 	return &EmailInfo{
-		Channel:   Channel(m.Channel),
-		Email:     m.Email,
-		token:     m.Token,
-		ExpiresAt: m.ExpiresAt,
-		CreatedAt: m.CreatedAt,
+		Channel:      Channel(m.Channel),
+		Email:        m.Email,
+		token:        m.Token,
+		ExpiresAt:    normalizeExpiresAt(m.ExpiresAt),
+		ExpiresAtRaw: m.ExpiresAt,
+		CreatedAt:    m.CreatedAt,
 	}
 }
 
@@ -119,6 +173,21 @@ func fromMailbox(m *provider.CreatedMailbox, err error) (*EmailInfo, error) {
 	return mailboxToEmailInfo(m), nil
 }
 
+func normEmailResult(n provider.NormEmail, err error) (*Email, error) {
+	if err != nil {
+		return nil, err
+	}
+	e := normToEmail(n)
+	return &e, nil
+}
+
+func normEmailsSinceResult(ns []provider.NormEmail, cursor string, err error) ([]Email, string, error) {
+	if err != nil {
+		return nil, "", err
+	}
+	return normSliceToEmails(ns), cursor, nil
+}
+
 func normEmailsResult(ns []provider.NormEmail, err error) ([]Email, error) {
 	if err != nil {
 		return nil, err
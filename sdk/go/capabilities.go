@@ -0,0 +1,112 @@
+package tempemail
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+/*
+ * 渠道能力
+ * 每个渠道在注册时声明其能力集合（ChannelSpec.Capabilities），通过 ListChannels / GetChannelInfo 查询；
+ * GenerateEmailOptions.Require 按能力筛选渠道，只尝试具备全部所需能力的渠道。
+ * 声明是保守的：未声明不代表服务端一定不支持，只是 SDK 未解析或未验证该能力。
+ *
+ * 示例:
+ *   info, err := GenerateEmail(&GenerateEmailOptions{Require: CapAttachments | CapHTML})
+ *
+ *   ch, _ := GetChannelInfo(ChannelMailTm)
+ *   if ch.Capabilities.Has(CapAttachments) { ... }
+ */
+
+/* Capability 渠道能力位集合，可按位或组合 */
+type Capability uint32
+
+const (
+	/* 邮件正文提供 HTML */
+	CapHTML Capability = 1 << iota
+	/* 解析邮件附件信息 */
+	CapAttachments
+	/* 可指定邮箱用户名（@ 前的部分） */
+	CapCustomLocalPart
+	/* 可通过 Domain / Suffix 选择邮箱域名 */
+	CapDomainChoice
+	/* 可通过 Duration 控制邮箱有效期 */
+	CapTTLControl
+	/* 支持新邮件推送（见 ChannelSpec.Subscribe），Watch 无需轮询 */
+	CapPush
+	/* 支持删除邮件或销毁邮箱 */
+	CapDelete
+	/* 支持延长邮箱有效期（见 ExtendMailbox） */
+	CapExtend
+	/* 可获取邮件原始 MIME 源码（见 GetRawEmail） */
+	CapRawSource
+)
+
+/* capabilityNames 能力名称，用于 String 与 JSON 序列化 */
+var capabilityNames = []struct {
+	cap  Capability
+	name string
+}{
+	{CapHTML, "html"},
+	{CapAttachments, "attachments"},
+	{CapCustomLocalPart, "custom_local_part"},
+	{CapDomainChoice, "domain_choice"},
+	{CapTTLControl, "ttl_control"},
+	{CapPush, "push"},
+	{CapDelete, "delete"},
+	{CapExtend, "extend"},
+	{CapRawSource, "raw_source"},
+}
+
+/* Has 是否具备 want 中的全部能力 */
+func (c Capability) Has(want Capability) bool {
+	return c&want == want
+}
+
+/* Names 返回已声明能力的名称列表，如 ["html", "attachments"] */
+func (c Capability) Names() []string {
+	names := make([]string, 0, len(capabilityNames))
+	for _, n := range capabilityNames {
+		if c&n.cap != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return names
+}
+
+func (c Capability) String() string {
+	return strings.Join(c.Names(), "|")
+}
+
+/* MarshalJSON 序列化为能力名称数组 */
+func (c Capability) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Names())
+}
+
+/* UnmarshalJSON 从能力名称数组解析，忽略未知名称 */
+func (c *Capability) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+	*c = 0
+	for _, name := range names {
+		for _, n := range capabilityNames {
+			if n.name == name {
+				*c |= n.cap
+			}
+		}
+	}
+	return nil
+}
+
+/* filterChannelsByCapability 保留具备 want 全部能力的渠道，顺序不变 */
+func filterChannelsByCapability(channels []Channel, want Capability) []Channel {
+	out := make([]Channel, 0, len(channels))
+	for _, ch := range channels {
+		if spec, ok := channelRegistryMap[ch]; ok && spec.Capabilities.Has(want) {
+			out = append(out, ch)
+		}
+	}
+	return out
+}
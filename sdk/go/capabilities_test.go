@@ -0,0 +1,71 @@
+package tempemail
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+/*
+ * TestCapabilitiesRequire 校验能力声明：ChannelInfo 的 JSON 形式、
+ * Require 只尝试具备全部所需能力的渠道（包括跳过不满足条件的指定渠道）
+ */
+func TestCapabilitiesRequire(t *testing.T) {
+	info, ok := GetChannelInfo(ChannelMailTm)
+	if !ok || !info.Capabilities.Has(CapHTML|CapAttachments) {
+		t.Fatalf("mail-tm 应声明 html 与 attachments，实际 %v", info.Capabilities)
+	}
+	/* 渠道实现未解析附件时不声明 attachments，Require 不会选中它们 */
+	for _, ch := range []Channel{ChannelMffac, ChannelOpeninbox, ChannelRootsh, ChannelDropmail} {
+		if info, _ := GetChannelInfo(ch); info.Capabilities.Has(CapAttachments) {
+			t.Fatalf("%s 未解析附件，不应声明 attachments", ch)
+		}
+	}
+	data, _ := json.Marshal(ChannelInfo{Capabilities: CapHTML | CapPush})
+	var back ChannelInfo
+	if err := json.Unmarshal(data, &back); err != nil || back.Capabilities != CapHTML|CapPush {
+		t.Fatalf("JSON 往返失败: %s -> %v (%v)", data, back.Capabilities, err)
+	}
+
+	var tried []Channel
+	gen := func(ch Channel) func(context.Context, *GenerateEmailOptions) (*EmailInfo, error) {
+		return func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			tried = append(tried, ch)
+			return &EmailInfo{Channel: ch, Email: string(ch) + "@x"}, nil
+		}
+	}
+	registerFakeChannel(t, ChannelSpec{Channel: "cap-plain", Generate: gen("cap-plain"), Capabilities: CapHTML})
+	registerFakeChannel(t, ChannelSpec{Channel: "cap-full", Generate: gen("cap-full"), Capabilities: CapHTML | CapAttachments})
+	saved := allChannels
+	allChannels = []Channel{"cap-plain", "cap-full"}
+	t.Cleanup(func() { allChannels = saved })
+
+	got, err := newOfflineClient().Generate(&GenerateEmailOptions{Channel: "cap-plain", Require: CapAttachments})
+	if err != nil || got.Channel != "cap-full" || len(tried) != 1 {
+		t.Fatalf("期望跳过 cap-plain 只尝试 cap-full，实际 %+v, %v, 尝试 %v", got, err, tried)
+	}
+}
+
+/*
+ * TestSharedReaderCapabilities 校验共用同一收信实现的渠道声明相同的内容能力（html / attachments），
+ * 并同样提供摘要列表与单封读取
+ */
+func TestSharedReaderCapabilities(t *testing.T) {
+	const content = CapHTML | CapAttachments
+	for root, channels := range sharedProviderGroups(t) {
+		if !strings.HasPrefix(root, "GetEmails:") {
+			continue
+		}
+		first := channelRegistryMap[channels[0]]
+		for _, ch := range channels[1:] {
+			spec := channelRegistryMap[ch]
+			if spec.Capabilities&content != first.Capabilities&content {
+				t.Errorf("%s: %s 的内容能力 %v 与 %s 的 %v 不一致", root, ch, spec.Capabilities&content, channels[0], first.Capabilities&content)
+			}
+			if (spec.ListEmails == nil) != (first.ListEmails == nil) || (spec.GetEmail == nil) != (first.GetEmail == nil) {
+				t.Errorf("%s: %s 与 %s 的 ListEmails / GetEmail 不一致", root, ch, channels[0])
+			}
+		}
+	}
+}
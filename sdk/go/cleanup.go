@@ -0,0 +1,95 @@
+package tempemail
+
+import (
+	"context"
+	"fmt"
+)
+
+/*
+ * 删除邮件与销毁邮箱
+ * 声明了 CapDelete 的渠道（mail.tm、duckmail、guerrillamail 系列、dropmail）支持清理，
+ * 其余渠道返回可用 errors.Is(err, ErrUnsupported) 判断的错误。测试套件可据此在结束后清理现场。
+ *
+ * 示例:
+ *   info, _ := GenerateEmail(&GenerateEmailOptions{Require: CapDelete})
+ *   defer DestroyMailbox(context.Background(), info)
+ *
+ *   result, _ := GetEmails(info, nil)
+ *   for _, e := range result.Emails {
+ *       _ = DeleteEmail(ctx, info, e.ID)
+ *   }
+ */
+
+/*
+ * DeleteEmail 删除邮箱中的单封邮件，id 为 Email.ID
+ * 渠道不支持时返回 ErrUnsupported；网络错误与 5xx 按 RetryOptions 默认值重试
+ */
+func DeleteEmail(ctx context.Context, info *EmailInfo, id string) error {
+	return instanceOf(info).deleteEmail(ctx, info, id)
+}
+
+/*
+ * DestroyMailbox 销毁邮箱：删除服务端账号（mail.tm / duckmail）或放弃该地址（guerrillamail / dropmail）
+ * 销毁后该 EmailInfo 不应再使用；渠道不支持时返回 ErrUnsupported
+ */
+func DestroyMailbox(ctx context.Context, info *EmailInfo) error {
+	return instanceOf(info).destroyMailbox(ctx, info)
+}
+
+func (in *instance) deleteEmail(ctx context.Context, info *EmailInfo, id string) error {
+	spec, err := specFor(info)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		return fmt.Errorf("email id is required")
+	}
+	if spec.DeleteEmail == nil {
+		return fmt.Errorf("%s 渠道不支持删除邮件：%w", info.Channel, ErrUnsupported)
+	}
+	return in.runCleanup(ctx, info, "删除邮件", func(ctx context.Context) error {
+		return spec.DeleteEmail(ctx, info.Email, info.token, id)
+	})
+}
+
+func (in *instance) destroyMailbox(ctx context.Context, info *EmailInfo) error {
+	spec, err := specFor(info)
+	if err != nil {
+		return err
+	}
+	if spec.Destroy == nil {
+		return fmt.Errorf("%s 渠道不支持销毁邮箱：%w", info.Channel, ErrUnsupported)
+	}
+	return in.runCleanup(ctx, info, "销毁邮箱", func(ctx context.Context) error {
+		return spec.Destroy(ctx, info.Email, info.token)
+	})
+}
+
+/* runCleanup 以实例配置执行清理操作并重试暂时性失败 */
+func (in *instance) runCleanup(ctx context.Context, info *EmailInfo, action string, fn func(context.Context) error) error {
+	ctx = withInstance(ctx, in)
+	log := in.log()
+	_, _, err := withRetryAndAttempts(ctx, func() (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	}, nil)
+	if err != nil {
+		log.Warn(action+"失败", "channel", string(info.Channel), "email", info.Email, "error", err.Error())
+		return fmt.Errorf("%s失败：%w", action, err)
+	}
+	log.Info(action+"成功", "channel", string(info.Channel), "email", info.Email)
+	return nil
+}
+
+/* DeleteEmail 删除该邮箱中的单封邮件，见 DeleteEmail */
+func (m *Mailbox) DeleteEmail(ctx context.Context, id string) error {
+	return DeleteEmail(ctx, m.info, id)
+}
+
+/* Destroy 销毁该邮箱（见 DestroyMailbox），成功后从所属 Client 移除 */
+func (m *Mailbox) Destroy(ctx context.Context) error {
+	if err := DestroyMailbox(ctx, m.info); err != nil {
+		return err
+	}
+	m.Remove()
+	return nil
+}
@@ -0,0 +1,46 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+/*
+ * TestDeleteAndDestroy 校验清理接口：分发到渠道实现并透传令牌、
+ * 不支持的渠道返回 ErrUnsupported、Mailbox.Destroy 成功后移出 Client
+ */
+func TestDeleteAndDestroy(t *testing.T) {
+	var deleted, destroyed []string
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-cleanup",
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			deleted = append(deleted, token+"/"+id)
+			return nil
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			destroyed = append(destroyed, email)
+			return nil
+		},
+		Capabilities: CapDelete,
+	})
+	registerFakeChannel(t, ChannelSpec{Channel: "test-nocleanup"})
+
+	ctx := context.Background()
+	client := newOfflineClient()
+	m := client.AddMailbox(&EmailInfo{Channel: "test-cleanup", Email: "a@x", token: "tok"}, nil)
+	if err := m.DeleteEmail(ctx, "42"); err != nil || len(deleted) != 1 || deleted[0] != "tok/42" {
+		t.Fatalf("DeleteEmail: %v, %v", err, deleted)
+	}
+	if err := m.Destroy(ctx); err != nil || len(destroyed) != 1 || len(client.Mailboxes()) != 0 {
+		t.Fatalf("Destroy: %v, %v, 剩余 %d 个邮箱", err, destroyed, len(client.Mailboxes()))
+	}
+
+	plain := &EmailInfo{Channel: "test-nocleanup", Email: "b@x"}
+	if err := DeleteEmail(ctx, plain, "1"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("期望 ErrUnsupported，实际 %v", err)
+	}
+	if err := DestroyMailbox(ctx, plain); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("期望 ErrUnsupported，实际 %v", err)
+	}
+}
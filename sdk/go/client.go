@@ -1,17 +1,16 @@
 package tempemail
 
 import (
+	"context"
 	"fmt"
-	"math/rand"
+	"log/slog"
 	"strings"
+	"sync"
 	"time"
 
 	prov "github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
 )
 
-/*
- * ChannelInfo 渠道信息，包含渠道标识、显示名称和对应网站
- */
 func fixedDomain(domain string) *string { return &domain }
 
 /*
@@ -20,8 +19,8 @@ func fixedDomain(domain string) *string { return &domain }
  * 底层 MoaktGenerate 统一返回基准渠道 "moakt"，此处将 Channel 回填为具体变体渠道，
  * 保证返回的 EmailInfo.Channel 与用户请求的渠道标识一致
  */
-func genMoaktVariant(domain string, channel Channel) (*EmailInfo, error) {
-	info, err := fromMailbox(prov.MoaktGenerate(fixedDomain(domain)))
+func genMoaktVariant(ctx context.Context, domain string, channel Channel) (*EmailInfo, error) {
+	info, err := fromMailbox(prov.MoaktGenerate(ctx, fixedDomain(domain)))
 	if err != nil {
 		return nil, err
 	}
@@ -35,8 +34,8 @@ func genMoaktVariant(domain string, channel Channel) (*EmailInfo, error) {
  * 此处将 Channel 回填为具体变体渠道（xghff-com / oqqaj-com 等），
  * 保证返回的 EmailInfo.Channel 与用户请求的渠道标识一致
  */
-func genTenminuteVariant(domain string, channel Channel) (*EmailInfo, error) {
-	info, err := fromMailbox(prov.TenminuteOneGenerate(fixedDomain(domain)))
+func genTenminuteVariant(ctx context.Context, domain string, channel Channel) (*EmailInfo, error) {
+	info, err := fromMailbox(prov.TenminuteOneGenerate(ctx, fixedDomain(domain)))
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +43,9 @@ func genTenminuteVariant(domain string, channel Channel) (*EmailInfo, error) {
 	return info, nil
 }
 
+/*
+ * ChannelInfo 渠道信息，包含渠道标识、显示名称、对应网站、所属后端和能力集合
+ */
 type ChannelInfo struct {
 	/* 渠道标识 */
 	Channel Channel
@@ -51,6 +53,10 @@ type ChannelInfo struct {
 	Name string
 	/* 对应的临时邮箱服务网站 */
 	Website string
+	/* 渠道所属后端，同一后端的渠道共用服务端基础设施；未声明时为渠道标识本身 */
+	Backend string
+	/* 渠道声明的能力集合，JSON 中为名称数组 */
+	Capabilities Capability
 }
 
 /*
@@ -60,11 +66,7 @@ type ChannelInfo struct {
 func ListChannels() []ChannelInfo {
 	result := make([]ChannelInfo, len(channelRegistry))
 	for i := range channelRegistry {
-		result[i] = ChannelInfo{
-			Channel: channelRegistry[i].Channel,
-			Name:    channelRegistry[i].Name,
-			Website: channelRegistry[i].Website,
-		}
+		result[i] = channelRegistry[i].info()
 	}
 	return result
 }
@@ -78,27 +80,67 @@ func GetChannelInfo(channel Channel) (ChannelInfo, bool) {
 	if !ok {
 		return ChannelInfo{}, false
 	}
-	return ChannelInfo{Channel: spec.Channel, Name: spec.Name, Website: spec.Website}, true
+	return spec.info(), true
 }
 
 /*
  * GenerateEmail 创建临时邮箱
  *
  * 错误处理策略:
- * - 指定渠道失败时，自动尝试其他可用渠道（打乱顺序逐个尝试）
- * - 未指定渠道时，打乱全部渠道逐个尝试，直到成功
- * - 所有渠道均不可用时返回 nil（不返回 error）
+ * - 指定渠道失败时，自动尝试其他可用渠道（按健康度得分加权随机排序逐个尝试，见 ChannelStats）
+ * - 未指定渠道时，按同样的顺序尝试全部渠道，直到成功
+ * - Parallelism > 1 时同时竞速多个渠道（同一后端不并发），返回最先成功者
+ * - 所有渠道均不可用时返回 error，包装最后一个渠道的错误（可用 errors.Is / errors.As 判断分类，
+ *   如 *ErrRateLimited、ErrCaptchaRequired）；没有渠道可尝试时为 ErrChannelUnavailable
  *
  * 示例:
  *   info, _ := GenerateEmail(&GenerateEmailOptions{Channel: ChannelMailTm})
  *   if info != nil { fmt.Println(info.Email) }
  */
 func GenerateEmail(opts *GenerateEmailOptions) (*EmailInfo, error) {
+	return GenerateEmailContext(context.Background(), opts)
+}
+
+/*
+ * GenerateEmailContext 与 GenerateEmail 相同，但受 ctx 控制
+ *
+ * ctx 取消后：渠道循环立即停止、重试退避等待立即返回、
+ * 正在进行的 provider HTTP 请求随之中断，返回的 error 可用 errors.Is 匹配 ctx.Err()。
+ * ctx 带截止时间且早于 TotalTimeout 时，以截止时间作为整体超时。
+ *
+ * 示例:
+ *   ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+ *   defer cancel()
+ *   info, err := GenerateEmailContext(ctx, &GenerateEmailOptions{Channel: ChannelMailTm})
+ */
+func GenerateEmailContext(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+	return defaultInstance.generateEmail(ctx, opts)
+}
+
+/* generateEmail 在实例 in 上创建邮箱：使用实例的配置、HTTP 客户端、熔断状态与 logger */
+func (in *instance) generateEmail(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+	return in.generateEmailWith(ctx, opts, nil)
+}
+
+/*
+ * backendQuota 创建时的后端名额（如邮箱池的 MaxPerBackend）
+ * acquire 在尝试某后端前占用一个名额，返回 false 时跳过该后端；尝试失败或被取消时 release 归还，
+ * 成功渠道的名额保留给调用方，由其在入池或丢弃后归还
+ */
+type backendQuota interface {
+	acquire(backend string) bool
+	release(backend string)
+}
+
+/* generateEmailWith 同 generateEmail，quota 非 nil 时按其名额跳过后端 */
+func (in *instance) generateEmailWith(ctx context.Context, opts *GenerateEmailOptions, quota backendQuota) (*EmailInfo, error) {
+	ctx = withInstance(ctx, in)
+	log := in.log()
 	if opts == nil {
 		opts = &GenerateEmailOptions{}
 	}
 
-	tryOrder := buildChannelOrder(opts.Channel)
+	tryOrder := in.buildChannelOrder(opts.Channel)
 
 	// 解析域名筛选条件
 	var targetDomains []string
@@ -114,6 +156,19 @@ func GenerateEmail(opts *GenerateEmailOptions) (*EmailInfo, error) {
 	if len(targetDomains) > 0 {
 		tryOrder = filterChannelsByDomain(tryOrder, targetDomains)
 	}
+	// 按所需能力筛选渠道；指定用户名时仅保留支持自定义用户名的渠道
+	require := opts.Require
+	if wantsLocalPart(opts) {
+		require |= CapCustomLocalPart
+	}
+	if require != 0 {
+		tryOrder = filterChannelsByCapability(tryOrder, require)
+	}
+	if opts.LocalPartFunc == nil && opts.LocalPart != "" {
+		if _, err := resolveLocalPart("", opts); err != nil {
+			return nil, err
+		}
+	}
 
 	maxChannels := opts.MaxChannelsTried
 	if maxChannels <= 0 {
@@ -123,78 +178,159 @@ func GenerateEmail(opts *GenerateEmailOptions) (*EmailInfo, error) {
 	if totalTimeout <= 0 {
 		totalTimeout = 60 * time.Second
 	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < totalTimeout {
+			totalTimeout = remaining
+		}
+	}
 	startTime := time.Now()
 	failedBackends := make(map[string]bool)
 
-	channelsTried := 0
-	var lastErrMsg string
-	for _, ch := range tryOrder {
-		if channelsTried >= maxChannels {
-			sdkLogger.Warn("已尝试最大渠道数，停止", "max", maxChannels)
-			break
-		}
-		if time.Since(startTime) >= totalTimeout {
-			sdkLogger.Warn("整体超时，停止尝试")
-			break
+	parallelism := max(opts.Parallelism, 1)
+
+	/* attempt 单个渠道的尝试结果 */
+	type attempt struct {
+		ch       Channel
+		result   *EmailInfo
+		attempts int
+		elapsed  time.Duration
+		err      error
+	}
+	/* 任一渠道成功后取消其余仍在进行的尝试 */
+	raceCtx, cancelRace := context.WithCancel(ctx)
+	defer cancelRace()
+	results := make(chan attempt, parallelism)
+	running := make(map[string]bool) /* 正在尝试的后端，同一后端同时只尝试一个渠道 */
+	/* 返回时仍未得出结果的尝试（被取消或落后于成功的渠道）不计入熔断，释放其试探名额与后端名额 */
+	defer func() {
+		for key := range running {
+			in.breaker.release(key)
+			if quota != nil {
+				quota.release(key)
+			}
 		}
+	}()
 
-		backend := channelToBackend[ch]
+	channelsTried := 0
+	next := 0
+	stopped := false
+	var lastErr error
+
+	/* launch 按尝试顺序填满空闲并发槽位 */
+	launch := func() {
+		for !stopped && len(running) < parallelism && next < len(tryOrder) {
+			if ctx.Err() != nil {
+				return
+			}
+			if channelsTried >= maxChannels {
+				log.Warn("已尝试最大渠道数，停止", "max", maxChannels)
+				stopped = true
+				return
+			}
+			if time.Since(startTime) >= totalTimeout {
+				log.Warn("整体超时，停止尝试")
+				stopped = true
+				return
+			}
+
+			ch := tryOrder[next]
+			next++
+			key := backendOf(ch)
 
-		if backend != "" {
-			if failedBackends[backend] {
-				sdkLogger.Debug("跳过渠道，同后端已失败", "channel", string(ch), "backend", backend)
+			if running[key] {
+				log.Debug("跳过渠道，同后端正在尝试", "channel", string(ch), "backend", key)
+				continue
+			}
+			if failedBackends[key] {
+				log.Debug("跳过渠道，同后端已失败", "channel", string(ch), "backend", key)
+				continue
+			}
+			if quota != nil && !quota.acquire(key) {
+				log.Debug("跳过渠道，后端名额已满", "channel", string(ch), "backend", key)
 				continue
 			}
-			if !isBackendOpen(backend) {
-				sdkLogger.Debug("跳过渠道，后端熔断中", "channel", string(ch), "backend", backend)
+			if ok, _ := in.backendAllowed(key); !ok {
+				if quota != nil {
+					quota.release(key)
+				}
+				log.Debug("跳过渠道，后端熔断中", "channel", string(ch), "backend", key)
 				continue
 			}
+
+			channelsTried++
+			running[key] = true
+			log.Info("创建临时邮箱", "channel", string(ch))
+			go func() {
+				start := time.Now()
+				result, attempts, err := withRetryAndAttempts(raceCtx, func() (*EmailInfo, error) {
+					return generateEmailOnce(raceCtx, ch, opts)
+				}, opts.Retry)
+				results <- attempt{ch: ch, result: result, attempts: attempts, elapsed: time.Since(start), err: err}
+			}()
 		}
+	}
 
-		channelsTried++
-		sdkLogger.Info("创建临时邮箱", "channel", string(ch))
-		result, attempts, err := withRetryAndAttempts(func() (*EmailInfo, error) {
-			return generateEmailOnce(ch, opts)
-		}, opts.Retry)
-		if err == nil && result != nil {
-			sdkLogger.Info("邮箱创建成功", "channel", string(ch), "email", result.Email)
-			reportTelemetry("generate_email", string(ch), true, attempts, channelsTried, "")
-			if backend != "" {
-				recordBackendSuccess(backend)
-			}
-			return result, nil
+	launch()
+	for len(running) > 0 {
+		var r attempt
+		select {
+		case r = <-results:
+		case <-ctx.Done():
 		}
-		errMsg := "unknown error"
-		if err != nil {
-			errMsg = err.Error()
-			lastErrMsg = errMsg
+		if r.err == nil && r.result != nil {
+			r.result.inst = in
+			log.Info("邮箱创建成功", "channel", string(r.ch), "email", r.result.Email)
+			in.reportTelemetry("generate_email", string(r.ch), true, r.attempts, channelsTried, "")
+			in.recordChannelResult(r.ch, true, true, r.elapsed)
+			delete(running, backendOf(r.ch))
+			in.recordBackendResult(r.ch, nil)
+			return r.result, nil
 		}
-		sdkLogger.Warn("渠道不可用，尝试下一个", "channel", string(ch), "error", errMsg)
-		if backend != "" {
-			failedBackends[backend] = true
-			recordBackendFailure(backend)
+		/* 调用方取消导致的失败不计入后端熔断 */
+		if ctx.Err() != nil {
+			break
+		}
+		delete(running, backendOf(r.ch))
+		if quota != nil {
+			quota.release(backendOf(r.ch))
+		}
+		errMsg := "unknown error"
+		failErr := error(ErrChannelUnavailable)
+		if r.err != nil {
+			errMsg = r.err.Error()
+			lastErr = r.err
+			failErr = r.err
 		}
+		log.Warn("渠道不可用，尝试下一个", "channel", string(r.ch), "error", errMsg)
+		in.recordChannelResult(r.ch, true, false, r.elapsed)
+		failedBackends[backendOf(r.ch)] = true
+		in.recordBackendResult(r.ch, failErr)
+		launch()
+	}
+
+	if err := ctx.Err(); err != nil {
+		log.Warn("创建邮箱已取消", "error", err.Error())
+		in.reportTelemetry("generate_email", "", false, 0, channelsTried, err.Error())
+		return nil, fmt.Errorf("创建临时邮箱已取消：已尝试 %d 个渠道：%w", channelsTried, err)
 	}
 
-	sdkLogger.Error("所有渠道均不可用，创建邮箱失败")
-	reportTelemetry("generate_email", "", false, 0, channelsTried, lastErrMsg)
-	if lastErrMsg == "" {
-		lastErrMsg = "所有渠道均不可用"
+	log.Error("所有渠道均不可用，创建邮箱失败")
+	if lastErr == nil {
+		in.reportTelemetry("generate_email", "", false, 0, channelsTried, "")
+		return nil, fmt.Errorf("创建临时邮箱失败：已尝试 %d 个渠道，所有渠道均不可用：%w", channelsTried, ErrChannelUnavailable)
 	}
-	return nil, fmt.Errorf("创建临时邮箱失败：已尝试 %d 个渠道，最后错误：%s", channelsTried, lastErrMsg)
+	in.reportTelemetry("generate_email", "", false, 0, channelsTried, lastErr.Error())
+	return nil, fmt.Errorf("创建临时邮箱失败：已尝试 %d 个渠道，最后错误：%w", channelsTried, lastErr)
 }
 
 /*
  * buildChannelOrder 构建渠道尝试顺序
- * 指定渠道时优先尝试该渠道，其余渠道打乱追加
- * 未指定时打乱全部渠道
+ * 指定渠道时优先尝试该渠道，其余渠道按健康度得分加权随机追加（见 health.go）
+ * 未指定时按得分加权随机排列全部渠道
  */
-func buildChannelOrder(preferred Channel) []Channel {
-	shuffled := make([]Channel, len(allChannels))
-	copy(shuffled, allChannels)
-	rand.Shuffle(len(shuffled), func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
+func (in *instance) buildChannelOrder(preferred Channel) []Channel {
+	in.loadChannelStats()
+	shuffled := in.health.order(allChannels)
 	if preferred == "" {
 		return shuffled
 	}
@@ -211,14 +347,30 @@ func buildChannelOrder(preferred Channel) []Channel {
 
 /*
  * generateEmailOnce 单次创建邮箱（不含重试逻辑）
- * 根据渠道类型分发到对应的 provider 实现
+ * 根据渠道类型分发到对应的 provider 实现；指定用户名时经 ctx 传给 provider 并校验结果
  */
-func generateEmailOnce(channel Channel, opts *GenerateEmailOptions) (*EmailInfo, error) {
+func generateEmailOnce(ctx context.Context, channel Channel, opts *GenerateEmailOptions) (*EmailInfo, error) {
 	spec, ok := channelRegistryMap[channel]
 	if !ok || spec.Generate == nil {
 		return nil, fmt.Errorf("unknown channel: %s", channel)
 	}
-	return spec.Generate(opts)
+	if !wantsLocalPart(opts) {
+		return spec.Generate(ctx, opts)
+	}
+
+	local, err := resolveLocalPart(channel, opts)
+	if err != nil {
+		return nil, err
+	}
+	info, err := spec.Generate(prov.WithLocalPart(ctx, local), opts)
+	if err != nil {
+		return nil, err
+	}
+	/* 渠道未按指定用户名创建时视为失败，交由调用方尝试下一个渠道 */
+	if info == nil || !hasLocalPart(info.Email, local) {
+		return nil, fmt.Errorf("%s: 渠道未使用指定的邮箱用户名 %q", channel, local)
+	}
+	return info, nil
 }
 
 /*
@@ -227,7 +379,7 @@ func generateEmailOnce(channel Channel, opts *GenerateEmailOptions) (*EmailInfo,
  *
  * 错误处理策略:
  * - 网络错误、超时、服务端 5xx 错误 → 自动重试（默认 2 次）
- * - 重试耗尽后返回 { Success: false, Emails: [] }，不返回 error
+ * - 重试耗尽后返回 { Success: false, Emails: [] }，不返回 error；失败原因见 GetEmailsResult.Err
  * - 参数校验错误（缺少 EmailInfo）直接返回 error
  *
  * 这种设计让调用方在轮询场景下不会因网络波动而中断整个流程，
@@ -238,16 +390,33 @@ func generateEmailOnce(channel Channel, opts *GenerateEmailOptions) (*EmailInfo,
  *   result, _ := GetEmails(info, nil)
  */
 func GetEmails(info *EmailInfo, opts *GetEmailsOptions) (*GetEmailsResult, error) {
+	return GetEmailsContext(context.Background(), info, opts)
+}
+
+/*
+ * GetEmailsContext 与 GetEmails 相同，但受 ctx 控制
+ * 请求经由创建该邮箱的实例发出（见 NewClient），恢复的会话使用默认实例
+ * ctx 取消后重试等待与进行中的 HTTP 请求立即中断，返回 nil 与 ctx.Err()；
+ * 其余失败仍按 GetEmails 的约定返回 { Success: false }
+ */
+func GetEmailsContext(ctx context.Context, info *EmailInfo, opts *GetEmailsOptions) (*GetEmailsResult, error) {
+	return instanceOf(info).getEmails(ctx, info, opts)
+}
+
+/* getEmails 在实例 in 上拉取邮件 */
+func (in *instance) getEmails(ctx context.Context, info *EmailInfo, opts *GetEmailsOptions) (*GetEmailsResult, error) {
+	ctx = withInstance(ctx, in)
+	log := in.log()
 	if info == nil {
-		reportTelemetry("get_emails", "", false, 0, 0, "EmailInfo is required, call GenerateEmail() first")
+		in.reportTelemetry("get_emails", "", false, 0, 0, "EmailInfo is required, call GenerateEmail() first")
 		return nil, fmt.Errorf("EmailInfo is required, call GenerateEmail() first")
 	}
 	if info.Channel == "" {
-		reportTelemetry("get_emails", "", false, 0, 0, "channel is required")
+		in.reportTelemetry("get_emails", "", false, 0, 0, "channel is required")
 		return nil, fmt.Errorf("channel is required")
 	}
 	if info.Email == "" && info.Channel != ChannelTempmailLol {
-		reportTelemetry("get_emails", string(info.Channel), false, 0, 0, "email is required")
+		in.reportTelemetry("get_emails", string(info.Channel), false, 0, 0, "email is required")
 		return nil, fmt.Errorf("email is required")
 	}
 
@@ -256,32 +425,59 @@ func GetEmails(info *EmailInfo, opts *GetEmailsOptions) (*GetEmailsResult, error
 		retry = opts.Retry
 	}
 
-	sdkLogger.Debug("获取邮件", "channel", string(info.Channel), "email", info.Email)
-	emails, attempts, err := withRetryAndAttempts(func() ([]Email, error) {
-		return getEmailsOnce(info.Channel, info.Email, info.token)
+	backend := backendOf(info.Channel)
+	if ok, wait := in.backendAllowed(backend); !ok {
+		err := fmt.Errorf("%s: %w", info.Channel, &ErrBackendOpen{Backend: backend, RetryAfter: wait})
+		log.Debug("跳过获取邮件，后端熔断中", "channel", string(info.Channel), "backend", backend)
+		return &GetEmailsResult{
+			Channel: info.Channel,
+			Email:   info.Email,
+			Emails:  []Email{},
+			Success: false,
+			Err:     err,
+		}, nil
+	}
+
+	log.Debug("获取邮件", "channel", string(info.Channel), "email", info.Email)
+	start := time.Now()
+	emails, attempts, err := withRetryAndAttempts(ctx, func() ([]Email, error) {
+		return getEmailsOnce(ctx, info.Channel, info.Email, info.token)
 	}, retry)
+	elapsed := time.Since(start)
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		in.breaker.release(backend)
+		in.reportTelemetry("get_emails", string(info.Channel), false, attempts, 0, ctxErr.Error())
+		log.Warn("获取邮件已取消", "channel", string(info.Channel), "error", ctxErr.Error())
+		return nil, ctxErr
+	}
 
 	if err != nil {
-		reportTelemetry("get_emails", string(info.Channel), false, attempts, 0, err.Error())
+		in.reportTelemetry("get_emails", string(info.Channel), false, attempts, 0, err.Error())
+		in.recordChannelResult(info.Channel, false, false, elapsed)
+		in.recordBackendResult(info.Channel, err)
 		/*
 		 * 重试耗尽后仍然失败 → 返回空结果而非 error
 		 * 这样调用方在轮询场景下不会因为一次网络波动而中断整个流程
 		 */
-		sdkLogger.Error("获取邮件失败", "channel", string(info.Channel), "error", err.Error())
+		log.Error("获取邮件失败", "channel", string(info.Channel), "error", err.Error())
 		return &GetEmailsResult{
 			Channel: info.Channel,
 			Email:   info.Email,
 			Emails:  []Email{},
 			Success: false,
+			Err:     err,
 		}, nil
 	}
 
 	if len(emails) > 0 {
-		sdkLogger.Info("获取到邮件", "channel", string(info.Channel), "count", len(emails))
+		log.Info("获取到邮件", "channel", string(info.Channel), "count", len(emails))
 	} else {
-		sdkLogger.Debug("暂无邮件", "channel", string(info.Channel))
+		log.Debug("暂无邮件", "channel", string(info.Channel))
 	}
-	reportTelemetry("get_emails", string(info.Channel), true, attempts, 0, "")
+	in.reportTelemetry("get_emails", string(info.Channel), true, attempts, 0, "")
+	in.recordChannelResult(info.Channel, false, true, elapsed)
+	in.recordBackendResult(info.Channel, nil)
 
 	return &GetEmailsResult{
 		Channel: info.Channel,
@@ -296,12 +492,12 @@ func GetEmails(info *EmailInfo, opts *GetEmailsOptions) (*GetEmailsResult, error
  * 根据渠道类型分发到对应的 provider 实现
  * token 由 SDK 内部从 EmailInfo 中获取，用户无感知
  */
-func getEmailsOnce(channel Channel, email string, token string) ([]Email, error) {
+func getEmailsOnce(ctx context.Context, channel Channel, email string, token string) ([]Email, error) {
 	spec, ok := channelRegistryMap[channel]
 	if !ok || spec.GetEmails == nil {
 		return nil, fmt.Errorf("unsupported channel: %s", channel)
 	}
-	return spec.GetEmails(email, token)
+	return spec.GetEmails(ctx, email, token)
 }
 
 /*
@@ -316,9 +512,15 @@ func (info *EmailInfo) GetEmails(opts *GetEmailsOptions) (*GetEmailsResult, erro
 	return GetEmails(info, opts)
 }
 
+/* EmailInfo.GetEmailsContext 获取当前邮箱的邮件列表，受 ctx 控制 */
+func (info *EmailInfo) GetEmailsContext(ctx context.Context, opts *GetEmailsOptions) (*GetEmailsResult, error) {
+	return GetEmailsContext(ctx, info, opts)
+}
+
 /*
  * Client 临时邮箱客户端
  * 封装了邮箱创建和邮件获取的完整流程，自动管理邮箱信息和认证令牌
+ * 并发安全：可在多个 goroutine 中共用；同时管理多个邮箱见 NewMailbox
  *
  * 示例:
  *   client := NewClient()
@@ -329,12 +531,61 @@ func (info *EmailInfo) GetEmails(opts *GetEmailsOptions) (*GetEmailsResult, erro
  *   }
  */
 type Client struct {
-	emailInfo *EmailInfo
+	/* 客户端使用的 SDK 实例，nil 表示默认实例 */
+	inst *instance
+
+	mu        sync.RWMutex
+	emailInfo *EmailInfo /* Generate() 缓存的当前邮箱 */
+	mailboxes []*Mailbox /* NewMailbox() / AddMailbox() 管理的邮箱，按加入顺序，见 mailbox.go */
+}
+
+/*
+ * NewClient 创建临时邮箱客户端实例
+ * 不带选项时共享默认实例（与包级函数、SetConfig 同一配置）；
+ * 带选项时创建独立实例，拥有自己的配置、HTTP 客户端、后端熔断状态与 logger，
+ * 未指定的项沿用创建时刻默认实例的配置与 logger
+ *
+ * 示例:
+ *   proxied := NewClient(WithConfig(SDKConfig{Proxy: "http://127.0.0.1:7890"}))
+ *   direct := NewClient(WithConfig(SDKConfig{Timeout: 5 * time.Second}), WithLogger(myLogger))
+ */
+func NewClient(opts ...ClientOption) *Client {
+	if len(opts) == 0 {
+		return &Client{}
+	}
+	in := newInstance(defaultInstance.getConfig(), defaultInstance.log())
+	for _, opt := range opts {
+		opt(in)
+	}
+	return &Client{inst: in}
+}
+
+/* instance 客户端使用的 SDK 实例 */
+func (c *Client) instance() *instance {
+	if c.inst != nil {
+		return c.inst
+	}
+	return defaultInstance
+}
+
+/* SetConfig 更新客户端所属实例的配置；共享默认实例时等同于包级 SetConfig */
+func (c *Client) SetConfig(config SDKConfig) {
+	c.instance().setConfig(config)
 }
 
-/* NewClient 创建临时邮箱客户端实例 */
-func NewClient() *Client {
-	return &Client{}
+/* Config 获取客户端所属实例的当前配置 */
+func (c *Client) Config() SDKConfig {
+	return c.instance().getConfig()
+}
+
+/* SetLogger 设置客户端所属实例的 logger，nil 表示静默 */
+func (c *Client) SetLogger(l *slog.Logger) {
+	c.instance().setLogger(l)
+}
+
+/* Logger 获取客户端所属实例的 logger */
+func (c *Client) Logger() *slog.Logger {
+	return c.instance().log()
 }
 
 /*
@@ -342,11 +593,18 @@ func NewClient() *Client {
  * 后续调用 GetEmails() 时自动使用此邮箱的渠道、地址和令牌
  */
 func (c *Client) Generate(opts *GenerateEmailOptions) (*EmailInfo, error) {
-	info, err := GenerateEmail(opts)
+	return c.GenerateContext(context.Background(), opts)
+}
+
+/* GenerateContext 与 Generate 相同，但受 ctx 控制（见 GenerateEmailContext） */
+func (c *Client) GenerateContext(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+	info, err := c.instance().generateEmail(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
+	c.mu.Lock()
 	c.emailInfo = info
+	c.mu.Unlock()
 	return info, nil
 }
 
@@ -355,15 +613,23 @@ func (c *Client) Generate(opts *GenerateEmailOptions) (*EmailInfo, error) {
  * 必须先调用 Generate() 创建邮箱
  */
 func (c *Client) GetEmails(opts *GetEmailsOptions) (*GetEmailsResult, error) {
-	if c.emailInfo == nil {
-		reportTelemetry("get_emails", "", false, 0, 0, "no email generated. Call Generate() first")
+	return c.GetEmailsContext(context.Background(), opts)
+}
+
+/* GetEmailsContext 与 GetEmails 相同，但受 ctx 控制（见 GetEmailsContext） */
+func (c *Client) GetEmailsContext(ctx context.Context, opts *GetEmailsOptions) (*GetEmailsResult, error) {
+	info := c.GetEmailInfo()
+	if info == nil {
+		c.instance().reportTelemetry("get_emails", "", false, 0, 0, "no email generated. Call Generate() first")
 		return nil, fmt.Errorf("no email generated. Call Generate() first")
 	}
 
-	return GetEmails(c.emailInfo, opts)
+	return GetEmailsContext(ctx, info, opts)
 }
 
 /* GetEmailInfo 获取当前缓存的邮箱信息，未调用 Generate() 时返回 nil */
 func (c *Client) GetEmailInfo() *EmailInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.emailInfo
 }
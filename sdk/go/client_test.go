@@ -0,0 +1,82 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/*
+ * TestGenerateEmailParallelism 校验渠道竞速：同一后端的渠道不会同时尝试、
+ * 返回最先成功的渠道并取消其余尝试、MaxChannelsTried 限制启动的渠道总数
+ */
+func TestGenerateEmailParallelism(t *testing.T) {
+	var sharedInflight, sharedPeak, canceled int32
+	shared := func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+		n := atomic.AddInt32(&sharedInflight, 1)
+		defer atomic.AddInt32(&sharedInflight, -1)
+		if n > atomic.LoadInt32(&sharedPeak) {
+			atomic.StoreInt32(&sharedPeak, n)
+		}
+		select {
+		case <-ctx.Done():
+			atomic.AddInt32(&canceled, 1)
+			return nil, ctx.Err()
+		case <-time.After(2 * time.Second):
+			return &EmailInfo{Channel: "race-a1", Email: "slow@x"}, nil
+		}
+	}
+	fakes := map[Channel]func(context.Context, *GenerateEmailOptions) (*EmailInfo, error){
+		"race-a1": shared,
+		"race-a2": shared,
+		"race-fail": func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return nil, errors.New("boom")
+		},
+		"race-ok": func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			time.Sleep(50 * time.Millisecond)
+			return &EmailInfo{Channel: "race-ok", Email: "fast@x"}, nil
+		},
+	}
+	saved := allChannels
+	allChannels = nil
+	for ch, gen := range fakes {
+		registerFakeChannel(t, ChannelSpec{Channel: ch, Generate: gen})
+		allChannels = append(allChannels, ch)
+	}
+	channelToBackend["race-a1"], channelToBackend["race-a2"] = "race-shared", "race-shared"
+	t.Cleanup(func() {
+		allChannels = saved
+		delete(channelToBackend, "race-a1")
+		delete(channelToBackend, "race-a2")
+	})
+
+	client := newOfflineClient()
+	info, err := client.Generate(&GenerateEmailOptions{Parallelism: 4})
+	if err != nil || info.Email != "fast@x" {
+		t.Fatalf("期望最快成功的 race-ok，实际 %+v, %v", info, err)
+	}
+	if atomic.LoadInt32(&sharedPeak) > 1 {
+		t.Fatalf("同一后端的渠道被同时尝试，峰值 %d", sharedPeak)
+	}
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&sharedInflight) > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if atomic.LoadInt32(&canceled) != 1 {
+		t.Fatalf("期望落后的同后端渠道被取消 1 次，实际 %d", canceled)
+	}
+
+	/* 仅允许尝试 1 个渠道且指定失败渠道时，不会再启动其他渠道 */
+	_, err = client.Generate(&GenerateEmailOptions{Channel: "race-fail", Parallelism: 4, MaxChannelsTried: 1})
+	if err == nil {
+		t.Fatal("MaxChannelsTried=1 且首个渠道失败时应返回错误")
+	}
+}
+
+/* newOfflineClient 关闭遥测的独立客户端，避免测试向上报端点发送事件 */
+func newOfflineClient() *Client {
+	off := false
+	return NewClient(WithConfig(SDKConfig{TelemetryEnabled: &off}))
+}
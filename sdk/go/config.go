@@ -11,7 +11,8 @@ import (
 )
 
 /*
-* SDKConfig SDK 全局配置
+* SDKConfig SDK 配置：默认实例经 SetConfig 设置，独立实例经 NewClient(WithConfig(...)) 指定
+* 默认实例启动时读取以下环境变量：
 
 *   TEMPMAIL_PROXY    - 代理 URL
 *   TEMPMAIL_TIMEOUT  - 超时秒数
@@ -22,6 +23,7 @@ import (
 *   APIHZ_ID / APIHZ_KEY - apihz（接口盒子）调用凭据，默认公共账号 88888888
 *   TEMPMAIL_TELEMETRY_ENABLED - true/false，默认 true；设为 false/0/no 关闭匿名用量上报
 *   TEMPMAIL_TELEMETRY_URL - 自定义上报端点 URL（覆盖内置默认地址）
+*   TEMPMAIL_CHANNEL_STATS_FILE - 渠道健康度统计的持久化文件路径
  */
 type SDKConfig struct {
 	/* 代理 URL，支持 http/https/socks5，如 "http://127.0.0.1:7890"，空字符串不使用代理 */
@@ -46,68 +48,82 @@ type SDKConfig struct {
 	TelemetryEnabled *bool
 	/* 非空时作为上报服务端 URL，覆盖默认端点与环境变量 TEMPMAIL_TELEMETRY_URL */
 	TelemetryEndpoint string
+	/* 渠道健康度统计的持久化文件路径（JSON），空则只保存在内存中，见 ChannelStats */
+	ChannelStatsFile string
+	/*
+	 * 按主机的令牌桶限速，键为主机名（按域名后缀匹配，"*" 为其余主机的默认值），见 RateLimit；
+	 * 服务端 Retry-After / X-RateLimit-* 信号无需配置即生效
+	 */
+	RateLimits map[string]RateLimit
+	/* 限速时最多原地等待的时长，超过则跳过该主机（返回 *ErrRateLimited），0 使用默认值 5s */
+	RateLimitMaxWait time.Duration
+	/* 后端首次熔断的冷却期，之后每次连续失败翻倍，0 使用默认值 60s，见 BackendStatus */
+	BreakerCooldown time.Duration
+	/* 后端熔断冷却期上限，0 使用默认值 5m */
+	BreakerMaxCooldown time.Duration
 }
 
-var (
-	globalConfig  SDKConfig
-	configVersion uint64 /* 配置版本号，每次 SetConfig 递增 */
-	configMu      sync.RWMutex
-
-	cachedClient            tls_client.HttpClient /* 缓存的 TLS 指纹客户端 */
-	cachedNoRedirectClient  tls_client.HttpClient /* 缓存的不跟随重定向客户端 */
-	cachedNoCookieJarClient tls_client.HttpClient /* 无 Cookie 罐，供 tempmailg 等需「清空环境」建邮的渠道 */
-	cachedClientVersion     uint64                /* 缓存客户端对应的配置版本 */
-	clientMu                sync.Mutex
-
-	cachedTenmailWangtzClient tls_client.HttpClient /* 10mail-wangtz：默认跳过证书校验 */
-	cachedTenmailWangtzVer    uint64
-	tenmailWangtzClientMu     sync.Mutex
-)
-
-func init() {
-	/* 启动时从环境变量读取默认配置 */
-	loadEnvConfig()
+/*
+ * httpClientCache 实例内缓存的 TLS 客户端
+ * 配置版本变化（SetConfig）后惰性重建；每次重建随机选取浏览器配置
+ */
+type httpClientCache struct {
+	mu                   sync.Mutex
+	client               tls_client.HttpClient /* 缓存的 TLS 指纹客户端 */
+	noRedirectClient     tls_client.HttpClient /* 缓存的不跟随重定向客户端 */
+	noCookieJarClient    tls_client.HttpClient /* 无 Cookie 罐，供 tempmailg 等需「清空环境」建邮的渠道 */
+	version              uint64                /* 缓存客户端对应的配置版本 */
+	tenmailWangtzMu      sync.Mutex
+	tenmailWangtzClient  tls_client.HttpClient /* 10mail-wangtz：默认跳过证书校验 */
+	tenmailWangtzVersion uint64
+	browserMu            sync.RWMutex
+	currentBrowser       *BrowserConfig /* 当前 TLS 客户端对应的浏览器配置 */
 }
 
 /*
- * loadEnvConfig 从环境变量读取配置
- * TEMPMAIL_PROXY / TEMPMAIL_TIMEOUT / TEMPMAIL_INSECURE
+ * envConfig 从环境变量读取默认配置
+ * TEMPMAIL_PROXY / TEMPMAIL_TIMEOUT / TEMPMAIL_INSECURE 等，见 SDKConfig
  */
-func loadEnvConfig() {
+func envConfig() SDKConfig {
+	var cfg SDKConfig
 	if proxy := os.Getenv("TEMPMAIL_PROXY"); proxy != "" {
-		globalConfig.Proxy = proxy
+		cfg.Proxy = proxy
 	}
 	if t := os.Getenv("TEMPMAIL_TIMEOUT"); t != "" {
 		if secs, err := strconv.Atoi(t); err == nil && secs > 0 {
-			globalConfig.Timeout = time.Duration(secs) * time.Second
+			cfg.Timeout = time.Duration(secs) * time.Second
 		}
 	}
 	if v := os.Getenv("TEMPMAIL_INSECURE"); v != "" {
-		globalConfig.Insecure = v == "1" || strings.EqualFold(v, "true")
+		cfg.Insecure = v == "1" || strings.EqualFold(v, "true")
 	}
 	if v := strings.TrimSpace(os.Getenv("DROPMAIL_AUTH_TOKEN")); v != "" {
-		globalConfig.DropmailAuthToken = v
+		cfg.DropmailAuthToken = v
 	} else if v := strings.TrimSpace(os.Getenv("DROPMAIL_API_TOKEN")); v != "" {
-		globalConfig.DropmailAuthToken = v
+		cfg.DropmailAuthToken = v
 	}
 	if v := strings.TrimSpace(os.Getenv("DROPMAIL_NO_AUTO_TOKEN")); v != "" {
-		globalConfig.DropmailDisableAutoToken = v == "1" || strings.EqualFold(v, "true") || strings.EqualFold(v, "yes")
+		cfg.DropmailDisableAutoToken = v == "1" || strings.EqualFold(v, "true") || strings.EqualFold(v, "yes")
 	}
 	if v := strings.TrimSpace(os.Getenv("DROPMAIL_RENEW_LIFETIME")); v != "" {
-		globalConfig.DropmailRenewLifetime = v
+		cfg.DropmailRenewLifetime = v
 	}
 	if v := strings.TrimSpace(os.Getenv("APIHZ_ID")); v != "" {
-		globalConfig.ApihzID = v
+		cfg.ApihzID = v
 	}
 	if v := strings.TrimSpace(os.Getenv("APIHZ_KEY")); v != "" {
-		globalConfig.ApihzKey = v
+		cfg.ApihzKey = v
 	}
 	if v := strings.TrimSpace(os.Getenv("TEMPMAIL_TELEMETRY_ENABLED")); v != "" {
-		globalConfig.TelemetryEnabled = parseTelemetryEnabledEnv(v)
+		cfg.TelemetryEnabled = parseTelemetryEnabledEnv(v)
 	}
 	if v := strings.TrimSpace(os.Getenv("TEMPMAIL_TELEMETRY_URL")); v != "" {
-		globalConfig.TelemetryEndpoint = v
+		cfg.TelemetryEndpoint = v
+	}
+	if v := strings.TrimSpace(os.Getenv("TEMPMAIL_CHANNEL_STATS_FILE")); v != "" {
+		cfg.ChannelStatsFile = v
 	}
+	return cfg
 }
 
 /* parseTelemetryEnabledEnv 解析 true/false；无法识别时返回 nil（保持默认开启） */
@@ -126,7 +142,7 @@ func parseTelemetryEnabledEnv(raw string) *bool {
 }
 
 /*
- * SetConfig 设置 SDK 全局配置
+ * SetConfig 设置 SDK 全局配置（作用于默认实例，见 NewClient）
  * 线程安全，可在任意时刻调用；设置后自动使已缓存的 HTTP 客户端失效
  *
  * 示例:
@@ -134,11 +150,20 @@ func parseTelemetryEnabledEnv(raw string) *bool {
  *   tempemail.SetConfig(tempemail.SDKConfig{Proxy: "http://127.0.0.1:7890"})
  */
 func SetConfig(config SDKConfig) {
-	configMu.Lock()
-	globalConfig = config
-	configVersion++
-	configMu.Unlock()
-	sdkLogger.Info("SDK 配置已更新",
+	defaultInstance.setConfig(config)
+}
+
+/* GetConfig 获取当前 SDK 全局配置（默认实例） */
+func GetConfig() SDKConfig {
+	return defaultInstance.getConfig()
+}
+
+func (in *instance) setConfig(config SDKConfig) {
+	in.configMu.Lock()
+	in.config = config
+	in.configVersion++
+	in.configMu.Unlock()
+	in.log().Info("SDK 配置已更新",
 		"proxy", config.Proxy,
 		"timeout", config.Timeout.String(),
 		"insecure", config.Insecure,
@@ -146,22 +171,27 @@ func SetConfig(config SDKConfig) {
 	)
 }
 
-/* GetConfig 获取当前 SDK 全局配置 */
-func GetConfig() SDKConfig {
-	configMu.RLock()
-	defer configMu.RUnlock()
-	return globalConfig
+func (in *instance) getConfig() SDKConfig {
+	in.configMu.RLock()
+	defer in.configMu.RUnlock()
+	return in.config
+}
+
+func (in *instance) getConfigVersion() uint64 {
+	in.configMu.RLock()
+	defer in.configMu.RUnlock()
+	return in.configVersion
 }
 
 /*
  * buildTLSClient 根据配置和浏览器指纹创建 tls-client 实例
- * @param cfg SDK 全局配置
+ * @param cfg SDK 配置
  * @param bc 浏览器配置（TLS 指纹 profile + UA）
  * @param followRedirect 是否跟随重定向
  * @param withCookieJar 是否启用 Cookie 罐（false 时不持久化 Set-Cookie，适合每次建邮须独立会话的场景）
  * @returns tls_client.HttpClient
  */
-func buildTLSClient(cfg SDKConfig, bc BrowserConfig, followRedirect, withCookieJar bool) tls_client.HttpClient {
+func (in *instance) buildTLSClient(cfg SDKConfig, bc BrowserConfig, followRedirect, withCookieJar bool) tls_client.HttpClient {
 	timeout := cfg.Timeout
 	if timeout <= 0 {
 		timeout = 15 * time.Second
@@ -188,9 +218,11 @@ func buildTLSClient(cfg SDKConfig, bc BrowserConfig, followRedirect, withCookieJ
 		options = append(options, tls_client.WithProxyUrl(cfg.Proxy))
 	}
 
+	options = append(options, in.rateLimitHooks()...)
+
 	client, err := tls_client.NewHttpClient(tls_client.NewNoopLogger(), options...)
 	if err != nil {
-		sdkLogger.Error("创建 TLS 客户端失败，使用默认配置", "error", err.Error())
+		in.log().Error("创建 TLS 客户端失败，使用默认配置", "error", err.Error())
 		client, _ = tls_client.NewHttpClient(tls_client.NewNoopLogger())
 	}
 
@@ -198,111 +230,123 @@ func buildTLSClient(cfg SDKConfig, bc BrowserConfig, followRedirect, withCookieJ
 }
 
 /*
- * HTTPClient 获取带全局配置和浏览器 TLS 指纹的 HTTP 客户端
+ * HTTPClient 获取带全局配置和浏览器 TLS 指纹的 HTTP 客户端（默认实例）
  * 内部缓存复用，仅在配置变更时重建
  * 每次重建时随机选取浏览器配置（profile + UA），模拟真实浏览器指纹
  */
 func HTTPClient() tls_client.HttpClient {
-	configMu.RLock()
-	ver := configVersion
-	configMu.RUnlock()
+	return defaultInstance.httpClient()
+}
+
+/*
+ * HTTPClientNoRedirect 获取不跟随重定向的 TLS 客户端（默认实例）
+ * 使用当前浏览器配置，用于需要捕获 Set-Cookie 等场景
+ * 内部缓存复用，与主客户端同步失效
+ */
+func HTTPClientNoRedirect() tls_client.HttpClient {
+	return defaultInstance.httpClientNoRedirect()
+}
+
+/*
+ * HTTPClientNoCookieJar 获取与主客户端相同 TLS 指纹与代理配置、但不使用 Cookie 罐的客户端（默认实例）。
+ * tempmailg.com 等依赖「首次 GET 下发的会话 Cookie（服务端 Laravel 加密串，常被误称为 JWT）」作为邮箱凭证；
+ * 若共用全局 Cookie 罐，第二次 Generate 会带上旧会话，无法换到新邮箱。本客户端仅用于此类渠道。
+ */
+func HTTPClientNoCookieJar() tls_client.HttpClient {
+	return defaultInstance.httpClientNoCookieJar()
+}
+
+/*
+ * HTTPClientTenmailWangtz 供 10mail.wangtz.cn 使用：默认跳过 TLS 证书校验（与 curl --insecure 一致），
+ * 仍应用当前全局代理与超时。全局 Insecure 为 true 时行为一致。
+ */
+func HTTPClientTenmailWangtz() tls_client.HttpClient {
+	return defaultInstance.httpClientTenmailWangtz()
+}
+
+func (in *instance) httpClient() tls_client.HttpClient {
+	ver := in.getConfigVersion()
+	cache := &in.clients
 
-	clientMu.Lock()
-	defer clientMu.Unlock()
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
 
 	/* 缓存命中 */
-	if cachedClient != nil && cachedClientVersion == ver {
-		return cachedClient
+	if cache.client != nil && cache.version == ver {
+		return cache.client
 	}
 
 	/* 缓存未命中或配置已变更，重建客户端 */
-	cfg := GetConfig()
+	cfg := in.getConfig()
 	bc := RandomBrowserConfig()
-	setCurrentBrowser(bc)
+	in.setCurrentBrowser(bc)
 
-	sdkLogger.Debug("创建 TLS 客户端", "ua", bc.UA)
+	in.log().Debug("创建 TLS 客户端", "ua", bc.UA)
 
-	cachedClient = buildTLSClient(cfg, bc, true, true)
-	cachedClientVersion = ver
-	cachedNoCookieJarClient = nil /* 主客户端重建时一并失效无罐客户端 */
-	return cachedClient
+	cache.client = in.buildTLSClient(cfg, bc, true, true)
+	cache.version = ver
+	cache.noRedirectClient = nil
+	cache.noCookieJarClient = nil /* 主客户端重建时一并失效派生客户端 */
+	return cache.client
 }
 
-/*
- * HTTPClientNoRedirect 获取不跟随重定向的 TLS 客户端
- * 使用当前浏览器配置，用于需要捕获 Set-Cookie 等场景
- * 内部缓存复用，与主客户端同步失效
- */
-func HTTPClientNoRedirect() tls_client.HttpClient {
+func (in *instance) httpClientNoRedirect() tls_client.HttpClient {
 	/* 先确保主客户端已初始化（会设置 currentBrowser） */
-	HTTPClient()
+	in.httpClient()
 
-	configMu.RLock()
-	ver := configVersion
-	configMu.RUnlock()
+	ver := in.getConfigVersion()
+	cache := &in.clients
 
-	clientMu.Lock()
-	defer clientMu.Unlock()
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
 
-	if cachedNoRedirectClient != nil && cachedClientVersion == ver {
-		return cachedNoRedirectClient
+	if cache.noRedirectClient != nil && cache.version == ver {
+		return cache.noRedirectClient
 	}
 
-	cfg := GetConfig()
-	bc := GetCurrentBrowser()
-	cachedNoRedirectClient = buildTLSClient(cfg, bc, false, true)
-	return cachedNoRedirectClient
+	cfg := in.getConfig()
+	bc := in.currentBrowserConfig()
+	cache.noRedirectClient = in.buildTLSClient(cfg, bc, false, true)
+	return cache.noRedirectClient
 }
 
-/*
- * HTTPClientNoCookieJar 获取与主客户端相同 TLS 指纹与代理配置、但不使用 Cookie 罐的客户端。
- * tempmailg.com 等依赖「首次 GET 下发的会话 Cookie（服务端 Laravel 加密串，常被误称为 JWT）」作为邮箱凭证；
- * 若共用全局 Cookie 罐，第二次 Generate 会带上旧会话，无法换到新邮箱。本客户端仅用于此类渠道。
- */
-func HTTPClientNoCookieJar() tls_client.HttpClient {
-	HTTPClient()
+func (in *instance) httpClientNoCookieJar() tls_client.HttpClient {
+	in.httpClient()
 
-	configMu.RLock()
-	ver := configVersion
-	configMu.RUnlock()
+	ver := in.getConfigVersion()
+	cache := &in.clients
 
-	clientMu.Lock()
-	defer clientMu.Unlock()
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
 
-	if cachedNoCookieJarClient != nil && cachedClientVersion == ver {
-		return cachedNoCookieJarClient
+	if cache.noCookieJarClient != nil && cache.version == ver {
+		return cache.noCookieJarClient
 	}
 
-	cfg := GetConfig()
-	bc := GetCurrentBrowser()
-	sdkLogger.Debug("创建 TLS 客户端（无 Cookie 罐）", "ua", bc.UA)
-	cachedNoCookieJarClient = buildTLSClient(cfg, bc, true, false)
-	return cachedNoCookieJarClient
+	cfg := in.getConfig()
+	bc := in.currentBrowserConfig()
+	in.log().Debug("创建 TLS 客户端（无 Cookie 罐）", "ua", bc.UA)
+	cache.noCookieJarClient = in.buildTLSClient(cfg, bc, true, false)
+	return cache.noCookieJarClient
 }
 
-/*
- * HTTPClientTenmailWangtz 供 10mail.wangtz.cn 使用：默认跳过 TLS 证书校验（与 curl --insecure 一致），
- * 仍应用当前全局代理与超时。全局 Insecure 为 true 时行为一致。
- */
-func HTTPClientTenmailWangtz() tls_client.HttpClient {
-	configMu.RLock()
-	ver := configVersion
-	configMu.RUnlock()
+func (in *instance) httpClientTenmailWangtz() tls_client.HttpClient {
+	ver := in.getConfigVersion()
+	cache := &in.clients
 
-	tenmailWangtzClientMu.Lock()
-	defer tenmailWangtzClientMu.Unlock()
+	cache.tenmailWangtzMu.Lock()
+	defer cache.tenmailWangtzMu.Unlock()
 
-	if cachedTenmailWangtzClient != nil && cachedTenmailWangtzVer == ver {
-		return cachedTenmailWangtzClient
+	if cache.tenmailWangtzClient != nil && cache.tenmailWangtzVersion == ver {
+		return cache.tenmailWangtzClient
 	}
 
-	cfg := GetConfig()
-	cfgCopy := cfg
+	cfgCopy := in.getConfig()
 	cfgCopy.Insecure = true
-	bc := GetCurrentBrowser()
+	bc := in.currentBrowserConfig()
 
-	sdkLogger.Debug("创建 TLS 客户端（10mail-wangtz，跳过证书校验）", "ua", bc.UA)
-	cachedTenmailWangtzClient = buildTLSClient(cfgCopy, bc, true, true)
-	cachedTenmailWangtzVer = ver
-	return cachedTenmailWangtzClient
+	in.log().Debug("创建 TLS 客户端（10mail-wangtz，跳过证书校验）", "ua", bc.UA)
+	cache.tenmailWangtzClient = in.buildTLSClient(cfgCopy, bc, true, true)
+	cache.tenmailWangtzVersion = ver
+	return cache.tenmailWangtzClient
 }
@@ -0,0 +1,123 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	prov "github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
+)
+
+/*
+ * 错误分类
+ * GenerateEmail 返回的 error、GetEmailsResult.Err 均可用 errors.Is / errors.As 判断类别，
+ * 重试策略同样基于这些类别，而非错误消息文本。
+ *
+ * 示例:
+ *   var rl *ErrRateLimited
+ *   switch {
+ *   case errors.As(err, &rl):
+ *       time.Sleep(rl.RetryAfter)
+ *   case errors.Is(err, ErrCaptchaRequired):
+ *       // 换渠道
+ *   }
+ *
+ *   var se *HTTPStatusError
+ *   if errors.As(err, &se) { fmt.Println(se.StatusCode) }
+ */
+
+var (
+	/* 渠道暂不可用：服务端 5xx、后端熔断中、所有渠道均失败等（可重试） */
+	ErrChannelUnavailable = prov.ErrChannelUnavailable
+	/* 渠道要求人机验证（Cloudflare 质询等），重试无意义 */
+	ErrCaptchaRequired = prov.ErrCaptchaRequired
+	/* 邮箱已过期或已被服务端回收，应重新创建邮箱 */
+	ErrMailboxExpired = prov.ErrMailboxExpired
+	/* 会话令牌无效或鉴权失败 */
+	ErrInvalidToken = prov.ErrInvalidToken
+	/* 邮箱中不存在指定 ID 的邮件（GetEmail） */
+	ErrEmailNotFound = prov.ErrEmailNotFound
+	/* 渠道不支持该操作（如 DeleteEmail / DestroyMailbox），与标准库 errors.ErrUnsupported 相同 */
+	ErrUnsupported = errors.ErrUnsupported
+)
+
+/* ErrRateLimited 渠道限流，RetryAfter 为服务端建议的等待时长（未提供时为 0） */
+type ErrRateLimited = prov.ErrRateLimited
+
+/*
+ * HTTPStatusError 渠道返回 4xx/5xx 状态码
+ * 按状态码展开为上述分类：429 → *ErrRateLimited，401 → ErrInvalidToken，
+ * 410 → ErrMailboxExpired，5xx → ErrChannelUnavailable，Cloudflare 质询 → ErrCaptchaRequired
+ */
+type HTTPStatusError = prov.HTTPStatusError
+
+/* ErrBackendOpen 渠道所属后端熔断中，请求未发出；errors.Is(err, ErrChannelUnavailable) 为 true，见 BackendStatus */
+type ErrBackendOpen struct {
+	/* 熔断中的后端 */
+	Backend string
+	/* 距离冷却期结束（或试探请求超时）的时长 */
+	RetryAfter time.Duration
+}
+
+func (e *ErrBackendOpen) Error() string {
+	return fmt.Sprintf("backend %s circuit open, retry after %s", e.Backend, e.RetryAfter.Round(time.Second))
+}
+
+func (e *ErrBackendOpen) Unwrap() error { return ErrChannelUnavailable }
+
+/* isMailboxGone 邮箱已失效（过期或令牌无效），继续轮询没有意义 */
+func isMailboxGone(err error) bool {
+	return errors.Is(err, ErrMailboxExpired) || errors.Is(err, ErrInvalidToken)
+}
+
+/* ErrorClass 错误类别，便于记录与统计（如 ProbeChannels 报告），判断单个错误仍建议用 errors.Is / errors.As */
+type ErrorClass string
+
+const (
+	ErrorClassRateLimited    ErrorClass = "rate_limited"
+	ErrorClassCaptcha        ErrorClass = "captcha"
+	ErrorClassMailboxExpired ErrorClass = "mailbox_expired"
+	ErrorClassInvalidToken   ErrorClass = "invalid_token"
+	ErrorClassUnsupported    ErrorClass = "unsupported"
+	ErrorClassTimeout        ErrorClass = "timeout"
+	ErrorClassCanceled       ErrorClass = "canceled"
+	ErrorClassUnavailable    ErrorClass = "unavailable"
+	ErrorClassNetwork        ErrorClass = "network"
+	ErrorClassHTTPStatus     ErrorClass = "http_status"
+	ErrorClassUnknown        ErrorClass = "unknown"
+)
+
+/* ClassifyError 将错误归入 ErrorClass，err 为 nil 时返回空串 */
+func ClassifyError(err error) ErrorClass {
+	if err == nil {
+		return ""
+	}
+	var rateLimited *ErrRateLimited
+	var netErr net.Error
+	var statusErr *HTTPStatusError
+	switch {
+	case errors.As(err, &rateLimited):
+		return ErrorClassRateLimited
+	case errors.Is(err, ErrCaptchaRequired):
+		return ErrorClassCaptcha
+	case errors.Is(err, ErrMailboxExpired):
+		return ErrorClassMailboxExpired
+	case errors.Is(err, ErrInvalidToken):
+		return ErrorClassInvalidToken
+	case errors.Is(err, ErrUnsupported):
+		return ErrorClassUnsupported
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return ErrorClassTimeout
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, ErrChannelUnavailable):
+		return ErrorClassUnavailable
+	case errors.As(err, &statusErr):
+		return ErrorClassHTTPStatus
+	case shouldRetry(err):
+		return ErrorClassNetwork
+	}
+	return ErrorClassUnknown
+}
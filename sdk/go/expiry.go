@@ -0,0 +1,53 @@
+package tempemail
+
+import (
+	"time"
+)
+
+/*
+ * 过期时间
+ * 各渠道返回的过期时间格式不一（毫秒/秒时间戳、ISO 8601、"2006-01-02 15:04:05" 等），
+ * 创建邮箱时统一归一化为 RFC3339（UTC）字符串写入 EmailInfo.ExpiresAt，原始值保留在 ExpiresAtRaw 便于排查。
+ * 调用方应使用 ExpiresAtTime / TTL / IsExpired，而不是自行解析 ExpiresAt；
+ * 这些方法同样兼容旧版本会话中保存的时间戳形式。ExpiresAt 为创建时的期限，ExtendMailbox 续期后不更新，
+ * 续期后的期限见 ExtendMailbox 的返回值或 Lease.ExpiresAt。
+ *
+ * 示例:
+ *   if ttl := info.TTL(); ttl > 0 && ttl < time.Minute {
+ *       // 即将过期，换一个邮箱
+ *   }
+ */
+
+/* normalizeExpiresAt 将渠道返回的过期时间归一化为 RFC3339（UTC）字符串，无法解析时原样返回 */
+func normalizeExpiresAt(v any) any {
+	if t, ok := parseTimeValue(v); ok {
+		return t.UTC().Format(time.RFC3339)
+	}
+	if s, ok := v.(string); ok && s == "" {
+		return nil
+	}
+	return v
+}
+
+/* ExpiresAtTime 解析后的过期时间，渠道未返回或无法解析时 ok 为 false */
+func (info *EmailInfo) ExpiresAtTime() (time.Time, bool) {
+	if info == nil {
+		return time.Time{}, false
+	}
+	return parseTimeValue(info.ExpiresAt)
+}
+
+/* TTL 距过期的剩余时长，已过期时为负值；过期时间未知时返回 0 */
+func (info *EmailInfo) TTL() time.Duration {
+	t, ok := info.ExpiresAtTime()
+	if !ok {
+		return 0
+	}
+	return time.Until(t)
+}
+
+/* IsExpired 邮箱是否已过期；过期时间未知时返回 false */
+func (info *EmailInfo) IsExpired() bool {
+	t, ok := info.ExpiresAtTime()
+	return ok && !time.Now().Before(t)
+}
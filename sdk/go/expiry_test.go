@@ -0,0 +1,47 @@
+package tempemail
+
+import (
+	"testing"
+	"time"
+
+	"github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
+)
+
+/*
+ * TestExpiryAndReceivedAt 校验过期时间归一化（毫秒时间戳、ISO 8601、本地时间字符串）、
+ * 原始值保留、TTL / IsExpired 以及旧会话中时间戳形式的兼容，和 Email.ReceivedAt 的解析
+ */
+func TestExpiryAndReceivedAt(t *testing.T) {
+	future := time.Now().Add(time.Hour).Truncate(time.Second)
+	for _, raw := range []any{
+		future.UnixMilli(),
+		float64(future.Unix()),
+		future.Format(time.RFC3339),
+		future.Local().Format("2006-01-02 15:04:05"),
+	} {
+		info := mailboxToEmailInfo(&provider.CreatedMailbox{Channel: "test", Email: "a@x", ExpiresAt: raw})
+		if info.ExpiresAt != future.UTC().Format(time.RFC3339) || info.ExpiresAtRaw != raw {
+			t.Fatalf("归一化 %v: %v / %v", raw, info.ExpiresAt, info.ExpiresAtRaw)
+		}
+		if ttl := info.TTL(); ttl <= 59*time.Minute || ttl > time.Hour || info.IsExpired() {
+			t.Fatalf("TTL %v: %v", raw, ttl)
+		}
+	}
+
+	legacy := &EmailInfo{ExpiresAt: time.Now().Add(-time.Minute).UnixMilli()}
+	if !legacy.IsExpired() || legacy.TTL() >= 0 {
+		t.Fatalf("旧会话毫秒时间戳应视为已过期: %v", legacy.TTL())
+	}
+	unknown := mailboxToEmailInfo(&provider.CreatedMailbox{Channel: "test", Email: "a@x", ExpiresAt: ""})
+	if _, ok := unknown.ExpiresAtTime(); ok || unknown.ExpiresAt != nil || unknown.TTL() != 0 || unknown.IsExpired() {
+		t.Fatalf("未知过期时间: %+v", unknown)
+	}
+
+	e := normalizeRawEmail(map[string]interface{}{"date": "Mon, 02 Jan 2006 15:04:05 -0700"}, "a@x")
+	if !e.ReceivedAt.Equal(time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC)) {
+		t.Fatalf("ReceivedAt: %v", e.ReceivedAt)
+	}
+	if got := normToEmail(provider.NormEmail{Date: "2026-10-17T08:00:00Z"}); got.ReceivedAt.Hour() != 8 {
+		t.Fatalf("ReceivedAt: %v", got.ReceivedAt)
+	}
+}
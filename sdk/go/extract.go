@@ -0,0 +1,388 @@
+package tempemail
+
+import (
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+/*
+ * 验证码与链接提取
+ * 基于启发式打分：候选项按格式、提示词距离、所在位置等特征累加/扣减置信度，
+ * 结果按置信度从高到低排序。置信度为 0~1 之间的相对值，仅用于排序与阈值过滤。
+ *
+ * 示例:
+ *   if codes := email.ExtractCodes(); len(codes) > 0 {
+ *       fmt.Println("验证码:", codes[0].Code)
+ *   }
+ *   if links := email.ExtractLinks(); len(links) > 0 {
+ *       fmt.Println("确认链接:", links[0].URL)
+ *   }
+ */
+
+/*
+ * VerificationCode 从邮件中提取的验证码候选
+ */
+type VerificationCode struct {
+	/* 验证码（分组形式如 "123 456" 已合并为 "123456"） */
+	Code string `json:"code"`
+	/* 置信度 0~1 */
+	Confidence float64 `json:"confidence"`
+	/* 来源：subject / text */
+	Source string `json:"source"`
+	/* 验证码周边的上下文片段 */
+	Context string `json:"context,omitempty"`
+}
+
+/* 链接类型 */
+const (
+	/* 带按钮样式的主操作链接 */
+	LinkKindButton = "button"
+	/* 普通正文链接 */
+	LinkKindLink = "link"
+	/* 退订、隐私、社交等页脚链接 */
+	LinkKindFooter = "footer"
+)
+
+/*
+ * EmailLink 从邮件中提取的链接候选
+ */
+type EmailLink struct {
+	/* 链接地址（已反转义 HTML 实体） */
+	URL string `json:"url"`
+	/* 锚文本，纯文本中的链接为空 */
+	Text string `json:"text,omitempty"`
+	/* 链接类型：button / link / footer */
+	Kind string `json:"kind"`
+	/* 置信度 0~1，越高越可能是验证/登录等主操作链接 */
+	Confidence float64 `json:"confidence"`
+}
+
+/* 验证码提取阈值：低于该置信度的候选不返回 */
+const codeMinConfidence = 0.3
+
+var (
+	/* 纯数字：3+3 / 4+4 分组或 4~8 位；分组在前，否则 "1234 5678" 会先被拆成两个 4 位码 */
+	codeDigitsRe  = regexp.MustCompile(`\b(\d{4}[ -]\d{4}|\d{3}[ -]\d{3}|\d{4,8})\b`)
+	codeAlnumRe   = regexp.MustCompile(`\b([A-Za-z0-9]{4,8})\b`)
+	codeURLRe     = regexp.MustCompile(`(?i)https?://\S+|[\w.+-]+@[\w-]+\.[\w.-]+`)
+	codeYearRe    = regexp.MustCompile(`^(19|20)\d{2}$`)
+	codeHasLetter = regexp.MustCompile(`[A-Za-z]`)
+	codeHasDigit  = regexp.MustCompile(`\d`)
+)
+
+/* 验证码提示词（小写），出现在候选附近时显著提升置信度 */
+var codeCueWords = []string{
+	"验证码", "校验码", "动态码", "确认码", "安全码", "激活码", "登录码", "驗證碼", "代码", "口令",
+	"verification code", "security code", "confirmation code", "login code", "one-time",
+	"passcode", "otp", "code", "pin", "token", "verify",
+}
+
+/* 降低置信度的上下文词：订单号、电话、金额等常见数字 */
+var codeNegativeWords = []string{
+	"order", "invoice", "phone", "tel:", "订单", "电话", "金额", "价格", "¥", "$", "#",
+}
+
+/*
+ * ExtractCodes 提取邮件中的验证码候选，按置信度降序
+ * 同时检查主题与正文（无纯文本时由 HTML 转换），同一验证码只保留最高分
+ */
+func (e Email) ExtractCodes() []VerificationCode {
+	best := make(map[string]*VerificationCode)
+	var order []string
+	add := func(c VerificationCode) {
+		if prev, ok := best[c.Code]; ok {
+			/* 同一验证码在主题与正文中重复出现，略微加分 */
+			if c.Confidence > prev.Confidence {
+				c.Confidence = clampConfidence(c.Confidence + 0.05)
+				*prev = c
+			} else {
+				prev.Confidence = clampConfidence(prev.Confidence + 0.05)
+			}
+			return
+		}
+		cc := c
+		best[c.Code] = &cc
+		order = append(order, c.Code)
+	}
+
+	for _, c := range scanCodes(e.Subject, "subject") {
+		c.Confidence = clampConfidence(c.Confidence + 0.1)
+		add(c)
+	}
+	body := e.Text
+	if strings.TrimSpace(body) == "" && e.HTML != "" {
+		body = htmlToText(e.HTML)
+	}
+	for _, c := range scanCodes(body, "text") {
+		add(c)
+	}
+
+	out := make([]VerificationCode, 0, len(order))
+	for _, code := range order {
+		if c := best[code]; c.Confidence >= codeMinConfidence {
+			out = append(out, *c)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Confidence > out[j].Confidence })
+	return out
+}
+
+/* scanCodes 在一段文本中扫描验证码候选并打分（未过滤阈值） */
+func scanCodes(text, source string) []VerificationCode {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	/* URL 与邮箱地址中的数字不视为验证码 */
+	masked := codeURLRe.ReplaceAllStringFunc(text, func(s string) string {
+		return strings.Repeat(" ", len(s))
+	})
+	lower := strings.ToLower(masked)
+
+	var out []VerificationCode
+	seenAt := make(map[int]bool)
+	for _, loc := range codeDigitsRe.FindAllStringSubmatchIndex(masked, -1) {
+		start, end := loc[2], loc[3]
+		raw := masked[start:end]
+		code := strings.NewReplacer(" ", "", "-", "").Replace(raw)
+		score := 0.25
+		switch {
+		case len(code) == 6:
+			score = 0.4
+		case raw != code:
+			/* 分组数字（123 456）几乎只出现在验证码场景 */
+			score = 0.45
+		}
+		if len(code) == 4 && codeYearRe.MatchString(code) {
+			score -= 0.3
+		}
+		if strings.Count(code, code[:1]) == len(code) {
+			score -= 0.1
+		}
+		score += codeContextScore(lower, start, end)
+		seenAt[start] = true
+		out = append(out, VerificationCode{
+			Code:       code,
+			Confidence: clampConfidence(score),
+			Source:     source,
+			Context:    codeSnippet(text, start, end),
+		})
+	}
+	for _, loc := range codeAlnumRe.FindAllStringSubmatchIndex(masked, -1) {
+		start, end := loc[2], loc[3]
+		code := masked[start:end]
+		if seenAt[start] || !codeHasLetter.MatchString(code) || !codeHasDigit.MatchString(code) {
+			continue
+		}
+		/* 字母数字混合码：无提示词时基本不可信 */
+		score := 0.1
+		if code == strings.ToUpper(code) {
+			score += 0.05
+		}
+		score += codeContextScore(lower, start, end)
+		out = append(out, VerificationCode{
+			Code:       code,
+			Confidence: clampConfidence(score),
+			Source:     source,
+			Context:    codeSnippet(text, start, end),
+		})
+	}
+	return out
+}
+
+/*
+ * codeContextScore 依据候选前后的提示词计算加减分
+ * 前方 90 字节内出现提示词加分较多（"验证码：123456"），后方 40 字节内次之（"123456 is your code"）
+ */
+func codeContextScore(lower string, start, end int) float64 {
+	before := lower[max(0, start-90):start]
+	after := lower[end:min(len(lower), end+40)]
+	score := 0.0
+	for _, w := range codeCueWords {
+		if strings.Contains(before, w) {
+			score += 0.35
+			break
+		}
+	}
+	for _, w := range codeCueWords {
+		if strings.Contains(after, w) {
+			score += 0.2
+			break
+		}
+	}
+	near := lower[max(0, start-20):start]
+	for _, w := range codeNegativeWords {
+		if strings.Contains(near, w) {
+			score -= 0.3
+			break
+		}
+	}
+	/* 后接百分号，或经小数点、冒号接数字（金额、比例、时间）时降分 */
+	if end < len(lower) {
+		next := lower[end]
+		if next == '%' || ((next == '.' || next == ':') && end+1 < len(lower) && lower[end+1] >= '0' && lower[end+1] <= '9') {
+			score -= 0.3
+		}
+	}
+	return score
+}
+
+/* codeSnippet 截取候选周边约 30 字节的上下文（按 rune 边界对齐） */
+func codeSnippet(text string, start, end int) string {
+	from := max(0, start-30)
+	to := min(len(text), end+30)
+	for from > 0 && !isRuneStart(text[from]) {
+		from--
+	}
+	for to < len(text) && !isRuneStart(text[to]) {
+		to++
+	}
+	return strings.Join(strings.Fields(text[from:to]), " ")
+}
+
+func isRuneStart(b byte) bool { return b&0xC0 != 0x80 }
+
+var (
+	linkAnchorRe = regexp.MustCompile(`(?is)<a\b([^>]*)>(.*?)</a>`)
+	linkHrefRe   = regexp.MustCompile(`(?is)\bhref\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+	linkPlainRe  = regexp.MustCompile(`https?://[^\s<>"'()\[\]]+`)
+	linkAssetRe  = regexp.MustCompile(`(?i)\.(png|jpe?g|gif|svg|webp|ico|css|js)(\?|$)`)
+	linkTokenRe  = regexp.MustCompile(`(?i)[?&](token|code|key|t|otp|signature|sig|auth|hash|ticket)=|/[A-Za-z0-9_-]{24,}`)
+)
+
+/* 主操作提示词：出现在锚文本或 URL 中时加分 */
+var linkActionWords = []string{
+	"verify", "verification", "confirm", "activate", "validate", "reset", "password",
+	"login", "log in", "sign in", "signin", "magic", "continue", "get started", "accept", "invite",
+	"验证", "确认", "激活", "登录", "登入", "重置", "找回", "完成注册", "立即",
+}
+
+/* 页脚提示词：退订、隐私、社交等 */
+var linkFooterWords = []string{
+	"unsubscribe", "preferences", "privacy", "terms", "help", "support", "contact", "view in browser",
+	"facebook.com", "twitter.com", "x.com/", "linkedin.com", "instagram.com", "youtube.com",
+	"退订", "取消订阅", "隐私", "条款", "帮助", "联系我们",
+}
+
+/*
+ * ExtractLinks 提取邮件中的链接，按置信度降序
+ * HTML 锚点优先：带按钮样式或操作提示词的链接置信度高，退订/隐私/社交等页脚链接置信度低；
+ * 无 HTML 时从纯文本中提取 URL。图片、样式等静态资源与 mailto 链接被忽略。
+ */
+func (e Email) ExtractLinks() []EmailLink {
+	best := make(map[string]*EmailLink)
+	var order []string
+	add := func(l EmailLink) {
+		if prev, ok := best[l.URL]; ok {
+			if l.Confidence > prev.Confidence {
+				*prev = l
+			}
+			return
+		}
+		ll := l
+		best[l.URL] = &ll
+		order = append(order, l.URL)
+	}
+
+	if e.HTML != "" {
+		total := len(e.HTML)
+		for _, m := range linkAnchorRe.FindAllStringSubmatchIndex(e.HTML, -1) {
+			attrs := e.HTML[m[2]:m[3]]
+			href := anchorHref(attrs)
+			if !isUsefulLink(href) {
+				continue
+			}
+			text := htmlToText(e.HTML[m[4]:m[5]])
+			add(scoreLink(href, text, attrs, float64(m[0])/float64(total)))
+		}
+	}
+	if len(order) == 0 || strings.TrimSpace(e.HTML) == "" {
+		text := e.Text
+		for _, loc := range linkPlainRe.FindAllStringIndex(text, -1) {
+			href := strings.TrimRight(text[loc[0]:loc[1]], ".,;:!?")
+			if !isUsefulLink(href) {
+				continue
+			}
+			add(scoreLink(href, "", "", float64(loc[0])/float64(max(1, len(text)))))
+		}
+	}
+
+	out := make([]EmailLink, 0, len(order))
+	for _, u := range order {
+		out = append(out, *best[u])
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Confidence > out[j].Confidence })
+	return out
+}
+
+/* anchorHref 从 <a> 标签属性中取出 href 并反转义 */
+func anchorHref(attrs string) string {
+	m := linkHrefRe.FindStringSubmatch(attrs)
+	if m == nil {
+		return ""
+	}
+	for _, v := range m[1:] {
+		if v != "" {
+			return strings.TrimSpace(html.UnescapeString(v))
+		}
+	}
+	return ""
+}
+
+func isUsefulLink(href string) bool {
+	lower := strings.ToLower(href)
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+		return false
+	}
+	return !linkAssetRe.MatchString(lower)
+}
+
+/*
+ * scoreLink 计算单个链接的置信度与类型
+ * position 为链接在正文中的相对位置（0~1），越靠后越可能是页脚
+ */
+func scoreLink(href, text, attrs string, position float64) EmailLink {
+	lowerURL := strings.ToLower(href)
+	lowerText := strings.ToLower(text)
+	lowerAttrs := strings.ToLower(attrs)
+
+	kind := LinkKindLink
+	score := 0.3
+	if strings.Contains(lowerAttrs, "background") || strings.Contains(lowerAttrs, "btn") ||
+		strings.Contains(lowerAttrs, "button") || strings.Contains(lowerAttrs, "border-radius") ||
+		strings.Contains(lowerAttrs, "padding") {
+		kind = LinkKindButton
+		score += 0.25
+	}
+	for _, w := range linkActionWords {
+		if strings.Contains(lowerText, w) || strings.Contains(lowerURL, w) {
+			score += 0.25
+			break
+		}
+	}
+	if linkTokenRe.MatchString(href) {
+		score += 0.15
+	}
+	for _, w := range linkFooterWords {
+		if strings.Contains(lowerText, w) || strings.Contains(lowerURL, w) {
+			kind = LinkKindFooter
+			score -= 0.4
+			break
+		}
+	}
+	if position > 0.75 {
+		score -= 0.1
+	}
+	return EmailLink{URL: href, Text: text, Kind: kind, Confidence: clampConfidence(score)}
+}
+
+func clampConfidence(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return float64(int(v*100+0.5)) / 100
+}
@@ -0,0 +1,53 @@
+package tempemail
+
+import "testing"
+
+/*
+ * TestExtractCodesAndLinks 校验验证码按提示词排序优先、年份/订单号降权，
+ * 以及按钮链接排在页脚退订链接之前
+ */
+func TestExtractCodesAndLinks(t *testing.T) {
+	email := Email{
+		Subject: "Your account (order 20240917)",
+		Text:    "© 2026 Example Inc.\n您的验证码是：482913，10 分钟内有效。\n订单号 778812",
+		HTML: `<p>Hi</p>
+<a href="https://example.com/verify?token=abcDEF123&amp;u=1" style="background:#1a73e8;padding:12px 24px">Verify email</a>
+<p>Questions? <a href="https://example.com/help">Help center</a></p>
+<a href="https://example.com/unsubscribe?u=1">Unsubscribe</a>
+<img src="https://example.com/logo.png">`,
+	}
+
+	codes := email.ExtractCodes()
+	if len(codes) == 0 || codes[0].Code != "482913" {
+		t.Fatalf("期望首个验证码为 482913，实际 %+v", codes)
+	}
+	for _, c := range codes {
+		if c.Code == "2026" {
+			t.Fatalf("年份不应作为验证码返回: %+v", c)
+		}
+	}
+
+	links := email.ExtractLinks()
+	if len(links) != 3 {
+		t.Fatalf("期望 3 个链接（忽略图片），实际 %+v", links)
+	}
+	if links[0].URL != "https://example.com/verify?token=abcDEF123&u=1" || links[0].Kind != LinkKindButton {
+		t.Fatalf("期望按钮链接排首位，实际 %+v", links[0])
+	}
+	last := links[len(links)-1]
+	if last.Kind != LinkKindFooter {
+		t.Fatalf("期望页脚链接排末位，实际 %+v", last)
+	}
+
+	for text, want := range map[string]string{
+		"Your code: 123 456":   "123456",
+		"Your code: 123-456":   "123456",
+		"Your code: 1234 5678": "12345678",
+		"Your code: 1234-5678": "12345678",
+	} {
+		grouped := Email{Text: text}.ExtractCodes()
+		if len(grouped) != 1 || grouped[0].Code != want {
+			t.Fatalf("%q: 分组验证码应合并为 %s，实际 %+v", text, want, grouped)
+		}
+	}
+}
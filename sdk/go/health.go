@@ -0,0 +1,302 @@
+package tempemail
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+/*
+ * 渠道健康度与自适应排序
+ * 每个实例按渠道记录创建邮箱与收信的滚动成功率（指数加权，近期结果权重更高）及成功请求的平均耗时，
+ * 未指定渠道时 GenerateEmail 按得分加权随机排列候选渠道：得分高的渠道更可能排在前面，
+ * 长期失败的渠道仍保留很小的权重被偶尔尝试（探索），恢复后得分随之回升；
+ * 没有任何统计时等价于均匀打乱，与旧版本行为一致。
+ *
+ * 设置 SDKConfig.ChannelStatsFile（或环境变量 TEMPMAIL_CHANNEL_STATS_FILE）后统计跨进程保留：
+ * 首次使用时加载，有新记录时至多每 30 秒写盘一次；退出前可调用 SaveChannelStats 立即写入。
+ *
+ * 示例:
+ *   tempemail.SetConfig(tempemail.SDKConfig{ChannelStatsFile: "/var/lib/app/channel-stats.json"})
+ *   defer tempemail.SaveChannelStats()
+ *   for _, s := range tempemail.ChannelStats() {
+ *       fmt.Printf("%s %.2f %.0f%% %v\n", s.Channel, s.Score, s.GenerateSuccessRate*100, s.GenerateLatency)
+ *   }
+ */
+
+const (
+	/* healthAlpha 指数加权系数：每个新结果占 20% */
+	healthAlpha = 0.2
+	/* healthPriorRate 尚无创建记录的渠道的假定成功率 */
+	healthPriorRate = 0.5
+	/* healthMinWeight 排序时的最小权重，保证失败渠道仍有机会被探索 */
+	healthMinWeight = 0.02
+	/* healthLatencyScale 耗时惩罚尺度：平均耗时为该值时得分减半 */
+	healthLatencyScale = 10 * time.Second
+	/* healthSaveInterval 持久化的最小写盘间隔 */
+	healthSaveInterval = 30 * time.Second
+	/* channelStatsVersion 持久化文件格式版本 */
+	channelStatsVersion = 1
+)
+
+/* ChannelStat 渠道健康度统计 */
+type ChannelStat struct {
+	/* 渠道标识 */
+	Channel Channel `json:"channel"`
+	/* 排序得分（0~1），综合创建成功率、收信成功率与创建耗时 */
+	Score float64 `json:"score"`
+	/* 创建邮箱的滚动成功率 */
+	GenerateSuccessRate float64 `json:"generateSuccessRate"`
+	/* 创建邮箱成功时的平均耗时（含重试） */
+	GenerateLatency time.Duration `json:"generateLatency"`
+	/* 创建邮箱的累计尝试次数 */
+	GenerateSamples int `json:"generateSamples"`
+	/* 收信的滚动成功率 */
+	ReadSuccessRate float64 `json:"readSuccessRate"`
+	/* 收信成功时的平均耗时（含重试） */
+	ReadLatency time.Duration `json:"readLatency"`
+	/* 收信的累计请求次数 */
+	ReadSamples int `json:"readSamples"`
+	/* 最近一次记录时间 */
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+/* channelStatsFile 持久化文件结构 */
+type channelStatsFile struct {
+	Version  int           `json:"version"`
+	Channels []ChannelStat `json:"channels"`
+}
+
+/* channelHealth 实例内的渠道健康度统计，并发安全 */
+type channelHealth struct {
+	mu         sync.Mutex
+	stats      map[Channel]*ChannelStat
+	loadedPath string /* 已加载的持久化文件 */
+	dirty      bool
+	lastSave   time.Time
+
+	saveMu sync.Mutex /* 串行化写盘 */
+}
+
+/* observe 将一次结果计入滚动成功率与平均耗时，耗时只统计成功请求 */
+func observe(rate *float64, latency *time.Duration, samples *int, ok bool, d time.Duration) {
+	x := 0.0
+	if ok {
+		x = 1
+	}
+	if *samples == 0 {
+		*rate = x
+	} else {
+		*rate = *rate*(1-healthAlpha) + x*healthAlpha
+	}
+	*samples++
+	if ok {
+		if *latency == 0 {
+			*latency = d
+		} else {
+			*latency = time.Duration(float64(*latency)*(1-healthAlpha) + float64(d)*healthAlpha)
+		}
+	}
+}
+
+/* channelScore 排序得分：创建成功率 ×（收信成功率折半计入）÷ 耗时惩罚 */
+func channelScore(s *ChannelStat) float64 {
+	gen := healthPriorRate
+	if s.GenerateSamples > 0 {
+		gen = s.GenerateSuccessRate
+	}
+	read := 1.0
+	if s.ReadSamples > 0 {
+		read = s.ReadSuccessRate
+	}
+	return gen * (0.5 + 0.5*read) / (1 + float64(s.GenerateLatency)/float64(healthLatencyScale))
+}
+
+func (h *channelHealth) record(ch Channel, generate, ok bool, d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.stats == nil {
+		h.stats = make(map[Channel]*ChannelStat)
+	}
+	s, exists := h.stats[ch]
+	if !exists {
+		s = &ChannelStat{Channel: ch}
+		h.stats[ch] = s
+	}
+	if generate {
+		observe(&s.GenerateSuccessRate, &s.GenerateLatency, &s.GenerateSamples, ok, d)
+	} else {
+		observe(&s.ReadSuccessRate, &s.ReadLatency, &s.ReadSamples, ok, d)
+	}
+	s.UpdatedAt = time.Now()
+	h.dirty = true
+}
+
+/*
+ * order 按得分加权随机排列渠道（Efraimidis-Spirakis 加权抽样：键为 Exp(1)/权重，升序）
+ * 无统计的渠道得分相同，全部无统计时等价于均匀打乱
+ */
+func (h *channelHealth) order(channels []Channel) []Channel {
+	type keyed struct {
+		ch  Channel
+		key float64
+	}
+	unknown := channelScore(&ChannelStat{})
+	items := make([]keyed, len(channels))
+	h.mu.Lock()
+	for i, ch := range channels {
+		w := unknown
+		if s, ok := h.stats[ch]; ok {
+			w = channelScore(s)
+		}
+		items[i] = keyed{ch: ch, key: rand.ExpFloat64() / math.Max(w, healthMinWeight)}
+	}
+	h.mu.Unlock()
+	sort.Slice(items, func(i, j int) bool { return items[i].key < items[j].key })
+	out := make([]Channel, len(items))
+	for i, it := range items {
+		out[i] = it.ch
+	}
+	return out
+}
+
+/* snapshot 全部统计的副本（含得分），按得分从高到低 */
+func (h *channelHealth) snapshot() []ChannelStat {
+	h.mu.Lock()
+	out := make([]ChannelStat, 0, len(h.stats))
+	for _, s := range h.stats {
+		c := *s
+		c.Score = channelScore(s)
+		out = append(out, c)
+	}
+	h.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		return out[i].Channel < out[j].Channel
+	})
+	return out
+}
+
+/* load 首次使用某个持久化文件时读入其中的统计；内存中已有记录的渠道以内存为准 */
+func (h *channelHealth) load(path string) error {
+	h.mu.Lock()
+	if path == "" || path == h.loadedPath {
+		h.mu.Unlock()
+		return nil
+	}
+	h.loadedPath = path
+	h.mu.Unlock()
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var f channelStatsFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("invalid channel stats file %s: %w", path, err)
+	}
+	if f.Version != channelStatsVersion {
+		return fmt.Errorf("unsupported channel stats version %d", f.Version)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.stats == nil {
+		h.stats = make(map[Channel]*ChannelStat)
+	}
+	for i := range f.Channels {
+		s := f.Channels[i]
+		if _, ok := h.stats[s.Channel]; ok || s.Channel == "" {
+			continue
+		}
+		h.stats[s.Channel] = &s
+	}
+	return nil
+}
+
+/* save 写入持久化文件（先写临时文件再重命名，避免中途退出留下半个文件）；force 为 false 时受写盘间隔限制 */
+func (h *channelHealth) save(path string, force bool) error {
+	if path == "" {
+		return nil
+	}
+	h.saveMu.Lock()
+	defer h.saveMu.Unlock()
+
+	h.mu.Lock()
+	if !h.dirty || (!force && time.Since(h.lastSave) < healthSaveInterval) {
+		h.mu.Unlock()
+		return nil
+	}
+	h.dirty = false
+	h.lastSave = time.Now()
+	h.mu.Unlock()
+
+	data, err := json.MarshalIndent(channelStatsFile{Version: channelStatsVersion, Channels: h.snapshot()}, "", "  ")
+	if err == nil {
+		tmp := path + ".tmp"
+		if err = os.MkdirAll(filepath.Dir(path), 0o755); err == nil {
+			if err = os.WriteFile(tmp, data, 0o644); err == nil {
+				err = os.Rename(tmp, path)
+			}
+		}
+	}
+	if err != nil {
+		h.mu.Lock()
+		h.dirty = true
+		h.mu.Unlock()
+	}
+	return err
+}
+
+/* loadChannelStats 按实例配置加载持久化统计，失败只记录日志 */
+func (in *instance) loadChannelStats() {
+	if err := in.health.load(in.getConfig().ChannelStatsFile); err != nil {
+		in.log().Warn("加载渠道统计失败", "error", err.Error())
+	}
+}
+
+/* recordChannelResult 记录一次创建（generate 为 true）或收信结果，并按间隔写盘 */
+func (in *instance) recordChannelResult(ch Channel, generate, ok bool, d time.Duration) {
+	in.loadChannelStats()
+	in.health.record(ch, generate, ok, d)
+	if err := in.health.save(in.getConfig().ChannelStatsFile, false); err != nil {
+		in.log().Warn("保存渠道统计失败", "error", err.Error())
+	}
+}
+
+func (in *instance) channelStats() []ChannelStat {
+	in.loadChannelStats()
+	return in.health.snapshot()
+}
+
+/* ChannelStats 默认实例的渠道健康度统计（仅含有记录的渠道），按得分从高到低 */
+func ChannelStats() []ChannelStat {
+	return defaultInstance.channelStats()
+}
+
+/* ChannelStats 该客户端所属实例的渠道健康度统计，见 ChannelStats */
+func (c *Client) ChannelStats() []ChannelStat {
+	return c.instance().channelStats()
+}
+
+/* SaveChannelStats 立即将默认实例的渠道统计写入 SDKConfig.ChannelStatsFile，未配置时不做任何事 */
+func SaveChannelStats() error {
+	return defaultInstance.health.save(defaultInstance.getConfig().ChannelStatsFile, true)
+}
+
+/* SaveChannelStats 立即写入该客户端所属实例的渠道统计，见 SaveChannelStats */
+func (c *Client) SaveChannelStats() error {
+	in := c.instance()
+	return in.health.save(in.getConfig().ChannelStatsFile, true)
+}
@@ -0,0 +1,69 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+/*
+ * TestChannelHealthOrdering 校验渠道健康度：创建与收信结果计入统计、
+ * 长期失败的渠道排序靠后但仍可能被探索、统计写盘后由新实例加载
+ */
+func TestChannelHealthOrdering(t *testing.T) {
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "health-good",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return &EmailInfo{Channel: "health-good", Email: "good@x"}, nil
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) { return nil, nil },
+	})
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "health-bad",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return nil, errors.New("boom")
+		},
+	})
+	saved := allChannels
+	allChannels = []Channel{"health-good", "health-bad"}
+	t.Cleanup(func() { allChannels = saved })
+
+	off := false
+	file := filepath.Join(t.TempDir(), "stats.json")
+	client := NewClient(WithConfig(SDKConfig{TelemetryEnabled: &off, ChannelStatsFile: file}))
+	for i := 0; i < 5; i++ {
+		if _, err := client.Generate(&GenerateEmailOptions{Channel: "health-bad"}); err != nil {
+			t.Fatalf("Generate: %v", err)
+		}
+	}
+	info, _ := client.Generate(&GenerateEmailOptions{Channel: "health-good"})
+	if r, _ := info.GetEmails(nil); !r.Success {
+		t.Fatalf("GetEmails 失败: %v", r.Err)
+	}
+
+	stats := client.ChannelStats()
+	if len(stats) != 2 || stats[0].Channel != "health-good" || stats[0].ReadSamples != 1 ||
+		stats[1].GenerateSamples != 5 || stats[1].GenerateSuccessRate != 0 {
+		t.Fatalf("统计不符: %+v", stats)
+	}
+
+	in := client.instance()
+	badFirst := 0
+	for i := 0; i < 500; i++ {
+		if in.buildChannelOrder("")[0] == "health-bad" {
+			badFirst++
+		}
+	}
+	if badFirst == 0 || badFirst > 50 {
+		t.Fatalf("失败渠道排在首位 %d/500 次，期望少量探索", badFirst)
+	}
+
+	if err := client.SaveChannelStats(); err != nil {
+		t.Fatalf("SaveChannelStats: %v", err)
+	}
+	restored := NewClient(WithConfig(SDKConfig{TelemetryEnabled: &off, ChannelStatsFile: file})).ChannelStats()
+	if len(restored) != 2 || restored[1].GenerateSamples != 5 || restored[0].Score != stats[0].Score {
+		t.Fatalf("加载的统计不符: %+v", restored)
+	}
+}
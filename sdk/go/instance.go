@@ -0,0 +1,97 @@
+package tempemail
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+ * SDK 实例
+ * 配置、TLS 客户端缓存、后端熔断状态、渠道健康度统计与 logger 归属于实例，互不影响：
+ * 同一进程内不同模块可使用不同的代理、超时与日志输出。
+ *
+ * 包级函数（GenerateEmail / SetConfig / SetLogger 等）作用于默认实例；
+ * NewClient() 不带选项时同样共享默认实例，带选项时创建独立实例。
+ * EmailInfo 记录创建它的实例，后续 GetEmails 等调用沿用同一实例的代理与会话。
+ *
+ * 示例:
+ *   client := tempemail.NewClient(
+ *       tempemail.WithConfig(tempemail.SDKConfig{Proxy: "socks5://127.0.0.1:1080"}),
+ *       tempemail.WithLogLevel(tempemail.LogLevelInfo),
+ *   )
+ *   info, _ := client.Generate(nil)
+ */
+type instance struct {
+	configMu      sync.RWMutex
+	config        SDKConfig
+	configVersion uint64 /* 配置版本号，每次 setConfig 递增，用于使 TLS 客户端缓存失效 */
+
+	clients httpClientCache
+	breaker circuitBreaker
+	health  channelHealth
+	limiter rateLimiter
+	logger  atomic.Pointer[slog.Logger]
+}
+
+/* defaultInstance 包级函数使用的默认实例，启动时从环境变量读取配置，日志默认静默 */
+var defaultInstance = newInstance(envConfig(), nil)
+
+func newInstance(cfg SDKConfig, logger *slog.Logger) *instance {
+	in := &instance{config: cfg}
+	in.setLogger(logger)
+	return in
+}
+
+/* ClientOption NewClient 的配置项 */
+type ClientOption func(*instance)
+
+/*
+ * WithConfig 为客户端指定独立配置（代理、超时、遥测等）
+ * 未指定时沿用创建时刻默认实例的配置
+ */
+func WithConfig(config SDKConfig) ClientOption {
+	return func(in *instance) {
+		in.config = config
+	}
+}
+
+/* WithLogger 为客户端指定独立 logger，nil 表示静默 */
+func WithLogger(l *slog.Logger) ClientOption {
+	return func(in *instance) {
+		in.setLogger(l)
+	}
+}
+
+/* WithLogLevel 客户端日志输出到 stderr 并设置级别 */
+func WithLogLevel(level slog.Level) ClientOption {
+	return func(in *instance) {
+		in.setLogger(newSDKLogger(level))
+	}
+}
+
+/* instanceCtxKey ctx 中携带当前实例的键，provider 经桥接函数据此取得实例的 HTTP 客户端与配置 */
+type instanceCtxKey struct{}
+
+func withInstance(ctx context.Context, in *instance) context.Context {
+	return context.WithValue(ctx, instanceCtxKey{}, in)
+}
+
+/* instanceFromContext 取出 ctx 携带的实例，未携带时返回默认实例 */
+func instanceFromContext(ctx context.Context) *instance {
+	if ctx != nil {
+		if in, ok := ctx.Value(instanceCtxKey{}).(*instance); ok && in != nil {
+			return in
+		}
+	}
+	return defaultInstance
+}
+
+/* instanceOf 邮箱所属实例，未绑定（如 RestoreEmailInfo 恢复）时为默认实例 */
+func instanceOf(info *EmailInfo) *instance {
+	if info != nil && info.inst != nil {
+		return info.inst
+	}
+	return defaultInstance
+}
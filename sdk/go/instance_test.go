@@ -0,0 +1,44 @@
+package tempemail
+
+import (
+	"context"
+	"testing"
+
+	"github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
+)
+
+/*
+ * TestClientInstanceIsolation 校验带选项的 Client 拥有独立配置与熔断状态，
+ * 且 provider 经 ctx 读取到的是发起调用的实例的配置
+ */
+func TestClientInstanceIsolation(t *testing.T) {
+	a := NewClient(WithConfig(SDKConfig{Proxy: "http://127.0.0.1:1"}))
+	b := NewClient(WithConfig(SDKConfig{Proxy: "http://127.0.0.1:2"}))
+	shared := NewClient()
+
+	if a.Config().Proxy == b.Config().Proxy {
+		t.Fatalf("实例配置不应共享")
+	}
+	if shared.instance() != defaultInstance {
+		t.Fatalf("无选项的 Client 应共享默认实例")
+	}
+
+	a.instance().recordBackendResult(ChannelMailinator, ErrChannelUnavailable)
+	if ok, _ := a.instance().backendAllowed("mailinator"); ok {
+		t.Fatalf("实例 a 的 mailinator 应处于熔断")
+	}
+	if ok, _ := b.instance().backendAllowed("mailinator"); !ok {
+		t.Fatalf("熔断状态不应跨实例传播")
+	}
+	if ok, _ := defaultInstance.backendAllowed("mailinator"); !ok {
+		t.Fatalf("熔断状态不应跨实例传播")
+	}
+
+	ctx := withInstance(context.Background(), b.instance())
+	if got := provider.GetConfigSnapshot(ctx).Proxy; got != "http://127.0.0.1:2" {
+		t.Fatalf("provider 应读取实例 b 的配置，实际 %q", got)
+	}
+	if instanceFromContext(context.Background()) != defaultInstance {
+		t.Fatalf("未携带实例的 ctx 应回退默认实例")
+	}
+}
@@ -0,0 +1,351 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+/*
+ * 邮箱续期
+ * 声明了 CapExtend 的渠道（guerrillamail 系列、dropmail）可在过期前延长有效期，
+ * 长时间运行的测试或集成任务借此保持同一地址可用。服务端通常按自身规则续期
+ * （guerrillamail 固定续至 60 分钟后，dropmail 每次访问顺延会话），请求的时长仅作提示。
+ *
+ * ExtendMailbox 只返回新的过期时间、不修改 EmailInfo（EmailInfo 可能正被其他 goroutine 读取），
+ * info.ExpiresAt / TTL / IsExpired 仍是创建时的期限；续期后的期限以返回值为准，由调用方保存。
+ * 需要自动续期时使用 LeaseManager，每个租约持有当前期限（Lease.ExpiresAt），并在到期前 RenewBefore 续期。
+ *
+ * 示例:
+ *   leases := tempemail.NewLeaseManager(&tempemail.LeaseOptions{RenewBefore: 5 * time.Minute})
+ *   defer leases.Close()
+ *
+ *   info, _ := tempemail.GenerateEmail(&tempemail.GenerateEmailOptions{Require: tempemail.CapExtend})
+ *   lease, err := leases.Add(info)
+ *   ...
+ *   fmt.Println(lease.ExpiresAt())
+ */
+
+/* ErrLeaseManagerClosed 续期管理器已关闭 */
+var ErrLeaseManagerClosed = errors.New("lease manager closed")
+
+/*
+ * ExtendMailbox 延长邮箱有效期，d 为期望延长的时长（0 表示由服务端决定），返回续期后的过期时间；
+ * 不修改 info.ExpiresAt，续期后 info.TTL / IsExpired 不反映新的期限
+ * 渠道不支持时返回 ErrUnsupported；邮箱已失效时返回 ErrMailboxExpired；网络错误与 5xx 按 RetryOptions 默认值重试
+ */
+func ExtendMailbox(ctx context.Context, info *EmailInfo, d time.Duration) (time.Time, error) {
+	return instanceOf(info).extendMailbox(ctx, info, d)
+}
+
+func (in *instance) extendMailbox(ctx context.Context, info *EmailInfo, d time.Duration) (time.Time, error) {
+	spec, err := specFor(info)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if spec.Extend == nil {
+		return time.Time{}, fmt.Errorf("%s 渠道不支持延长邮箱有效期：%w", info.Channel, ErrUnsupported)
+	}
+	ctx = withInstance(ctx, in)
+	log := in.log()
+	expiresAt, _, err := withRetryAndAttempts(ctx, func() (time.Time, error) {
+		return spec.Extend(ctx, info.Email, info.token, d)
+	}, nil)
+	if err != nil {
+		log.Warn("延长邮箱有效期失败", "channel", string(info.Channel), "email", info.Email, "error", err.Error())
+		return time.Time{}, fmt.Errorf("延长邮箱有效期失败：%w", err)
+	}
+	log.Debug("延长邮箱有效期成功", "channel", string(info.Channel), "email", info.Email, "expiresAt", expiresAt)
+	return expiresAt, nil
+}
+
+/* Extend 延长该邮箱的有效期，见 ExtendMailbox */
+func (m *Mailbox) Extend(ctx context.Context, d time.Duration) (time.Time, error) {
+	return ExtendMailbox(ctx, m.info, d)
+}
+
+/* LeaseOptions 续期管理器选项 */
+type LeaseOptions struct {
+	/* 在过期前多久续期，默认 5 分钟 */
+	RenewBefore time.Duration
+	/* 每次续期请求的时长（传给 ExtendMailbox），0 表示由服务端决定 */
+	Extend time.Duration
+	/* 续期失败后的重试间隔，默认 30 秒；同时作为两次续期的最小间隔 */
+	RetryDelay time.Duration
+	/* 续期成功回调（在后台 goroutine 中调用） */
+	OnRenew func(info *EmailInfo, expiresAt time.Time)
+	/* 续期失败回调；邮箱已失效或已过期时租约随后被移除 */
+	OnError func(info *EmailInfo, err error)
+}
+
+const (
+	defaultLeaseRenewBefore = 5 * time.Minute
+	defaultLeaseRetryDelay  = 30 * time.Second
+)
+
+/* Lease 单个邮箱的续期租约，并发安全 */
+type Lease struct {
+	info *EmailInfo
+
+	mu        sync.Mutex
+	expiresAt time.Time
+	renewals  int
+	lastErr   error
+	next      time.Time /* 下次续期时间 */
+	renewing  bool
+}
+
+/* Info 租约对应的邮箱 */
+func (l *Lease) Info() *EmailInfo { return l.info }
+
+/* ExpiresAt 当前已知的过期时间，未知时为零值 */
+func (l *Lease) ExpiresAt() time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.expiresAt
+}
+
+/* Renewals 累计续期成功次数 */
+func (l *Lease) Renewals() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.renewals
+}
+
+/* Err 最近一次续期的错误，成功后清空 */
+func (l *Lease) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lastErr
+}
+
+/* LeaseManager 在到期前自动续期已登记的邮箱，并发安全；不再使用时应调用 Close 停止后台续期 */
+type LeaseManager struct {
+	in   *instance
+	opts LeaseOptions
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	kick   chan struct{}
+	done   chan struct{}
+
+	mu     sync.Mutex
+	leases map[*EmailInfo]*Lease
+	closed bool
+}
+
+/* NewLeaseManager 在默认实例上创建续期管理器 */
+func NewLeaseManager(opts *LeaseOptions) *LeaseManager {
+	return newLeaseManager(defaultInstance, opts)
+}
+
+/* NewLeaseManager 创建使用该 Client 配置（代理、logger 等）的续期管理器 */
+func (c *Client) NewLeaseManager(opts *LeaseOptions) *LeaseManager {
+	return newLeaseManager(c.instance(), opts)
+}
+
+func newLeaseManager(in *instance, opts *LeaseOptions) *LeaseManager {
+	o := LeaseOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.RenewBefore <= 0 {
+		o.RenewBefore = defaultLeaseRenewBefore
+	}
+	if o.RetryDelay <= 0 {
+		o.RetryDelay = defaultLeaseRetryDelay
+	}
+	ctx, cancel := context.WithCancel(withInstance(context.Background(), in))
+	lm := &LeaseManager{
+		in:     in,
+		opts:   o,
+		ctx:    ctx,
+		cancel: cancel,
+		kick:   make(chan struct{}, 1),
+		done:   make(chan struct{}),
+		leases: map[*EmailInfo]*Lease{},
+	}
+	go lm.run()
+	return lm
+}
+
+/*
+ * Add 登记邮箱并开始自动续期，重复登记返回已有租约
+ * 渠道不支持续期时返回 ErrUnsupported；EmailInfo 未带 ExpiresAt 时立即续期一次以获知过期时间
+ */
+func (lm *LeaseManager) Add(info *EmailInfo) (*Lease, error) {
+	spec, err := specFor(info)
+	if err != nil {
+		return nil, err
+	}
+	if spec.Extend == nil {
+		return nil, fmt.Errorf("%s 渠道不支持延长邮箱有效期：%w", info.Channel, ErrUnsupported)
+	}
+
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	if lm.closed {
+		return nil, ErrLeaseManagerClosed
+	}
+	if l, ok := lm.leases[info]; ok {
+		return l, nil
+	}
+	l := &Lease{info: info, next: time.Now()}
+	if t, ok := info.ExpiresAtTime(); ok {
+		l.expiresAt = t
+		l.next = t.Add(-lm.opts.RenewBefore)
+	}
+	lm.leases[info] = l
+	lm.wake()
+	return l, nil
+}
+
+/* Remove 停止续期该邮箱，返回其是否已登记 */
+func (lm *LeaseManager) Remove(info *EmailInfo) bool {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	if _, ok := lm.leases[info]; !ok {
+		return false
+	}
+	delete(lm.leases, info)
+	lm.wake()
+	return true
+}
+
+/* Leases 当前登记的全部租约 */
+func (lm *LeaseManager) Leases() []*Lease {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+	out := make([]*Lease, 0, len(lm.leases))
+	for _, l := range lm.leases {
+		out = append(out, l)
+	}
+	return out
+}
+
+/* Close 停止后台续期并等待进行中的续期结束，可重复调用 */
+func (lm *LeaseManager) Close() {
+	lm.mu.Lock()
+	if lm.closed {
+		lm.mu.Unlock()
+		<-lm.done
+		return
+	}
+	lm.closed = true
+	lm.mu.Unlock()
+	lm.cancel()
+	<-lm.done
+}
+
+func (lm *LeaseManager) wake() {
+	select {
+	case lm.kick <- struct{}{}:
+	default:
+	}
+}
+
+func (lm *LeaseManager) run() {
+	var wg sync.WaitGroup
+	defer close(lm.done)
+	defer wg.Wait()
+
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+	for {
+		now := time.Now()
+		var due []*Lease
+		var next time.Time
+		lm.mu.Lock()
+		for _, l := range lm.leases {
+			l.mu.Lock()
+			switch {
+			case l.renewing:
+			case !l.next.After(now):
+				l.renewing = true
+				due = append(due, l)
+			case next.IsZero() || l.next.Before(next):
+				next = l.next
+			}
+			l.mu.Unlock()
+		}
+		lm.mu.Unlock()
+
+		for _, l := range due {
+			wg.Add(1)
+			go func(l *Lease) {
+				defer wg.Done()
+				lm.renew(l)
+				lm.wake()
+			}(l)
+		}
+
+		wait := time.Hour
+		if !next.IsZero() {
+			wait = time.Until(next)
+		}
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(wait)
+		select {
+		case <-lm.ctx.Done():
+			return
+		case <-lm.kick:
+		case <-timer.C:
+		}
+	}
+}
+
+func (lm *LeaseManager) renew(l *Lease) {
+	expiresAt, err := lm.in.extendMailbox(lm.ctx, l.info, lm.opts.Extend)
+	if lm.ctx.Err() != nil {
+		return
+	}
+	now := time.Now()
+
+	l.mu.Lock()
+	l.renewing = false
+	if err == nil {
+		l.expiresAt = expiresAt
+		l.renewals++
+		l.lastErr = nil
+		/* 服务端只续期很短时也至少间隔 RetryDelay，避免连续请求 */
+		l.next = maxTime(expiresAt.Add(-lm.opts.RenewBefore), now.Add(lm.opts.RetryDelay))
+	} else {
+		l.lastErr = err
+		l.next = now.Add(lm.opts.RetryDelay)
+	}
+	gone := err != nil && (errors.Is(err, ErrMailboxExpired) || errors.Is(err, ErrInvalidToken) ||
+		(!l.expiresAt.IsZero() && now.After(l.expiresAt)))
+	l.mu.Unlock()
+
+	if err == nil {
+		if lm.opts.OnRenew != nil {
+			lm.opts.OnRenew(l.info, expiresAt)
+		}
+		return
+	}
+	if lm.opts.OnError != nil {
+		lm.opts.OnError(l.info, err)
+	}
+	if gone {
+		lm.mu.Lock()
+		if lm.leases[l.info] == l {
+			delete(lm.leases, l.info)
+		}
+		lm.mu.Unlock()
+		lm.in.log().Warn("邮箱已失效，停止续期", "channel", string(l.info.Channel), "email", l.info.Email)
+	}
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
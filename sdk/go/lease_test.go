@@ -0,0 +1,115 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/*
+ * TestLeaseManager 校验续期：未带 ExpiresAt 的邮箱登记后立即续期、
+ * 到期前再次续期、邮箱失效后移除租约，不支持的渠道返回 ErrUnsupported
+ */
+func TestLeaseManager(t *testing.T) {
+	var calls atomic.Int32
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-extend",
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			if calls.Add(1) > 2 {
+				return time.Time{}, fmt.Errorf("gone: %w", ErrMailboxExpired)
+			}
+			return time.Now().Add(time.Hour), nil
+		},
+		Capabilities: CapExtend,
+	})
+	registerFakeChannel(t, ChannelSpec{Channel: "test-noextend"})
+
+	client := newOfflineClient()
+	renewed := make(chan time.Time, 4)
+	failed := make(chan error, 4)
+	leases := client.NewLeaseManager(&LeaseOptions{
+		RenewBefore: time.Hour - 50*time.Millisecond,
+		RetryDelay:  10 * time.Millisecond,
+		OnRenew:     func(info *EmailInfo, expiresAt time.Time) { renewed <- expiresAt },
+		OnError:     func(info *EmailInfo, err error) { failed <- err },
+	})
+	defer leases.Close()
+
+	info := &EmailInfo{Channel: "test-extend", Email: "a@x", token: "tok"}
+	lease, err := leases.Add(info)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case <-renewed:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("第 %d 次续期未发生", i+1)
+		}
+	}
+	select {
+	case err := <-failed:
+		if !errors.Is(err, ErrMailboxExpired) {
+			t.Fatalf("期望 ErrMailboxExpired，实际 %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("续期失败未回调")
+	}
+	deadline := time.Now().Add(time.Second)
+	for len(leases.Leases()) != 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if len(leases.Leases()) != 0 || lease.Renewals() != 2 {
+		t.Fatalf("失效后应移除租约：剩余 %d，续期 %d 次", len(leases.Leases()), lease.Renewals())
+	}
+
+	plain := &EmailInfo{Channel: "test-noextend", Email: "b@x"}
+	if _, err := leases.Add(plain); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("期望 ErrUnsupported，实际 %v", err)
+	}
+	if _, err := ExtendMailbox(context.Background(), plain, time.Hour); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("期望 ErrUnsupported，实际 %v", err)
+	}
+}
+
+/*
+ * TestExtendMailboxKeepsInfo 校验 ExtendMailbox 只返回新的期限、不修改 EmailInfo，
+ * 续期后的期限由租约持有
+ */
+func TestExtendMailboxKeepsInfo(t *testing.T) {
+	renewed := time.Now().Add(time.Hour).Truncate(time.Second)
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-extend-info",
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return renewed, nil
+		},
+		Capabilities: CapExtend,
+	})
+
+	created := time.Now().Add(time.Minute).UTC().Format(time.RFC3339)
+	info := &EmailInfo{Channel: "test-extend-info", Email: "a@x", ExpiresAt: created}
+	got, err := ExtendMailbox(context.Background(), info, time.Hour)
+	if err != nil || !got.Equal(renewed) {
+		t.Fatalf("ExtendMailbox: %v %v", got, err)
+	}
+	if info.ExpiresAt != created {
+		t.Fatalf("ExtendMailbox 不应修改 info.ExpiresAt，实际 %v", info.ExpiresAt)
+	}
+
+	leases := newOfflineClient().NewLeaseManager(&LeaseOptions{RenewBefore: 2 * time.Minute})
+	defer leases.Close()
+	lease, err := leases.Add(info)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for !lease.ExpiresAt().Equal(renewed) && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if !lease.ExpiresAt().Equal(renewed) || info.ExpiresAt != created {
+		t.Fatalf("租约应持有续期后的期限 %v，实际 %v（info %v）", renewed, lease.ExpiresAt(), info.ExpiresAt)
+	}
+}
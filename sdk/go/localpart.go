@@ -0,0 +1,97 @@
+package tempemail
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/*
+ * 自定义邮箱用户名
+ * GenerateEmailOptions.LocalPart 指定 @ 前的部分，可包含占位符：
+ *   {rand}    10 位随机小写字母数字
+ *   {rand:N}  N 位随机小写字母数字（1-32）
+ * 每次尝试渠道时重新展开占位符；LocalPartFunc 非 nil 时优先使用其返回值。
+ * 仅尝试声明了 CapCustomLocalPart 的渠道（公共收件箱、catch-all 域名、mail.tm 注册等）。
+ *
+ * 示例:
+ *   info, _ := GenerateEmail(&GenerateEmailOptions{LocalPart: "signup-{rand:6}"})
+ *   // signup-k3x9qa@mailinator.com
+ */
+
+/* localPartPlaceholder 匹配 {rand} 与 {rand:N} */
+var localPartPlaceholder = regexp.MustCompile(`\{rand(?::(\d+))?\}`)
+
+/* localPartChars 合法用户名字符：字母数字与 . _ + -，不以 . 开头或结尾 */
+var localPartChars = regexp.MustCompile(`^[A-Za-z0-9_+-](?:[A-Za-z0-9._+-]*[A-Za-z0-9_+-])?$`)
+
+const (
+	defaultLocalPartRand = 10
+	maxLocalPartRand     = 32
+	maxLocalPartLen      = 64
+)
+
+/* wantsLocalPart 调用方是否指定了用户名 */
+func wantsLocalPart(opts *GenerateEmailOptions) bool {
+	return opts.LocalPart != "" || opts.LocalPartFunc != nil
+}
+
+/* resolveLocalPart 为渠道 ch 的一次尝试确定用户名 */
+func resolveLocalPart(ch Channel, opts *GenerateEmailOptions) (string, error) {
+	var local string
+	if opts.LocalPartFunc != nil {
+		local = opts.LocalPartFunc(ch)
+	} else {
+		expanded, err := expandLocalPart(opts.LocalPart)
+		if err != nil {
+			return "", err
+		}
+		local = expanded
+	}
+	if err := validateLocalPart(local); err != nil {
+		return "", err
+	}
+	return local, nil
+}
+
+/* expandLocalPart 展开 {rand} / {rand:N} 占位符 */
+func expandLocalPart(pattern string) (string, error) {
+	var expandErr error
+	out := localPartPlaceholder.ReplaceAllStringFunc(pattern, func(m string) string {
+		n := defaultLocalPartRand
+		if sub := localPartPlaceholder.FindStringSubmatch(m); sub[1] != "" {
+			n, _ = strconv.Atoi(sub[1])
+		}
+		if n < 1 || n > maxLocalPartRand {
+			expandErr = fmt.Errorf("邮箱用户名占位符 %s 长度须在 1-%d 之间", m, maxLocalPartRand)
+			return ""
+		}
+		return randomLocal(n)
+	})
+	return out, expandErr
+}
+
+/* validateLocalPart 校验用户名字符集与长度 */
+func validateLocalPart(local string) error {
+	if len(local) > maxLocalPartLen || !localPartChars.MatchString(local) || strings.Contains(local, "..") {
+		return fmt.Errorf("无效的邮箱用户名 %q：仅允许字母、数字与 . _ + -，长度 1-%d", local, maxLocalPartLen)
+	}
+	return nil
+}
+
+/* hasLocalPart 邮箱地址的用户名是否为 local（不区分大小写） */
+func hasLocalPart(email, local string) bool {
+	at := strings.LastIndex(email, "@")
+	return at > 0 && strings.EqualFold(email[:at], local)
+}
+
+func randomLocal(n int) string {
+	const chars = "abcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = chars[rand.Intn(len(chars))]
+	}
+	return string(b)
+}
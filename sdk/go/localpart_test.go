@@ -0,0 +1,40 @@
+package tempemail
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+/*
+ * TestGenerateEmailLocalPart 校验自定义用户名：占位符展开、非法用户名提前报错、
+ * 不支持的渠道被跳过、LocalPartFunc 优先于 LocalPart
+ */
+func TestGenerateEmailLocalPart(t *testing.T) {
+	saved := allChannels
+	allChannels = []Channel{ChannelTempmail, ChannelMailinator}
+	t.Cleanup(func() { allChannels = saved })
+	client := newOfflineClient()
+
+	info, err := client.Generate(&GenerateEmailOptions{Channel: ChannelTempmail, LocalPart: "qa-{rand:4}"})
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if info.Channel != ChannelMailinator || !regexp.MustCompile(`^qa-[a-z0-9]{4}@mailinator\.com$`).MatchString(info.Email) {
+		t.Fatalf("期望跳过 tempmail 并在 mailinator 上使用 qa-xxxx，实际 %s %s", info.Channel, info.Email)
+	}
+
+	for _, bad := range []string{"a b", ".lead", "x{rand:99}", strings.Repeat("a", 65)} {
+		if _, err := client.Generate(&GenerateEmailOptions{LocalPart: bad}); err == nil {
+			t.Fatalf("非法用户名 %q 应返回错误", bad)
+		}
+	}
+
+	info, err = client.Generate(&GenerateEmailOptions{
+		LocalPart:     "ignored",
+		LocalPartFunc: func(ch Channel) string { return "hook-" + string(ch) },
+	})
+	if err != nil || info.Email != "hook-mailinator@mailinator.com" {
+		t.Fatalf("LocalPartFunc 未生效: %+v, %v", info, err)
+	}
+}
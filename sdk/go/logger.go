@@ -27,18 +27,15 @@ const (
 	LogLevelSilent = slog.Level(100)
 )
 
-/* sdkLogger SDK 内部使用的 logger 实例 */
-var sdkLogger *slog.Logger
-
-func init() {
-	/* 默认静默：日志级别设为极高值，不输出任何日志 */
-	sdkLogger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-		Level: LogLevelSilent,
+/* newSDKLogger 创建输出到 stderr 的文本 logger */
+func newSDKLogger(level slog.Level) *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: level,
 	})).With("module", "tempmail-sdk")
 }
 
 /*
- * SetLogLevel 设置日志级别
+ * SetLogLevel 设置日志级别（默认实例）
  * 默认 LogLevelSilent（不输出任何日志）
  *
  * 示例:
@@ -46,13 +43,11 @@ func init() {
  *   tempemail.SetLogLevel(tempemail.LogLevelInfo)  // 只输出 INFO 及以上
  */
 func SetLogLevel(level slog.Level) {
-	sdkLogger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-		Level: level,
-	})).With("module", "tempmail-sdk")
+	defaultInstance.setLogger(newSDKLogger(level))
 }
 
 /*
- * SetLogger 设置自定义 slog.Logger 实例
+ * SetLogger 设置自定义 slog.Logger 实例（默认实例）
  * 替换默认的 stderr 文本输出，可对接任意日志框架
  *
  * 示例:
@@ -60,10 +55,22 @@ func SetLogLevel(level slog.Level) {
  *   tempemail.SetLogger(slog.New(handler))
  */
 func SetLogger(l *slog.Logger) {
-	sdkLogger = l
+	defaultInstance.setLogger(l)
 }
 
-/* GetLogger 获取当前 SDK 使用的 logger 实例 */
+/* GetLogger 获取默认实例当前使用的 logger */
 func GetLogger() *slog.Logger {
-	return sdkLogger
+	return defaultInstance.log()
+}
+
+func (in *instance) setLogger(l *slog.Logger) {
+	if l == nil {
+		l = newSDKLogger(LogLevelSilent)
+	}
+	in.logger.Store(l)
+}
+
+/* log 实例当前使用的 logger */
+func (in *instance) log() *slog.Logger {
+	return in.logger.Load()
 }
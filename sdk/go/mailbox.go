@@ -0,0 +1,268 @@
+package tempemail
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+/*
+ * 多邮箱管理
+ * 一个 Client 可同时持有多个邮箱句柄（Mailbox），各 goroutine 共用同一 Client 即可，
+ * 无需为每个地址单独创建 Client。所有方法并发安全。
+ *
+ * 示例:
+ *   client := NewClient()
+ *   signup, _ := client.NewMailbox(&MailboxOptions{Labels: map[string]string{"purpose": "signup"}})
+ *   reset, _ := client.NewMailbox(&MailboxOptions{Labels: map[string]string{"purpose": "reset"}})
+ *
+ *   results, _ := client.GetAllEmails(ctx, &GetAllEmailsOptions{Concurrency: 4})
+ *   for _, r := range results {
+ *       if r.Result != nil && r.Result.Success {
+ *           fmt.Println(r.Mailbox.Label("purpose"), len(r.Result.Emails))
+ *       }
+ *   }
+ */
+
+/*
+ * MailboxOptions 创建邮箱句柄的选项
+ * 内嵌 GenerateEmailOptions（渠道、域名、重试等同 GenerateEmail）
+ */
+type MailboxOptions struct {
+	GenerateEmailOptions
+	/* 邮箱元数据标签，如 {"purpose": "signup", "user": "42"} */
+	Labels map[string]string
+}
+
+/*
+ * Mailbox Client 管理的邮箱句柄
+ * 邮箱信息创建后不可变；标签可随时读写，并发安全
+ */
+type Mailbox struct {
+	client  *Client
+	info    *EmailInfo
+	addedAt time.Time
+
+	mu     sync.RWMutex
+	labels map[string]string
+}
+
+/* Info 邮箱信息（可用于 MarshalSession 等） */
+func (m *Mailbox) Info() *EmailInfo { return m.info }
+
+/* Email 邮箱地址 */
+func (m *Mailbox) Email() string { return m.info.Email }
+
+/* Channel 邮箱所属渠道 */
+func (m *Mailbox) Channel() Channel { return m.info.Channel }
+
+/* AddedAt 加入 Client 的时间 */
+func (m *Mailbox) AddedAt() time.Time { return m.addedAt }
+
+/* Label 读取标签值，不存在时返回空字符串 */
+func (m *Mailbox) Label(key string) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.labels[key]
+}
+
+/* Labels 返回全部标签的副本 */
+func (m *Mailbox) Labels() map[string]string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make(map[string]string, len(m.labels))
+	for k, v := range m.labels {
+		out[k] = v
+	}
+	return out
+}
+
+/* SetLabel 设置标签，value 为空字符串时删除该标签 */
+func (m *Mailbox) SetLabel(key, value string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if value == "" {
+		delete(m.labels, key)
+		return
+	}
+	if m.labels == nil {
+		m.labels = make(map[string]string)
+	}
+	m.labels[key] = value
+}
+
+/* GetEmails 拉取该邮箱的邮件，见 GetEmailsContext */
+func (m *Mailbox) GetEmails(ctx context.Context, opts *GetEmailsOptions) (*GetEmailsResult, error) {
+	return GetEmailsContext(ctx, m.info, opts)
+}
+
+/* WaitForEmail 等待该邮箱收到满足条件的新邮件，见 WaitForEmail */
+func (m *Mailbox) WaitForEmail(ctx context.Context, opts WaitOptions) (*Email, error) {
+	return WaitForEmail(ctx, m.info, opts)
+}
+
+/* Watch 监听该邮箱，见 Watch */
+func (m *Mailbox) Watch(ctx context.Context, opts *WatchOptions) (<-chan Email, error) {
+	return Watch(ctx, m.info, opts)
+}
+
+/* Remove 将该邮箱从所属 Client 移除（不影响服务端邮箱） */
+func (m *Mailbox) Remove() bool {
+	return m.client.RemoveMailbox(m)
+}
+
+/*
+ * NewMailbox 创建一个新邮箱并加入 Client 管理
+ * 与 Generate 不同，不会替换 Client 的「当前邮箱」，可并发调用
+ */
+func (c *Client) NewMailbox(opts *MailboxOptions) (*Mailbox, error) {
+	return c.NewMailboxContext(context.Background(), opts)
+}
+
+/* NewMailboxContext 与 NewMailbox 相同，但受 ctx 控制（见 GenerateEmailContext） */
+func (c *Client) NewMailboxContext(ctx context.Context, opts *MailboxOptions) (*Mailbox, error) {
+	if opts == nil {
+		opts = &MailboxOptions{}
+	}
+	info, err := c.instance().generateEmail(ctx, &opts.GenerateEmailOptions)
+	if err != nil {
+		return nil, err
+	}
+	return c.AddMailbox(info, opts.Labels), nil
+}
+
+/*
+ * AddMailbox 将已有邮箱（如 RestoreEmailInfo 恢复的会话）加入 Client 管理
+ * 未绑定实例的 EmailInfo（如包级 RestoreEmailInfo 的结果）绑定到该 Client 的实例，后续请求使用其代理与配置；
+ * 已绑定其他实例的保持原绑定。同一 EmailInfo 重复加入时返回已有句柄并合并标签
+ */
+func (c *Client) AddMailbox(info *EmailInfo, labels map[string]string) *Mailbox {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if info.inst == nil && c.inst != nil {
+		info.inst = c.inst
+	}
+	for _, m := range c.mailboxes {
+		if m.info == info {
+			for k, v := range labels {
+				m.SetLabel(k, v)
+			}
+			return m
+		}
+	}
+	m := &Mailbox{client: c, info: info, addedAt: time.Now(), labels: make(map[string]string, len(labels))}
+	for k, v := range labels {
+		m.labels[k] = v
+	}
+	c.mailboxes = append(c.mailboxes, m)
+	return m
+}
+
+/* RemoveMailbox 移除邮箱句柄，不存在时返回 false */
+func (c *Client) RemoveMailbox(m *Mailbox) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, x := range c.mailboxes {
+		if x == m {
+			c.mailboxes = append(c.mailboxes[:i:i], c.mailboxes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+/* Mailboxes 返回当前管理的全部邮箱句柄（按加入顺序的快照） */
+func (c *Client) Mailboxes() []*Mailbox {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make([]*Mailbox, len(c.mailboxes))
+	copy(out, c.mailboxes)
+	return out
+}
+
+/* FindMailboxes 返回标签 key 等于 value 的邮箱句柄 */
+func (c *Client) FindMailboxes(key, value string) []*Mailbox {
+	var out []*Mailbox
+	for _, m := range c.Mailboxes() {
+		if m.Label(key) == value {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+/* GetAllEmailsOptions 批量拉取邮件的选项 */
+type GetAllEmailsOptions struct {
+	/* 最大并发数，默认 8 */
+	Concurrency int
+	/* 仅拉取包含全部这些标签的邮箱，nil 表示全部 */
+	Labels map[string]string
+	/* 单个邮箱的重试配置，nil 则使用默认值 */
+	Retry *RetryOptions
+}
+
+const defaultGetAllConcurrency = 8
+
+/* MailboxEmails 单个邮箱的拉取结果；Result 为 nil 时 Err 说明原因 */
+type MailboxEmails struct {
+	Mailbox *Mailbox
+	Result  *GetEmailsResult
+	Err     error
+}
+
+/*
+ * GetAllEmails 并发拉取 Client 管理的全部邮箱（并发数受 Concurrency 限制）
+ * 结果顺序与 Mailboxes() 一致；单个邮箱失败不影响其他邮箱（见 GetEmails 的 Success 约定）
+ * ctx 取消时返回已完成的结果与 ctx.Err()，未完成项的 Err 为 ctx.Err()
+ */
+func (c *Client) GetAllEmails(ctx context.Context, opts *GetAllEmailsOptions) ([]MailboxEmails, error) {
+	if opts == nil {
+		opts = &GetAllEmailsOptions{}
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultGetAllConcurrency
+	}
+
+	var targets []*Mailbox
+	for _, m := range c.Mailboxes() {
+		if mailboxHasLabels(m, opts.Labels) {
+			targets = append(targets, m)
+		}
+	}
+
+	results := make([]MailboxEmails, len(targets))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, m := range targets {
+		results[i].Mailbox = m
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, m *Mailbox) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i].Result, results[i].Err = m.GetEmails(ctx, &GetEmailsOptions{Retry: opts.Retry})
+		}(i, m)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return results, fmt.Errorf("批量获取邮件已取消：%w", err)
+	}
+	return results, nil
+}
+
+func mailboxHasLabels(m *Mailbox, want map[string]string) bool {
+	for k, v := range want {
+		if m.Label(k) != v {
+			return false
+		}
+	}
+	return true
+}
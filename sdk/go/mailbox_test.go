@@ -0,0 +1,100 @@
+package tempemail
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
+)
+
+/*
+ * registerFakeChannel 注册仅供测试使用的离线渠道，测试结束后移除
+ * 不进入 allChannels，避免影响渠道顺序与随机尝试
+ */
+func registerFakeChannel(t *testing.T, spec ChannelSpec) {
+	t.Helper()
+	channelRegistryMap[spec.Channel] = &spec
+	t.Cleanup(func() { delete(channelRegistryMap, spec.Channel) })
+}
+
+/*
+ * TestClientGetAllEmailsBounded 校验多邮箱批量拉取：结果顺序与 Mailboxes() 一致、
+ * 并发数不超过 Concurrency、按标签筛选
+ */
+func TestClientGetAllEmailsBounded(t *testing.T) {
+	var inflight, peak int32
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-fake",
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			n := atomic.AddInt32(&inflight, 1)
+			defer atomic.AddInt32(&inflight, -1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			return []Email{{ID: email}}, nil
+		},
+	})
+
+	client := newOfflineClient()
+	for i, addr := range []string{"a@x", "b@x", "c@x", "d@x", "e@x"} {
+		purpose := "signup"
+		if i%2 == 1 {
+			purpose = "reset"
+		}
+		client.AddMailbox(&EmailInfo{Channel: "test-fake", Email: addr}, map[string]string{"purpose": purpose})
+	}
+
+	results, err := client.GetAllEmails(context.Background(), &GetAllEmailsOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("GetAllEmails: %v", err)
+	}
+	if len(results) != 5 || peak > 2 {
+		t.Fatalf("期望 5 个结果且并发不超过 2，实际 %d 个，峰值 %d", len(results), peak)
+	}
+	for i, r := range results {
+		if r.Mailbox != client.Mailboxes()[i] || r.Result == nil || r.Result.Emails[0].ID != r.Mailbox.Email() {
+			t.Fatalf("第 %d 个结果与邮箱不对应: %+v", i, r)
+		}
+	}
+
+	resets, _ := client.GetAllEmails(context.Background(), &GetAllEmailsOptions{Labels: map[string]string{"purpose": "reset"}})
+	if len(resets) != 2 || len(client.FindMailboxes("purpose", "signup")) != 3 {
+		t.Fatalf("标签筛选结果不符: %d", len(resets))
+	}
+}
+
+/* TestAddMailboxBindsClientInstance 校验包级 RestoreEmailInfo 恢复的邮箱加入 Client 后使用该 Client 的配置收信 */
+func TestAddMailboxBindsClientInstance(t *testing.T) {
+	var proxy atomic.Value
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-bind",
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			proxy.Store(provider.GetConfigSnapshot(ctx).Proxy)
+			return nil, nil
+		},
+	})
+	data, err := (&EmailInfo{Channel: "test-bind", Email: "a@x", token: "tok"}).MarshalSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := RestoreEmailInfo(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	off := false
+	client := NewClient(WithConfig(SDKConfig{TelemetryEnabled: &off, Proxy: "http://127.0.0.1:9"}))
+	m := client.AddMailbox(info, nil)
+	if _, err := m.GetEmails(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := proxy.Load().(string); got != "http://127.0.0.1:9" {
+		t.Fatalf("期望使用 Client 的代理，实际 %q", got)
+	}
+}
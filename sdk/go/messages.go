@@ -0,0 +1,123 @@
+package tempemail
+
+import (
+	"context"
+	"fmt"
+)
+
+/*
+ * 邮件摘要与按需加载
+ * 部分渠道（guerrillamail 系列、maildrop、mailinator、emailnator、mail.tm）的 GetEmails
+ * 每次轮询都会逐封拉取正文；繁忙收件箱下用 ListEmails(SummaryOnly) 只取摘要，
+ * 再对感兴趣的邮件调用 GetEmail 加载完整内容，可大幅减少请求数。
+ * 其余渠道的列表接口本身已含正文，SummaryOnly 时回退到完整列表（Email.Summary 为 false）。
+ *
+ * 示例:
+ *   list, _ := ListEmails(ctx, info, &ListEmailsOptions{SummaryOnly: true})
+ *   for _, e := range list {
+ *       if strings.Contains(e.Subject, "验证码") {
+ *           full, _ := GetEmail(ctx, info, e.ID)
+ *           fmt.Println(full.HTML)
+ *       }
+ *   }
+ */
+
+/* ListEmailsOptions ListEmails 的选项 */
+type ListEmailsOptions struct {
+	/* 只获取摘要（发件人、主题、时间与预览），不逐封拉取正文 */
+	SummaryOnly bool
+	/* 重试配置，nil 则使用默认值 */
+	Retry *RetryOptions
+}
+
+/*
+ * ListEmails 获取邮件列表，与 GetEmails 不同的是失败时直接返回 error
+ * SummaryOnly 且渠道支持时返回的邮件 Summary 为 true
+ */
+func ListEmails(ctx context.Context, info *EmailInfo, opts *ListEmailsOptions) ([]Email, error) {
+	return instanceOf(info).listEmails(ctx, info, opts)
+}
+
+/*
+ * GetEmail 获取单封邮件的完整内容，id 为 Email.ID
+ * 渠道不提供单封接口时从完整列表中查找；不存在时返回 ErrEmailNotFound
+ */
+func GetEmail(ctx context.Context, info *EmailInfo, id string) (*Email, error) {
+	return instanceOf(info).getEmail(ctx, info, id)
+}
+
+func (in *instance) listEmails(ctx context.Context, info *EmailInfo, opts *ListEmailsOptions) ([]Email, error) {
+	spec, err := specFor(info)
+	if err != nil {
+		return nil, err
+	}
+	o := ListEmailsOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	summary := o.SummaryOnly && spec.ListEmails != nil
+	list := spec.GetEmails
+	if summary {
+		list = spec.ListEmails
+	}
+	if list == nil {
+		return nil, fmt.Errorf("unsupported channel: %s", info.Channel)
+	}
+
+	ctx = withInstance(ctx, in)
+	emails, _, err := withRetryAndAttempts(ctx, func() ([]Email, error) {
+		return list(ctx, info.Email, info.token)
+	}, o.Retry)
+	if err != nil {
+		in.log().Warn("获取邮件列表失败", "channel", string(info.Channel), "summaryOnly", summary, "error", err.Error())
+		return nil, err
+	}
+	if summary {
+		for i := range emails {
+			emails[i].Summary = true
+		}
+	}
+	return emails, nil
+}
+
+func (in *instance) getEmail(ctx context.Context, info *EmailInfo, id string) (*Email, error) {
+	spec, err := specFor(info)
+	if err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return nil, fmt.Errorf("email id is required")
+	}
+
+	ctx = withInstance(ctx, in)
+	email, _, err := withRetryAndAttempts(ctx, func() (*Email, error) {
+		if spec.GetEmail != nil {
+			return spec.GetEmail(ctx, info.Email, info.token, id)
+		}
+		emails, err := getEmailsOnce(ctx, info.Channel, info.Email, info.token)
+		if err != nil {
+			return nil, err
+		}
+		for i := range emails {
+			if emails[i].ID == id {
+				return &emails[i], nil
+			}
+		}
+		return nil, fmt.Errorf("%s: email %s not found: %w", info.Channel, id, ErrEmailNotFound)
+	}, nil)
+	if err != nil {
+		in.log().Warn("获取邮件失败", "channel", string(info.Channel), "id", id, "error", err.Error())
+		return nil, err
+	}
+	return email, nil
+}
+
+/* ListEmails 获取该邮箱的邮件列表，见 ListEmails */
+func (m *Mailbox) ListEmails(ctx context.Context, opts *ListEmailsOptions) ([]Email, error) {
+	return ListEmails(ctx, m.info, opts)
+}
+
+/* GetEmail 获取该邮箱中单封邮件的完整内容，见 GetEmail */
+func (m *Mailbox) GetEmail(ctx context.Context, id string) (*Email, error) {
+	return GetEmail(ctx, m.info, id)
+}
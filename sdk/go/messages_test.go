@@ -0,0 +1,53 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+/*
+ * TestListAndGetEmail 校验摘要列表与单封加载：SummaryOnly 走渠道摘要接口并标记 Summary、
+ * GetEmail 走单封接口；未实现摘要/单封接口的渠道回退到完整列表，缺失的 ID 返回 ErrEmailNotFound
+ */
+func TestListAndGetEmail(t *testing.T) {
+	var fullCalls int
+	full := func(ctx context.Context, email, token string) ([]Email, error) {
+		fullCalls++
+		return []Email{{ID: "1", Subject: "hi", HTML: "<b>body</b>"}}, nil
+	}
+	registerFakeChannel(t, ChannelSpec{
+		Channel:   "test-summary",
+		GetEmails: full,
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return []Email{{ID: "1", Subject: "hi"}}, nil
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return &Email{ID: id, Subject: "hi", HTML: "<b>" + token + "</b>"}, nil
+		},
+	})
+	registerFakeChannel(t, ChannelSpec{Channel: "test-fullonly", GetEmails: full})
+
+	ctx := context.Background()
+	m := newOfflineClient().AddMailbox(&EmailInfo{Channel: "test-summary", Email: "a@x", token: "tok"}, nil)
+	list, err := m.ListEmails(ctx, &ListEmailsOptions{SummaryOnly: true})
+	if err != nil || len(list) != 1 || !list[0].Summary || list[0].HTML != "" || fullCalls != 0 {
+		t.Fatalf("摘要列表: %+v, %v, 完整列表调用 %d 次", list, err, fullCalls)
+	}
+	got, err := m.GetEmail(ctx, "1")
+	if err != nil || got.HTML != "<b>tok</b>" || got.Summary {
+		t.Fatalf("GetEmail: %+v, %v", got, err)
+	}
+
+	plain := &EmailInfo{Channel: "test-fullonly", Email: "b@x"}
+	list, err = ListEmails(ctx, plain, &ListEmailsOptions{SummaryOnly: true})
+	if err != nil || len(list) != 1 || list[0].Summary || list[0].HTML == "" {
+		t.Fatalf("回退完整列表: %+v, %v", list, err)
+	}
+	if got, err := GetEmail(ctx, plain, "1"); err != nil || got.Subject != "hi" {
+		t.Fatalf("回退查找: %+v, %v", got, err)
+	}
+	if _, err := GetEmail(ctx, plain, "404"); !errors.Is(err, ErrEmailNotFound) {
+		t.Fatalf("期望 ErrEmailNotFound，实际 %v", err)
+	}
+}
@@ -1,11 +1,15 @@
 package tempemail
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"html"
 	"math"
+	"net/mail"
+	"net/textproto"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -51,7 +55,7 @@ func normalizeRawEmail(raw map[string]interface{}, recipientEmail string) Email
 		html = textToHTML(text)
 	}
 
-	return Email{
+	e := Email{
 		ID:          normalizeID(raw),
 		From:        normalizeFrom(raw),
 		To:          normalizeTo(raw, recipientEmail),
@@ -61,7 +65,48 @@ func normalizeRawEmail(raw map[string]interface{}, recipientEmail string) Email
 		Date:        normalizeDate(raw),
 		IsRead:      normalizeIsRead(raw),
 		Attachments: normalizeAttachments(raw),
+		Headers:     normalizeHeaders(raw),
 	}
+	e.ReceivedAt = normalizeReceivedAt(e.Date)
+	normalizeAddresses(&e, raw)
+	return e
+}
+
+/*
+ * normalizeHeaders 提取完整邮件头，键名规范化（Message-Id、Reply-To 等）
+ * 支持 map[string][]string 与 JSON 解码后的 map[string]interface{}（值为字符串或字符串数组）
+ */
+func normalizeHeaders(raw map[string]interface{}) map[string][]string {
+	var out map[string][]string
+	add := func(k, v string) {
+		if out == nil {
+			out = make(map[string][]string)
+		}
+		key := textproto.CanonicalMIMEHeaderKey(k)
+		out[key] = append(out[key], v)
+	}
+	switch h := raw["headers"].(type) {
+	case map[string][]string:
+		for k, vs := range h {
+			for _, v := range vs {
+				add(k, v)
+			}
+		}
+	case map[string]interface{}:
+		for k, v := range h {
+			switch val := v.(type) {
+			case string:
+				add(k, val)
+			case []interface{}:
+				for _, item := range val {
+					if s, ok := item.(string); ok {
+						add(k, s)
+					}
+				}
+			}
+		}
+	}
+	return out
 }
 
 /*
@@ -301,7 +346,92 @@ func normalizeAttachments(raw map[string]interface{}) []EmailAttachment {
 		}
 		att.ContentType = getStr(m, "contentType", "content_type", "mimeType", "mime_type")
 		att.URL = getStr(m, "url", "download_url", "downloadUrl")
+		/*
+		 * 内嵌内容：已解码的 []byte，或渠道标明为 base64 的字符串
+		 * 未标明编码的字符串无法区分原文与 base64，不填充 Content，仍可按 URL 下载
+		 */
+		switch c := m["content"].(type) {
+		case []byte:
+			att.Content = c
+		case string:
+			if attachmentIsBase64(m) {
+				if decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(c), "")); err == nil {
+					att.Content = decoded
+				}
+			}
+		}
+		if att.Size == 0 && len(att.Content) > 0 {
+			att.Size = int64(len(att.Content))
+		}
 		attachments = append(attachments, att)
 	}
 	return attachments
 }
+
+/* attachmentIsBase64 附件条目是否标明内容为 base64：encoding / contentTransferEncoding 字段或 base64 标记 */
+func attachmentIsBase64(m map[string]interface{}) bool {
+	if b, ok := m["base64"].(bool); ok {
+		return b
+	}
+	enc := getStr(m, "encoding", "contentTransferEncoding", "content_transfer_encoding", "transferEncoding")
+	return strings.EqualFold(strings.TrimSpace(enc), "base64")
+}
+
+/* normalizeReceivedAt 将归一化后的日期字符串解析为 UTC 时间，无法解析时返回零值 */
+func normalizeReceivedAt(date string) time.Time {
+	if t, ok := parseTimeValue(date); ok {
+		return t.UTC()
+	}
+	return time.Time{}
+}
+
+/*
+ * parseTimeValue 解析渠道返回的时间值
+ * 支持 ISO 8601 / "2006-01-02 15:04:05" / RFC 5322 日期字符串、数字字符串及秒级 / 毫秒级时间戳
+ */
+func parseTimeValue(v any) (time.Time, bool) {
+	var num float64
+	switch val := v.(type) {
+	case string:
+		val = strings.TrimPrefix(strings.TrimSpace(val), "$D")
+		if val == "" {
+			return time.Time{}, false
+		}
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05"} {
+			if t, err := time.ParseInLocation(layout, val, time.Local); err == nil {
+				return t, true
+			}
+		}
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			/* 邮件头格式，如 "Mon, 02 Jan 2006 15:04:05 -0700" */
+			if t, err := mail.ParseDate(val); err == nil {
+				return t, true
+			}
+			return time.Time{}, false
+		}
+		num = f
+	case float64:
+		num = val
+	case int64:
+		num = float64(val)
+	case int:
+		num = float64(val)
+	case json.Number:
+		f, err := val.Float64()
+		if err != nil {
+			return time.Time{}, false
+		}
+		num = f
+	default:
+		return time.Time{}, false
+	}
+	if num <= 0 {
+		return time.Time{}, false
+	}
+	/* 大数字为毫秒时间戳，小数字为秒级时间戳 */
+	if num > 1e12 {
+		return time.UnixMilli(int64(num)), true
+	}
+	return time.Unix(int64(num), 0), true
+}
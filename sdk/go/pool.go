@@ -0,0 +1,401 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+/*
+ * 预热邮箱池
+ * 后台持续创建并校验邮箱，使 Acquire 通常可立即拿到可用地址，省去临时创建的数秒到数十秒延迟。
+ * 池中邮箱按后端限额分布，临近过期（ExpiresAt - MinTTL）或超过 MaxAge 的条目会被丢弃并补充。
+ *
+ * 示例:
+ *   pool := tempemail.NewMailboxPool(&tempemail.PoolOptions{Size: 5, MaxPerBackend: 2})
+ *   defer pool.Close()
+ *
+ *   info, err := pool.Acquire(ctx)
+ *   if err != nil { return err }
+ *   email, _ := tempemail.WaitForEmail(ctx, info, tempemail.WaitOptions{})
+ */
+
+/* ErrPoolClosed 邮箱池已关闭 */
+var ErrPoolClosed = errors.New("mailbox pool closed")
+
+/* PoolOptions 邮箱池选项 */
+type PoolOptions struct {
+	/* 池中保持的就绪邮箱数量，默认 5 */
+	Size int
+	/* 创建邮箱的选项（渠道、域名、重试等），nil 则使用默认值 */
+	Generate *GenerateEmailOptions
+	/* 同一后端（见 ChannelSpec.Backend）在池中最多占用的条目数，0 表示不限 */
+	MaxPerBackend int
+	/* 距 ExpiresAt 不足该时长的邮箱视为临近过期并丢弃，默认 2 分钟 */
+	MinTTL time.Duration
+	/* 邮箱在池中的最长存放时间，用于未返回 ExpiresAt 的渠道，0 表示不限 */
+	MaxAge time.Duration
+	/* 同时进行的补充创建数，默认 2 */
+	RefillConcurrency int
+	/* 创建后先拉取一次收件箱以确认邮箱可用，nil 视为 true */
+	Validate *bool
+	/* 补充失败后的退避基准时长，连续失败时翻倍（上限 1 分钟），默认 5 秒 */
+	RetryDelay time.Duration
+}
+
+const (
+	defaultPoolSize        = 5
+	defaultPoolMinTTL      = 2 * time.Minute
+	defaultPoolConcurrency = 2
+	defaultPoolRetryDelay  = 5 * time.Second
+	maxPoolRetryDelay      = time.Minute
+	/* 后台定期清理临近过期条目的间隔 */
+	poolSweepInterval = 15 * time.Second
+)
+
+/* PoolStats 邮箱池状态快照 */
+type PoolStats struct {
+	/* 就绪条目数 */
+	Ready int `json:"ready"`
+	/* 正在创建的条目数 */
+	Pending int `json:"pending"`
+	/* 累计成功入池数 */
+	Created int `json:"created"`
+	/* 累计因过期、校验失败或超出后端限额被丢弃的条目数 */
+	Discarded int `json:"discarded"`
+	/* 累计创建失败次数 */
+	Failures int `json:"failures"`
+	/* 各后端就绪条目数 */
+	Backends map[string]int `json:"backends"`
+}
+
+type poolEntry struct {
+	info    *EmailInfo
+	backend string
+	addedAt time.Time
+}
+
+/* MailboxPool 预热邮箱池，并发安全；不再使用时应调用 Close 停止后台补充 */
+type MailboxPool struct {
+	in   *instance
+	opts PoolOptions
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	kick   chan struct{}
+	done   chan struct{}
+
+	mu       sync.Mutex
+	ready    []poolEntry
+	pending  int
+	inflight map[string]int /* 补充中已占用的后端名额，见 acquire */
+	failures int            /* 连续失败次数，成功后清零 */
+	changed  chan struct{}
+	closed   bool
+	stats    PoolStats
+}
+
+/* NewMailboxPool 在默认实例上创建邮箱池并立即开始后台补充 */
+func NewMailboxPool(opts *PoolOptions) *MailboxPool {
+	return newMailboxPool(defaultInstance, opts)
+}
+
+/* NewMailboxPool 创建使用该 Client 配置（代理、logger 等）的邮箱池 */
+func (c *Client) NewMailboxPool(opts *PoolOptions) *MailboxPool {
+	return newMailboxPool(c.instance(), opts)
+}
+
+func newMailboxPool(in *instance, opts *PoolOptions) *MailboxPool {
+	o := PoolOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Size <= 0 {
+		o.Size = defaultPoolSize
+	}
+	if o.MinTTL <= 0 {
+		o.MinTTL = defaultPoolMinTTL
+	}
+	if o.RefillConcurrency <= 0 {
+		o.RefillConcurrency = defaultPoolConcurrency
+	}
+	if o.RetryDelay <= 0 {
+		o.RetryDelay = defaultPoolRetryDelay
+	}
+	ctx, cancel := context.WithCancel(withInstance(context.Background(), in))
+	p := &MailboxPool{
+		in:       in,
+		opts:     o,
+		ctx:      ctx,
+		cancel:   cancel,
+		kick:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		changed:  make(chan struct{}),
+		inflight: make(map[string]int),
+	}
+	go p.run()
+	p.wake()
+	return p
+}
+
+/*
+ * Acquire 取出一个就绪邮箱，取出后即不再属于池（池会在后台补充）
+ * 池为空时等待补充完成，直到 ctx 取消；池已关闭时返回 ErrPoolClosed
+ */
+func (p *MailboxPool) Acquire(ctx context.Context) (*EmailInfo, error) {
+	for {
+		p.mu.Lock()
+		if p.closed {
+			p.mu.Unlock()
+			return nil, ErrPoolClosed
+		}
+		p.sweepLocked(time.Now())
+		if len(p.ready) > 0 {
+			e := p.ready[0]
+			p.ready = p.ready[1:]
+			p.mu.Unlock()
+			p.wake()
+			return e.info, nil
+		}
+		wait := p.changed
+		p.mu.Unlock()
+		p.wake()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+/* Len 当前就绪邮箱数 */
+func (p *MailboxPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.ready)
+}
+
+/* Stats 返回池状态快照 */
+func (p *MailboxPool) Stats() PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := p.stats
+	s.Ready = len(p.ready)
+	s.Pending = p.pending
+	s.Backends = p.backendCountsLocked()
+	return s
+}
+
+/* Close 停止后台补充并唤醒等待中的 Acquire；已创建的邮箱不会被销毁 */
+func (p *MailboxPool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	p.signalLocked()
+	p.mu.Unlock()
+	p.cancel()
+	<-p.done
+}
+
+/* wake 通知后台协程检查是否需要补充 */
+func (p *MailboxPool) wake() {
+	select {
+	case p.kick <- struct{}{}:
+	default:
+	}
+}
+
+/* signalLocked 唤醒所有等待中的 Acquire */
+func (p *MailboxPool) signalLocked() {
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+/* run 后台补充协程：被唤醒或定时检查时按缺口启动创建任务 */
+func (p *MailboxPool) run() {
+	var wg sync.WaitGroup
+	defer func() {
+		wg.Wait()
+		close(p.done)
+	}()
+	ticker := time.NewTicker(poolSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		p.mu.Lock()
+		p.sweepLocked(time.Now())
+		n := min(p.opts.Size-len(p.ready)-p.pending, p.opts.RefillConcurrency-p.pending)
+		delay := time.Duration(0)
+		if p.failures > 0 {
+			delay = min(p.opts.RetryDelay<<min(p.failures-1, 8), maxPoolRetryDelay)
+		}
+		for range max(n, 0) {
+			p.pending++
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.refillOne(delay)
+			}()
+		}
+		p.mu.Unlock()
+
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-p.kick:
+		case <-ticker.C:
+		}
+	}
+}
+
+/* refillOne 创建并校验一个邮箱，成功后放入池中 */
+func (p *MailboxPool) refillOne(delay time.Duration) {
+	defer p.wake()
+	if delay > 0 {
+		select {
+		case <-p.ctx.Done():
+			p.finish(nil, nil)
+			return
+		case <-time.After(delay):
+		}
+	}
+
+	info, err := p.in.generateEmailWith(p.ctx, p.opts.Generate, p)
+	if err == nil && (p.opts.Validate == nil || *p.opts.Validate) {
+		err = p.validate(info)
+	}
+	p.finish(info, err)
+}
+
+/* validate 拉取一次收件箱，确认邮箱与令牌可用 */
+func (p *MailboxPool) validate(info *EmailInfo) error {
+	var retry *RetryOptions
+	if p.opts.Generate != nil {
+		retry = p.opts.Generate.Retry
+	}
+	result, err := p.in.getEmails(p.ctx, info, &GetEmailsOptions{Retry: retry})
+	if err != nil {
+		return err
+	}
+	if !result.Success {
+		if result.Err != nil {
+			return result.Err
+		}
+		return ErrChannelUnavailable
+	}
+	return nil
+}
+
+/* finish 记录一次补充结果；info 为 nil 且 err 为 nil 表示因关闭而放弃 */
+func (p *MailboxPool) finish(info *EmailInfo, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pending--
+	if info != nil {
+		p.releaseLocked(backendOf(info.Channel))
+	}
+	log := p.in.log()
+
+	if p.closed || p.ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		p.failures++
+		p.stats.Failures++
+		if info != nil {
+			p.stats.Discarded++
+		}
+		log.Warn("邮箱池补充失败", "error", err, "consecutive", p.failures)
+		return
+	}
+	if info == nil {
+		return
+	}
+
+	/* 新邮箱被丢弃同样计入连续失败，避免渠道总是返回短有效期时空转 */
+	now := time.Now()
+	e := poolEntry{info: info, backend: backendOf(info.Channel), addedAt: now}
+	if p.expiredLocked(e, now) {
+		p.failures++
+		p.stats.Discarded++
+		log.Debug("邮箱池丢弃临近过期的新邮箱", "channel", string(info.Channel), "email", info.Email)
+		return
+	}
+	p.failures = 0
+	p.ready = append(p.ready, e)
+	p.stats.Created++
+	log.Debug("邮箱池新增邮箱", "channel", string(info.Channel), "email", info.Email, "ready", len(p.ready))
+	p.signalLocked()
+}
+
+/*
+ * acquire 创建时占用后端名额：池中就绪数加上补充中的数量达到 MaxPerBackend 时返回 false，
+ * 避免并发补充同时命中同一后端；名额在 finish 入池或丢弃时归还
+ */
+func (p *MailboxPool) acquire(backend string) bool {
+	if p.opts.MaxPerBackend <= 0 {
+		return true
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.backendCountsLocked()[backend]+p.inflight[backend] >= p.opts.MaxPerBackend {
+		return false
+	}
+	p.inflight[backend]++
+	return true
+}
+
+/* release 归还 acquire 占用的后端名额 */
+func (p *MailboxPool) release(backend string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.releaseLocked(backend)
+}
+
+func (p *MailboxPool) releaseLocked(backend string) {
+	if p.opts.MaxPerBackend <= 0 {
+		return
+	}
+	if p.inflight[backend] <= 1 {
+		delete(p.inflight, backend)
+		return
+	}
+	p.inflight[backend]--
+}
+
+func (p *MailboxPool) backendCountsLocked() map[string]int {
+	counts := make(map[string]int, len(p.ready))
+	for _, e := range p.ready {
+		counts[e.backend]++
+	}
+	return counts
+}
+
+/* sweepLocked 移除临近过期或超过 MaxAge 的条目 */
+func (p *MailboxPool) sweepLocked(now time.Time) {
+	kept := p.ready[:0]
+	for _, e := range p.ready {
+		if p.expiredLocked(e, now) {
+			p.stats.Discarded++
+			continue
+		}
+		kept = append(kept, e)
+	}
+	clear(p.ready[len(kept):])
+	p.ready = kept
+}
+
+func (p *MailboxPool) expiredLocked(e poolEntry, now time.Time) bool {
+	if p.opts.MaxAge > 0 && now.Sub(e.addedAt) >= p.opts.MaxAge {
+		return true
+	}
+	if t, ok := e.info.ExpiresAtTime(); ok && t.Sub(now) < p.opts.MinTTL {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package tempemail
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/*
+ * TestMailboxPoolAcquire 校验邮箱池：后台补充到 Size、丢弃临近过期与校验失败的邮箱、
+ * Acquire 后自动补充、Close 后返回 ErrPoolClosed
+ */
+func TestMailboxPoolAcquire(t *testing.T) {
+	var seq int32
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-pool",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			n := atomic.AddInt32(&seq, 1)
+			info := &EmailInfo{Channel: "test-pool", Email: fmt.Sprintf("p%d@x", n)}
+			switch n % 3 {
+			case 1: /* 30 秒后过期，小于 MinTTL，应被丢弃 */
+				info.ExpiresAt = time.Now().Add(30 * time.Second).UnixMilli()
+			case 2:
+				info.ExpiresAt = time.Now().Add(time.Hour).Format(time.RFC3339)
+			}
+			return info, nil
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			if email == "p3@x" {
+				return nil, ErrInvalidToken
+			}
+			return nil, nil
+		},
+	})
+
+	pool := newOfflineClient().NewMailboxPool(&PoolOptions{
+		Size:       2,
+		Generate:   &GenerateEmailOptions{Channel: "test-pool", Retry: &RetryOptions{MaxRetries: 0}},
+		MinTTL:     time.Minute,
+		RetryDelay: 10 * time.Millisecond,
+	})
+	defer pool.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for pool.Len() < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	stats := pool.Stats()
+	if stats.Ready != 2 || stats.Discarded < 2 {
+		t.Fatalf("期望 2 个就绪且至少丢弃 2 个，实际 %+v", stats)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	seen := map[string]bool{}
+	for range 3 {
+		info, err := pool.Acquire(ctx)
+		if err != nil {
+			t.Fatalf("Acquire: %v", err)
+		}
+		if seen[info.Email] || info.Email == "p1@x" || info.Email == "p3@x" {
+			t.Fatalf("取出了重复、临近过期或校验失败的邮箱: %s", info.Email)
+		}
+		seen[info.Email] = true
+	}
+
+	pool.Close()
+	if _, err := pool.Acquire(ctx); err != ErrPoolClosed {
+		t.Fatalf("关闭后期望 ErrPoolClosed，实际 %v", err)
+	}
+}
+
+/* TestMailboxPoolMaxPerBackendInflight 校验并发补充时补充中的邮箱计入后端限额，不会超额创建后再丢弃 */
+func TestMailboxPoolMaxPerBackendInflight(t *testing.T) {
+	var seq, inflight, peak int32
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-pool-quota",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			n := atomic.AddInt32(&inflight, 1)
+			defer atomic.AddInt32(&inflight, -1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(50 * time.Millisecond)
+			return &EmailInfo{Channel: "test-pool-quota", Email: fmt.Sprintf("q%d@x", atomic.AddInt32(&seq, 1))}, nil
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) { return nil, nil },
+	})
+
+	pool := newOfflineClient().NewMailboxPool(&PoolOptions{
+		Size:              3,
+		MaxPerBackend:     1,
+		RefillConcurrency: 3,
+		Generate:          &GenerateEmailOptions{Channel: "test-pool-quota", Retry: &RetryOptions{MaxRetries: 0}},
+		RetryDelay:        10 * time.Millisecond,
+	})
+	defer pool.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for pool.Len() < 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(100 * time.Millisecond)
+	stats := pool.Stats()
+	if stats.Ready != 1 || stats.Discarded != 0 || atomic.LoadInt32(&peak) != 1 {
+		t.Fatalf("期望同一后端仅创建 1 个且无丢弃，实际 %+v，并发峰值 %d", stats, peak)
+	}
+}
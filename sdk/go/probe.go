@@ -0,0 +1,349 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+ * 渠道探测
+ * ProbeChannels 对每个渠道创建一个邮箱并多次读取（空）收件箱，记录耗时、错误类别，
+ * 并按 devlog 的“邮箱可锁定”判据检查：重复读信必须始终是同一邮箱的收件箱——
+ * 每次读信都要成功、邮箱不能中途失效、收件箱中不能出现发往其他地址的邮件。
+ * 只有收件箱中有解析出收件人的邮件可供比对时才判定为可锁定；空收件箱的结论为 LockabilityUnknown。
+ * 探测不需要真实发信账号，比 verify 程序轻量，适合定时巡检；完整的收信验证仍使用 verify。
+ * 探测结果同时计入渠道健康度统计（见 ChannelStats），不上报遥测。
+ *
+ * 示例:
+ *   report, _ := tempemail.ProbeChannels(ctx, &tempemail.ProbeOptions{Concurrency: 8})
+ *   for _, r := range report.Results {
+ *       if r.Status != tempemail.ProbeOK {
+ *           fmt.Println(r.Channel, r.Status, r.ErrorClass, r.Error)
+ *       }
+ *   }
+ */
+
+/* ProbeOptions 渠道探测选项 */
+type ProbeOptions struct {
+	/* 同时探测的渠道数，默认 4 */
+	Concurrency int
+	/* 要探测的渠道，空表示全部渠道 */
+	Channels []Channel
+	/* 每个渠道的读信次数，默认且至少 2 次（判断邮箱可锁定） */
+	Reads int
+	/* 两次读信的间隔，默认 1 秒 */
+	ReadInterval time.Duration
+	/* 单个渠道的探测超时（含创建与全部读信），默认 60 秒 */
+	Timeout time.Duration
+	/* 重试配置，nil 则使用默认值 */
+	Retry *RetryOptions
+}
+
+/* ProbeStatus 单个渠道的探测结论 */
+type ProbeStatus string
+
+const (
+	/* 创建与读信均正常，未发现不可锁定的迹象（是否确认可锁定见 ProbeResult.Lockable） */
+	ProbeOK ProbeStatus = "ok"
+	/* 创建邮箱失败，或返回的渠道与请求的不一致 */
+	ProbeGenerateFailed ProbeStatus = "generate_failed"
+	/* 创建成功但读信失败 */
+	ProbeReadFailed ProbeStatus = "read_failed"
+	/* 读信成功但邮箱不可锁定（中途失效或出现发往其他地址的邮件） */
+	ProbeNotLockable ProbeStatus = "not_lockable"
+	/* ctx 在开始探测前已取消，未探测 */
+	ProbeSkipped ProbeStatus = "skipped"
+)
+
+/* probeDestroyTimeout 探测结束后销毁邮箱的超时，不受已到期的探测 ctx 影响 */
+const probeDestroyTimeout = 10 * time.Second
+
+/* Lockability 邮箱是否可锁定的判定 */
+type Lockability string
+
+const (
+	/* 无从判断：收件箱为空或邮件未解析出收件人，没有可比对的邮件 */
+	LockabilityUnknown Lockability = "unknown"
+	/* 每次读信都成功，且收件箱中的邮件均发往该邮箱 */
+	LockabilityYes Lockability = "yes"
+	/* 读信失败、邮箱中途失效或出现发往其他地址的邮件 */
+	LockabilityNo Lockability = "no"
+)
+
+/* ProbeResult 单个渠道的探测结果 */
+type ProbeResult struct {
+	/* 渠道标识 */
+	Channel Channel `json:"channel"`
+	/* 渠道所属后端 */
+	Backend string `json:"backend"`
+	/* 探测结论 */
+	Status ProbeStatus `json:"status"`
+	/* 创建的邮箱地址 */
+	Email string `json:"email,omitempty"`
+	/* 创建邮箱耗时（含重试） */
+	GenerateLatency time.Duration `json:"generateLatency"`
+	/* 成功读信的平均耗时（含重试） */
+	ReadLatency time.Duration `json:"readLatency"`
+	/* 成功读信次数 */
+	Reads int `json:"reads"`
+	/* 重复读信是否始终为同一邮箱的收件箱；没有可比对的邮件时为 LockabilityUnknown */
+	Lockable Lockability `json:"lockable"`
+	/* 失败原因的类别 */
+	ErrorClass ErrorClass `json:"errorClass,omitempty"`
+	/* 失败原因 */
+	Error string `json:"error,omitempty"`
+	/* 原始错误，可用 errors.Is / errors.As 判断 */
+	Err error `json:"-"`
+	/* 该渠道探测总耗时 */
+	Elapsed time.Duration `json:"elapsed"`
+}
+
+/* ProbeReport 探测报告，Results 与探测的渠道顺序一致 */
+type ProbeReport struct {
+	/* 开始时间 */
+	StartedAt time.Time `json:"startedAt"`
+	/* 总耗时 */
+	Elapsed time.Duration `json:"elapsed"`
+	/* 各渠道结果 */
+	Results []ProbeResult `json:"results"`
+}
+
+/* Healthy 探测通过的渠道 */
+func (r *ProbeReport) Healthy() []Channel {
+	var out []Channel
+	for _, res := range r.Results {
+		if res.Status == ProbeOK {
+			out = append(out, res.Channel)
+		}
+	}
+	return out
+}
+
+/* Failed 未通过探测的结果，不含未探测（ProbeSkipped）的渠道 */
+func (r *ProbeReport) Failed() []ProbeResult {
+	var out []ProbeResult
+	for _, res := range r.Results {
+		if res.Status != ProbeOK && res.Status != ProbeSkipped {
+			out = append(out, res)
+		}
+	}
+	return out
+}
+
+/* Counts 各结论的渠道数 */
+func (r *ProbeReport) Counts() map[ProbeStatus]int {
+	out := make(map[ProbeStatus]int)
+	for _, res := range r.Results {
+		out[res.Status]++
+	}
+	return out
+}
+
+/*
+ * ProbeChannels 在默认实例上探测渠道健康状况
+ * 单个渠道失败记录在结果中，不返回 error；仅选项非法时返回 error。ctx 取消后未开始的渠道不再探测，
+ * 结论为 ProbeSkipped；进行中的渠道以取消错误结束
+ */
+func ProbeChannels(ctx context.Context, opts *ProbeOptions) (*ProbeReport, error) {
+	return defaultInstance.probeChannels(ctx, opts)
+}
+
+/* ProbeChannels 使用该客户端配置（代理等）探测渠道，见 ProbeChannels */
+func (c *Client) ProbeChannels(ctx context.Context, opts *ProbeOptions) (*ProbeReport, error) {
+	return c.instance().probeChannels(ctx, opts)
+}
+
+func (in *instance) probeChannels(ctx context.Context, opts *ProbeOptions) (*ProbeReport, error) {
+	o := ProbeOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 4
+	}
+	if o.Reads < 2 {
+		o.Reads = 2
+	}
+	if o.ReadInterval <= 0 {
+		o.ReadInterval = time.Second
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = 60 * time.Second
+	}
+	channels := o.Channels
+	if len(channels) == 0 {
+		channels = append([]Channel(nil), allChannels...)
+	}
+	for _, ch := range channels {
+		if spec, ok := channelRegistryMap[ch]; !ok || spec.Generate == nil || spec.GetEmails == nil {
+			return nil, fmt.Errorf("unknown channel: %s", ch)
+		}
+	}
+
+	ctx = withInstance(ctx, in)
+	report := &ProbeReport{StartedAt: time.Now(), Results: make([]ProbeResult, len(channels))}
+	sem := make(chan struct{}, o.Concurrency)
+	var wg sync.WaitGroup
+	for i, ch := range channels {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			report.Results[i] = probeFailure(ch, ProbeSkipped, ctx.Err())
+			continue
+		}
+		wg.Add(1)
+		go func(i int, ch Channel) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			report.Results[i] = in.probeChannel(ctx, ch, o)
+		}(i, ch)
+	}
+	wg.Wait()
+	report.Elapsed = time.Since(report.StartedAt)
+
+	counts := report.Counts()
+	in.log().Info("渠道探测完成", "channels", len(channels), "ok", counts[ProbeOK], "elapsed", report.Elapsed.String())
+	return report, nil
+}
+
+func probeFailure(ch Channel, status ProbeStatus, err error) ProbeResult {
+	return ProbeResult{
+		Channel:    ch,
+		Backend:    backendOf(ch),
+		Status:     status,
+		Lockable:   LockabilityUnknown,
+		ErrorClass: ClassifyError(err),
+		Error:      err.Error(),
+		Err:        err,
+	}
+}
+
+/* probeChannel 探测单个渠道：创建邮箱（不回退到其他渠道）后按间隔多次读取收件箱，结束后尽量销毁邮箱 */
+func (in *instance) probeChannel(ctx context.Context, ch Channel, o ProbeOptions) ProbeResult {
+	ctx, cancel := context.WithTimeout(ctx, o.Timeout)
+	defer cancel()
+	start := time.Now()
+	log := in.log()
+
+	info, _, err := withRetryAndAttempts(ctx, func() (*EmailInfo, error) {
+		return generateEmailOnce(ctx, ch, &GenerateEmailOptions{})
+	}, o.Retry)
+	genLatency := time.Since(start)
+	if err == nil && (info == nil || info.Channel != ch) {
+		err = fmt.Errorf("%s: 创建的邮箱属于其他渠道: %w", ch, ErrChannelUnavailable)
+	}
+	if err != nil {
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			in.recordChannelResult(ch, true, false, genLatency)
+		}
+		r := probeFailure(ch, ProbeGenerateFailed, err)
+		r.GenerateLatency = genLatency
+		r.Elapsed = time.Since(start)
+		log.Warn("渠道探测：创建失败", "channel", string(ch), "error", err.Error())
+		return r
+	}
+	info.inst = in
+	in.recordChannelResult(ch, true, true, genLatency)
+
+	r := ProbeResult{
+		Channel:         ch,
+		Backend:         backendOf(ch),
+		Status:          ProbeOK,
+		Email:           info.Email,
+		GenerateLatency: genLatency,
+		Lockable:        LockabilityUnknown,
+	}
+	compared := false
+	var readTotal time.Duration
+	for i := 0; i < o.Reads; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(o.ReadInterval):
+			}
+			if ctx.Err() != nil {
+				err = ctx.Err()
+				break
+			}
+		}
+		readStart := time.Now()
+		var emails []Email
+		emails, _, err = withRetryAndAttempts(ctx, func() ([]Email, error) {
+			return getEmailsOnce(ctx, ch, info.Email, info.token)
+		}, o.Retry)
+		d := time.Since(readStart)
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			in.recordChannelResult(ch, false, err == nil, d)
+		}
+		if err != nil {
+			break
+		}
+		r.Reads++
+		readTotal += d
+		foreign, checked := foreignRecipient(emails, info.Email)
+		compared = compared || checked
+		if foreign != "" {
+			r.Lockable = LockabilityNo
+			r.Status = ProbeNotLockable
+			r.Error = fmt.Sprintf("收件箱中出现发往 %s 的邮件", foreign)
+		}
+	}
+	if r.Reads > 0 {
+		r.ReadLatency = readTotal / time.Duration(r.Reads)
+	}
+	if r.Status == ProbeOK && err == nil && compared {
+		r.Lockable = LockabilityYes
+	}
+	if err != nil {
+		r.Lockable = LockabilityNo
+		r.Status = ProbeReadFailed
+		if isMailboxGone(err) {
+			r.Status = ProbeNotLockable
+		}
+		r.ErrorClass = ClassifyError(err)
+		r.Error = err.Error()
+		r.Err = err
+	}
+
+	if spec := channelRegistryMap[ch]; spec.Destroy != nil {
+		/* 探测 ctx 可能已超时，销毁使用独立的短超时，仍携带实例 */
+		dctx, dcancel := context.WithTimeout(context.WithoutCancel(ctx), probeDestroyTimeout)
+		if derr := spec.Destroy(dctx, info.Email, info.token); derr != nil {
+			log.Debug("渠道探测：销毁邮箱失败", "channel", string(ch), "error", derr.Error())
+		}
+		dcancel()
+	}
+	r.Elapsed = time.Since(start)
+	log.Debug("渠道探测完成", "channel", string(ch), "status", string(r.Status), "elapsed", r.Elapsed.String())
+	return r
+}
+
+/*
+ * foreignRecipient 返回第一个收件人中不含 addr 的邮件的收件人；未解析出收件人的邮件不参与判断，
+ * compared 表示是否有邮件参与了比对
+ */
+func foreignRecipient(emails []Email, addr string) (foreign string, compared bool) {
+	for _, e := range emails {
+		if len(e.ToAddresses) == 0 {
+			continue
+		}
+		compared = true
+		mine := false
+		for _, a := range e.ToAddresses {
+			if strings.EqualFold(a.Address, addr) {
+				mine = true
+				break
+			}
+		}
+		if !mine {
+			return e.ToAddresses[0].Address, true
+		}
+	}
+	return "", compared
+}
@@ -0,0 +1,142 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/*
+ * TestProbeChannels 校验渠道探测：正常渠道（空收件箱无从判断可锁定）、创建失败、重复读信时邮箱失效、
+ * 收件箱邮件均发往本邮箱（可锁定）或出现发往其他地址的邮件（不可锁定），以及结果顺序与错误类别
+ */
+func TestProbeChannels(t *testing.T) {
+	gen := func(ch Channel) func(context.Context, *GenerateEmailOptions) (*EmailInfo, error) {
+		return func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return &EmailInfo{Channel: ch, Email: string(ch) + "@x.com", token: "tok"}, nil
+		}
+	}
+	empty := func(ctx context.Context, email, token string) ([]Email, error) { return nil, nil }
+	var reads int32
+	registerFakeChannel(t, ChannelSpec{Channel: "probe-ok", Generate: gen("probe-ok"), GetEmails: empty})
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "probe-genfail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return nil, fmt.Errorf("need captcha: %w", ErrCaptchaRequired)
+		},
+		GetEmails: empty,
+	})
+	registerFakeChannel(t, ChannelSpec{
+		Channel:  "probe-gone",
+		Generate: gen("probe-gone"),
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			if atomic.AddInt32(&reads, 1) > 1 {
+				return nil, ErrMailboxExpired
+			}
+			return nil, nil
+		},
+	})
+	registerFakeChannel(t, ChannelSpec{
+		Channel:  "probe-mine",
+		Generate: gen("probe-mine"),
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return []Email{{ID: "1", ToAddresses: []Address{{Address: email}}}}, nil
+		},
+	})
+	registerFakeChannel(t, ChannelSpec{
+		Channel:  "probe-foreign",
+		Generate: gen("probe-foreign"),
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return []Email{{ID: "1", ToAddresses: []Address{{Address: "someone-else@y.com"}}}}, nil
+		},
+	})
+
+	client := newOfflineClient()
+	report, err := client.ProbeChannels(context.Background(), &ProbeOptions{
+		Channels:     []Channel{"probe-ok", "probe-genfail", "probe-gone", "probe-mine", "probe-foreign"},
+		Concurrency:  2,
+		ReadInterval: time.Millisecond,
+		Retry:        &RetryOptions{MaxRetries: 0},
+	})
+	if err != nil {
+		t.Fatalf("ProbeChannels: %v", err)
+	}
+	want := []struct {
+		status   ProbeStatus
+		class    ErrorClass
+		lockable Lockability
+	}{
+		{ProbeOK, "", LockabilityUnknown},
+		{ProbeGenerateFailed, ErrorClassCaptcha, LockabilityUnknown},
+		{ProbeNotLockable, ErrorClassMailboxExpired, LockabilityNo},
+		{ProbeOK, "", LockabilityYes},
+		{ProbeNotLockable, "", LockabilityNo},
+	}
+	for i, w := range want {
+		r := report.Results[i]
+		if r.Status != w.status || r.ErrorClass != w.class || r.Lockable != w.lockable {
+			t.Fatalf("%s: %+v", r.Channel, r)
+		}
+	}
+	if r := report.Results[0]; r.Reads != 2 || r.Email != "probe-ok@x.com" {
+		t.Fatalf("probe-ok: %+v", r)
+	}
+	if !errors.Is(report.Results[2].Err, ErrMailboxExpired) || len(report.Healthy()) != 2 || len(report.Failed()) != 3 {
+		t.Fatalf("报告汇总不符: %+v", report.Counts())
+	}
+
+	if _, err := client.ProbeChannels(context.Background(), &ProbeOptions{Channels: []Channel{"probe-missing"}}); err == nil {
+		t.Fatal("未知渠道应返回错误")
+	}
+}
+
+/*
+ * TestProbeChannelsCancelAndCleanup 校验 ctx 取消前未开始的渠道记为 ProbeSkipped、不计入 Failed，
+ * 探测超时后仍以独立的 ctx 销毁邮箱
+ */
+func TestProbeChannelsCancelAndCleanup(t *testing.T) {
+	var destroyErr atomic.Value
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "probe-slow",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return &EmailInfo{Channel: "probe-slow", Email: "slow@x.com"}, nil
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			destroyErr.Store(fmt.Sprint(ctx.Err()))
+			return nil
+		},
+	})
+
+	client := newOfflineClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report, err := client.ProbeChannels(ctx, &ProbeOptions{Channels: []Channel{"probe-slow", "probe-slow"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := report.Counts(); c[ProbeSkipped] != 2 || len(report.Failed()) != 0 {
+		t.Fatalf("取消后未开始的渠道应为 skipped 且不计入 Failed: %v", c)
+	}
+
+	report, err = client.ProbeChannels(context.Background(), &ProbeOptions{
+		Channels: []Channel{"probe-slow"},
+		Timeout:  20 * time.Millisecond,
+		Retry:    &RetryOptions{MaxRetries: 0},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := report.Results[0]; r.Status != ProbeReadFailed {
+		t.Fatalf("读信超时应为 read_failed: %+v", r)
+	}
+	if got, _ := destroyErr.Load().(string); got != "<nil>" {
+		t.Fatalf("探测超时后应以未过期的 ctx 销毁邮箱，实际 ctx.Err()=%q", got)
+	}
+}
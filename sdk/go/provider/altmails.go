@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,7 +25,7 @@ var altmailsCSRFRe = regexp.MustCompile(`'_token'\s*:\s*'([^']+)'`)
 
 /* altmailsBrowserHeaders 设置浏览器模拟请求头 */
 func altmailsBrowserHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 }
@@ -32,10 +33,10 @@ func altmailsBrowserHeaders(req *http.Request) {
 /* altmailsFetchCSRF 访问首页建立 session 并从 HTML inline script 中提取 CSRF token
  * 返回提取到的 CSRF token 值
  */
-func altmailsFetchCSRF(client interface {
+func altmailsFetchCSRF(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }) (string, error) {
-	req, err := http.NewRequest("GET", altmailsBaseURL+"/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", altmailsBaseURL+"/", nil)
 	if err != nil {
 		return "", fmt.Errorf("altmails: 创建首页请求失败: %w", err)
 	}
@@ -71,17 +72,17 @@ func altmailsFetchCSRF(client interface {
  *   2. GET /random-email-address 获取随机邮箱地址（纯文本响应）
  * token: CSRF token 值
  */
-func AltmailsGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func AltmailsGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	/* 步骤 1：GET / 获取 session + CSRF token */
-	csrfToken, err := altmailsFetchCSRF(client)
+	csrfToken, err := altmailsFetchCSRF(ctx, client)
 	if err != nil {
 		return nil, err
 	}
 
 	/* 步骤 2：GET /random-email-address 获取邮箱地址 */
-	req, err := http.NewRequest("GET", altmailsBaseURL+"/random-email-address", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", altmailsBaseURL+"/random-email-address", nil)
 	if err != nil {
 		return nil, fmt.Errorf("altmails: 创建随机邮箱请求失败: %w", err)
 	}
@@ -122,16 +123,16 @@ func AltmailsGenerate() (*CreatedMailbox, error) {
  *   3. 对每封邮件 GET /view/{id} 获取邮件正文 HTML
  * 返回 JSON 数组 [{id, from, subject}]
  */
-func AltmailsGetEmails(email, token string) ([]NormEmail, error) {
+func AltmailsGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("altmails: 邮箱地址为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	/* 步骤 1：GET / 重新建立 session + 获取新 CSRF token */
-	csrfToken, err := altmailsFetchCSRF(client)
+	csrfToken, err := altmailsFetchCSRF(ctx, client)
 	if err != nil {
 		return nil, err
 	}
@@ -141,11 +142,11 @@ func AltmailsGetEmails(email, token string) ([]NormEmail, error) {
 	form.Set("_token", csrfToken)
 
 	fetchURL := fmt.Sprintf("%s/fetch-emails/%s", altmailsBaseURL, url.PathEscape(email))
-	req, err := http.NewRequest("POST", fetchURL, strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", fetchURL, strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("altmails: 创建获取邮件请求失败: %w", err)
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
 	req.Header.Set("Accept", "application/json, text/plain, */*")
@@ -197,7 +198,7 @@ func AltmailsGetEmails(email, token string) ([]NormEmail, error) {
 		/* GET /view/{id} 获取邮件正文 HTML */
 		if mailID != "" {
 			viewURL := fmt.Sprintf("%s/view/%s", altmailsBaseURL, url.PathEscape(mailID))
-			viewReq, viewErr := http.NewRequest("GET", viewURL, nil)
+			viewReq, viewErr := http.NewRequestWithContext(ctx, "GET", viewURL, nil)
 			if viewErr == nil {
 				altmailsBrowserHeaders(viewReq)
 				viewReq.Header.Set("Referer", altmailsBaseURL+"/")
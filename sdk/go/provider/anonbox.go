@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/mail"
+	"net/textproto"
 	"regexp"
 	"strconv"
 	"strings"
@@ -147,7 +150,9 @@ func anonboxMboxBlockToRaw(block string, recipient string) map[string]interface{
 		i = 1
 	}
 	headers := make(map[string]string)
-	var curKey string
+	/* 完整头部（规范化键名，保留重复头的全部值），供 Email.Headers */
+	allHeaders := make(map[string][]string)
+	var curKey, curCanon string
 	for ; i < len(lines); i++ {
 		line := lines[i]
 		if line == "" {
@@ -156,12 +161,16 @@ func anonboxMboxBlockToRaw(block string, recipient string) map[string]interface{
 		}
 		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && curKey != "" {
 			headers[curKey] += " " + strings.TrimSpace(line)
+			vals := allHeaders[curCanon]
+			vals[len(vals)-1] += " " + strings.TrimSpace(line)
 			continue
 		}
 		idx := strings.Index(line, ":")
 		if idx > 0 {
 			curKey = strings.ToLower(strings.TrimSpace(line[:idx]))
+			curCanon = textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(line[:idx]))
 			headers[curKey] = strings.TrimSpace(line[idx+1:])
+			allHeaders[curCanon] = append(allHeaders[curCanon], strings.TrimSpace(line[idx+1:]))
 		}
 	}
 	body := strings.Join(lines[i:], "\n")
@@ -169,6 +178,7 @@ func anonboxMboxBlockToRaw(block string, recipient string) map[string]interface{
 	ct := strings.ToLower(headers["content-type"])
 	text := ""
 	html := ""
+	attachments := []interface{}{}
 	if strings.Contains(ct, "multipart/") {
 		bm := regexp.MustCompile(`(?i)boundary="?([^";\s]+)"?`).FindStringSubmatch(headers["content-type"])
 		if len(bm) > 1 {
@@ -191,7 +201,9 @@ func anonboxMboxBlockToRaw(block string, recipient string) map[string]interface{
 				if len(pctM) > 1 {
 					pct = strings.ToLower(strings.TrimSpace(pctM[1]))
 				}
-				if pct == "text/plain" {
+				if att := anonboxPartAttachment(ph, pb); att != nil {
+					attachments = append(attachments, att)
+				} else if pct == "text/plain" {
 					text = anonboxDecodeQP(pb, ph)
 				} else if pct == "text/html" {
 					html = anonboxDecodeQP(pb, ph)
@@ -240,25 +252,75 @@ func anonboxMboxBlockToRaw(block string, recipient string) map[string]interface{
 		"body_html":   html,
 		"date":        dateStr,
 		"isRead":      false,
-		"attachments": []interface{}{},
+		"attachments": attachments,
+		"headers":     allHeaders,
 	}
 }
 
-func AnonboxGenerate() (*CreatedMailbox, error) {
-	req, err := fhttp.NewRequest("GET", anonboxPageURL, nil)
+var (
+	anonboxPartHeaderRe = regexp.MustCompile(`(?im)^(content-type|content-disposition|content-transfer-encoding):\s*(.+)$`)
+	anonboxHeaderFoldRe = regexp.MustCompile(`\r?\n[ \t]+`)
+	anonboxFilenameRe   = regexp.MustCompile(`(?i)(?:file)?name\*?="?([^";\r\n]+)"?`)
+)
+
+/*
+ * anonboxPartAttachment mbox 内嵌了完整 MIME，附件部分（Content-Disposition: attachment
+ * 或带文件名的非文本部分）直接解码为附件内容；不是附件时返回 nil
+ */
+func anonboxPartAttachment(headerBlock, body string) map[string]interface{} {
+	headers := map[string]string{}
+	unfolded := anonboxHeaderFoldRe.ReplaceAllString(headerBlock, " ")
+	for _, m := range anonboxPartHeaderRe.FindAllStringSubmatch(unfolded, -1) {
+		headers[strings.ToLower(m[1])] = strings.TrimSpace(m[2])
+	}
+	disposition := headers["content-disposition"]
+	filename := ""
+	if m := anonboxFilenameRe.FindStringSubmatch(disposition); len(m) > 1 {
+		filename = m[1]
+	} else if m := anonboxFilenameRe.FindStringSubmatch(headers["content-type"]); len(m) > 1 {
+		filename = m[1]
+	}
+	if !strings.HasPrefix(strings.ToLower(disposition), "attachment") && filename == "" {
+		return nil
+	}
+
+	contentType, _, _ := strings.Cut(headers["content-type"], ";")
+	var content []byte
+	switch strings.ToLower(headers["content-transfer-encoding"]) {
+	case "base64":
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(body), ""))
+		if err != nil {
+			return nil
+		}
+		content = decoded
+	case "quoted-printable":
+		content = []byte(anonboxDecodeQP(body, "content-transfer-encoding: quoted-printable"))
+	default:
+		content = []byte(strings.TrimRight(body, "\r\n"))
+	}
+	return map[string]interface{}{
+		"filename":    filename,
+		"contentType": strings.TrimSpace(contentType),
+		"size":        float64(len(content)),
+		"content":     content,
+	}
+}
+
+func AnonboxGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := fhttp.NewRequestWithContext(ctx, "GET", anonboxPageURL, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("anonbox: generate HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "anonbox: generate HTTP %d", resp.StatusCode)
 	}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -275,7 +337,39 @@ func AnonboxGenerate() (*CreatedMailbox, error) {
 	return info, nil
 }
 
-func AnonboxGetEmails(token, email string) ([]NormEmail, error) {
+func AnonboxGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
+	blocks, err := anonboxFetchMbox(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]NormEmail, 0, len(blocks))
+	for _, b := range blocks {
+		out = append(out, NormalizeMap(anonboxMboxBlockToRaw(b, email), email))
+	}
+	return out, nil
+}
+
+// AnonboxGetRawEmail 返回单封邮件的原始 MIME 源码（mbox 中对应的一段，去掉 "From " 分隔行）
+func AnonboxGetRawEmail(ctx context.Context, token, email, id string) ([]byte, error) {
+	blocks, err := anonboxFetchMbox(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range blocks {
+		if raw := anonboxMboxBlockToRaw(b, email); raw["id"] == id {
+			if strings.HasPrefix(b, "From ") {
+				if nl := strings.Index(b, "\n"); nl >= 0 {
+					b = b[nl+1:]
+				}
+			}
+			return []byte(b), nil
+		}
+	}
+	return nil, markError(ErrEmailNotFound, "anonbox: email %s not found", id)
+}
+
+// anonboxFetchMbox 拉取收件箱 mbox 文本并拆分为单封邮件，邮箱不存在（404）时返回空
+func anonboxFetchMbox(ctx context.Context, token string) ([]string, error) {
 	if token == "" {
 		return nil, fmt.Errorf("internal error: token missing for anonbox")
 	}
@@ -285,40 +379,33 @@ func AnonboxGetEmails(token, email string) ([]NormEmail, error) {
 	}
 	url := anonboxBase + "/" + path
 
-	req, err := fhttp.NewRequest("GET", url, nil)
+	req, err := fhttp.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 	req.Header.Set("Accept", "text/plain,*/*")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode == 404 {
-		return []NormEmail{}, nil
+		return nil, nil
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("anonbox: get emails HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "anonbox: get emails HTTP %d", resp.StatusCode)
 	}
 	raw, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
-	t := strings.TrimSpace(string(raw))
-	if t == "" {
-		return []NormEmail{}, nil
-	}
-	blocks := anonboxSplitMbox(t)
-	out := make([]NormEmail, 0, len(blocks))
-	for _, b := range blocks {
-		b = strings.TrimSpace(b)
-		if b == "" {
-			continue
+	var blocks []string
+	for _, b := range anonboxSplitMbox(strings.TrimSpace(string(raw))) {
+		if b = strings.TrimSpace(b); b != "" {
+			blocks = append(blocks, b)
 		}
-		out = append(out, NormalizeMap(anonboxMboxBlockToRaw(b, email), email))
 	}
-	return out, nil
+	return blocks, nil
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -34,18 +35,18 @@ func anonymmailDefaultHeaders(req *http.Request) {
 	req.Header.Set("Accept", "*/*")
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Referer", "https://anonymmail.net/")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 }
 
 /* anonymmailFetchDomains 获取可用域名列表 */
-func anonymmailFetchDomains() ([]string, error) {
-	req, err := http.NewRequest("POST", anonymmailBase+"/api/getDomains", nil)
+func anonymmailFetchDomains(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", anonymmailBase+"/api/getDomains", nil)
 	if err != nil {
 		return nil, err
 	}
 	anonymmailDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +57,7 @@ func anonymmailFetchDomains() ([]string, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("anonymmail domains: %d", resp.StatusCode)
+		return nil, statusError(resp, "anonymmail domains: %d", resp.StatusCode)
 	}
 
 	var domainList []struct {
@@ -85,15 +86,15 @@ func anonymmailFetchDomains() ([]string, error) {
  * 2. 获取可用域名
  * 3. POST /api/create 创建邮箱
  */
-func AnonymmailGenerate(channel ...string) (*CreatedMailbox, error) {
-	client := HTTPClient()
+func AnonymmailGenerate(ctx context.Context, channel ...string) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	/* 步骤 1: HEAD 请求获取 session cookie */
-	headReq, err := http.NewRequest("HEAD", anonymmailBase+"/", nil)
+	headReq, err := http.NewRequestWithContext(ctx, "HEAD", anonymmailBase+"/", nil)
 	if err != nil {
 		return nil, err
 	}
-	headReq.Header.Set("User-Agent", getCurrentUA())
+	headReq.Header.Set("User-Agent", getCurrentUA(ctx))
 	headResp, err := client.Do(headReq)
 	if err != nil {
 		return nil, fmt.Errorf("anonymmail: HEAD failed: %w", err)
@@ -101,7 +102,7 @@ func AnonymmailGenerate(channel ...string) (*CreatedMailbox, error) {
 	headResp.Body.Close()
 
 	/* 步骤 2: 获取可用域名 */
-	domains, err := anonymmailFetchDomains()
+	domains, err := anonymmailFetchDomains(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +113,7 @@ func AnonymmailGenerate(channel ...string) (*CreatedMailbox, error) {
 	email := user + "@" + domain
 	createBody := "email=" + email
 
-	createReq, err := http.NewRequest("POST", anonymmailBase+"/api/create", strings.NewReader(createBody))
+	createReq, err := http.NewRequestWithContext(ctx, "POST", anonymmailBase+"/api/create", strings.NewReader(createBody))
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +130,7 @@ func AnonymmailGenerate(channel ...string) (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if createResp.StatusCode < 200 || createResp.StatusCode >= 300 {
-		return nil, fmt.Errorf("anonymmail create: %d", createResp.StatusCode)
+		return nil, statusError(createResp, "anonymmail create: %d", createResp.StatusCode)
 	}
 
 	var createResult struct {
@@ -159,20 +160,20 @@ func AnonymmailGenerate(channel ...string) (*CreatedMailbox, error) {
  * POST /api/get body: email={email}
  * 响应: {"email@domain":{"created_at":"...","emails":[...]}}
  */
-func AnonymmailGetEmails(email string) ([]NormEmail, error) {
+func AnonymmailGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("anonymmail: empty email")
 	}
 
 	reqBody := "email=" + email
-	req, err := http.NewRequest("POST", anonymmailBase+"/api/get", strings.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", anonymmailBase+"/api/get", strings.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
 	anonymmailDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +184,7 @@ func AnonymmailGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("anonymmail get: %d", resp.StatusCode)
+		return nil, statusError(resp, "anonymmail get: %d", resp.StatusCode)
 	}
 
 	/*
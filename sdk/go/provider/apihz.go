@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -28,10 +29,10 @@ const (
 var apihzDomains = []string{"apimail.email", "apimail.vip"}
 
 // apihzCredentials 读取 apihz 调用凭据：优先配置/环境变量，回退官方公共账号
-func apihzCredentials() (id, key string) {
+func apihzCredentials(ctx context.Context) (id, key string) {
 	id, key = apihzPublicID, apihzPublicKey
 	if GetConfigSnapshot != nil {
-		cfg := GetConfigSnapshot()
+		cfg := GetConfigSnapshot(ctx)
 		if s := strings.TrimSpace(cfg.ApihzID); s != "" {
 			id = s
 		}
@@ -121,8 +122,8 @@ type apihzListResponse struct {
 // ApihzGenerate 创建 apihz（接口盒子）临时邮箱
 // GET /api/mail/mailcache.php?id=&key=&domain=&name=&pwd=&buytype=0
 // 有效期 10 分钟，读信必须携带创建时的 pwd
-func ApihzGenerate() (*CreatedMailbox, error) {
-	id, key := apihzCredentials()
+func ApihzGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	id, key := apihzCredentials(ctx)
 	domain := apihzDomains[rand.Intn(len(apihzDomains))]
 	name := apihzRandomLocal(10)
 	pwd := apihzRandomPassword()
@@ -131,14 +132,14 @@ func ApihzGenerate() (*CreatedMailbox, error) {
 		apihzBaseURL, url.QueryEscape(id), url.QueryEscape(key),
 		url.QueryEscape(domain), url.QueryEscape(name), url.QueryEscape(pwd))
 
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("User-Agent", apihzUA)
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +181,7 @@ func ApihzGenerate() (*CreatedMailbox, error) {
 // ApihzGetEmails 获取 apihz 邮件列表
 // GET /api/mail/mailgetlist.php?id=&key=&mail=&pwd=&page=1
 // token 已含 mail 与 pwd，读信只需 token
-func ApihzGetEmails(token string) ([]NormEmail, error) {
+func ApihzGetEmails(ctx context.Context, token string) ([]NormEmail, error) {
 	if token == "" {
 		return nil, fmt.Errorf("apihz: 缺少 token")
 	}
@@ -188,20 +189,20 @@ func ApihzGetEmails(token string) ([]NormEmail, error) {
 	if err != nil {
 		return nil, err
 	}
-	id, key := apihzCredentials()
+	id, key := apihzCredentials(ctx)
 
 	u := fmt.Sprintf("%s/api/mail/mailgetlist.php?id=%s&key=%s&mail=%s&pwd=%s&page=1",
 		apihzBaseURL, url.QueryEscape(id), url.QueryEscape(key),
 		url.QueryEscape(mail), url.QueryEscape(pwd))
 
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("User-Agent", apihzUA)
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	http "github.com/bogdanfinn/fhttp"
+)
+
+// DownloadAttachmentURL 以实例的 TLS 指纹客户端下载附件，headers 为渠道所需的额外请求头（鉴权等）
+// 状态码 >= 400 时返回分类错误；成功时调用方负责关闭返回的 Body
+func DownloadAttachmentURL(ctx context.Context, rawURL string, headers map[string]string) (io.ReadCloser, error) {
+	if rawURL == "" {
+		return nil, fmt.Errorf("attachment url is empty")
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
+	req.Header.Set("Accept", "*/*")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := HTTPClient(ctx).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download attachment: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		return nil, statusError(resp, "download attachment: %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -48,14 +49,14 @@ func setAwamailHeaders(req *http.Request) {
 	for k, v := range awamailHeaders {
 		req.Header.Set(k, v)
 	}
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(req.Context()))
 }
 
 // AwamailGenerate 创建临时邮箱
 // API: POST /welcome/change_mailbox (空 body)
 // 需要保存响应中的 Set-Cookie (awamail_session) 用于后续获取邮件
-func AwamailGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest("POST", awamailBaseURL+"/change_mailbox", nil)
+func AwamailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", awamailBaseURL+"/change_mailbox", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +66,7 @@ func AwamailGenerate() (*CreatedMailbox, error) {
 	 * 不自动跟踪重定向，以便捕获 Set-Cookie
 	 * 使用独立的 TLS 客户端实例，避免影响全局缓存客户端
 	 */
-	noRedirectClient := HTTPClientNoRedirect()
+	noRedirectClient := HTTPClientNoRedirect(ctx)
 	resp, err := noRedirectClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -107,8 +108,8 @@ func AwamailGenerate() (*CreatedMailbox, error) {
 // AwamailGetEmails 获取邮件列表
 // API: GET /welcome/get_emails
 // 需要传入 Cookie (awamail_session) 和 x-requested-with 头
-func AwamailGetEmails(token string, email string) ([]NormEmail, error) {
-	req, err := http.NewRequest("GET", awamailBaseURL+"/get_emails", nil)
+func AwamailGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", awamailBaseURL+"/get_emails", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +117,7 @@ func AwamailGetEmails(token string, email string) ([]NormEmail, error) {
 	req.Header.Set("Cookie", token)
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
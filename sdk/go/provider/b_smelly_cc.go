@@ -1,6 +1,10 @@
 package provider
 
-func BSmellyCcGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "b-smelly-cc", Email: randomStr(12) + "@b.smelly.cc"}, nil
+import "context"
+
+func BSmellyCcGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "b-smelly-cc", Email: localPartOr(ctx, randomStr(12)) + "@b.smelly.cc"}, nil
+}
+func BSmellyCcGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func BSmellyCcGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
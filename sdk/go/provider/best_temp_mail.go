@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -44,7 +45,7 @@ func bestTempMailHeaders(req *http.Request) {
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("Referer", "https://best-temp-mail.com/")
 	req.Header.Set("Origin", "https://best-temp-mail.com")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 }
 
 /*
@@ -55,7 +56,7 @@ func bestTempMailHeaders(req *http.Request) {
  *   3. 解析响应中的 address、id、update_tag
  *   4. 将 intToken + id + update_tag 序列化为 JSON 存入 token
  */
-func BestTempMailGenerate(channel ...string) (*CreatedMailbox, error) {
+func BestTempMailGenerate(ctx context.Context, channel ...string) (*CreatedMailbox, error) {
 	intToken := bestTempMailGenUUID()
 
 	/* 构造请求体 */
@@ -64,13 +65,13 @@ func BestTempMailGenerate(channel ...string) (*CreatedMailbox, error) {
 		return nil, fmt.Errorf("best-temp-mail: 序列化请求体失败: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", bestTempMailAPIBase+"/createEmail", strings.NewReader(string(reqBody)))
+	req, err := http.NewRequestWithContext(ctx, "POST", bestTempMailAPIBase+"/createEmail", strings.NewReader(string(reqBody)))
 	if err != nil {
 		return nil, fmt.Errorf("best-temp-mail: 创建请求失败: %w", err)
 	}
 	bestTempMailHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("best-temp-mail: 请求创建邮箱失败: %w", err)
 	}
@@ -132,7 +133,7 @@ func BestTempMailGenerate(channel ...string) (*CreatedMailbox, error) {
  *   2. POST /api/v3/getEmailList {"address":"...","id":"...","intToken":"...","update_tag":"..."}
  *   3. 解析响应中的邮件列表并归一化
  */
-func BestTempMailGetEmails(email, token string) ([]NormEmail, error) {
+func BestTempMailGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("best-temp-mail: 邮箱地址为空")
@@ -155,13 +156,13 @@ func BestTempMailGetEmails(email, token string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("best-temp-mail: 序列化请求体失败: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", bestTempMailAPIBase+"/getEmailList", strings.NewReader(string(reqBody)))
+	req, err := http.NewRequestWithContext(ctx, "POST", bestTempMailAPIBase+"/getEmailList", strings.NewReader(string(reqBody)))
 	if err != nil {
 		return nil, fmt.Errorf("best-temp-mail: 创建请求失败: %w", err)
 	}
 	bestTempMailHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("best-temp-mail: 请求获取邮件失败: %w", err)
 	}
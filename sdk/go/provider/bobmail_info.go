@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // bobmail.info：mailinator 官方姊妹域名，MX 指向 mail.mailinator.com。
 
 // BobmailInfoGenerate 创建 bobmail.info 临时邮箱
-func BobmailInfoGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func BobmailInfoGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "bobmail-info",
 		Email:   local + "@bobmail.info",
@@ -12,6 +14,6 @@ func BobmailInfoGenerate() (*CreatedMailbox, error) {
 }
 
 // BobmailInfoGetEmails 读取 bobmail.info 邮件（复用 mailinator public API）
-func BobmailInfoGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func BobmailInfoGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
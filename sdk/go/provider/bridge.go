@@ -1,11 +1,14 @@
 /*
  * 桥接：根包 tempemail 在 init 中注入 HTTP/归一化等实现，
  * 避免 provider import tempemail 造成循环依赖（Go 单模块内子包不可与根包互引）。
+ * HTTP 客户端、UA 与配置按 ctx 所携带的 SDK 实例解析，须传入调用方的 ctx。
  */
 package provider
 
 import (
+	"context"
 	"encoding/json"
+	"time"
 
 	http "github.com/bogdanfinn/fhttp"
 	tls_client "github.com/bogdanfinn/tls-client"
@@ -38,48 +41,69 @@ type NormAttachment struct {
 	Size        int64
 	ContentType string
 	URL         string
+	Content     []byte
+}
+
+type NormAddress struct {
+	Name    string
+	Address string
 }
 
 type NormEmail struct {
 	ID          string
 	From        string
 	To          string
+	FromAddress NormAddress
+	ToAddresses []NormAddress
+	Cc          []NormAddress
+	ReplyTo     []NormAddress
 	Subject     string
 	Text        string
 	HTML        string
 	Date        string
 	IsRead      bool
 	Attachments []NormAttachment
+	Headers     map[string][]string
 }
 
 var (
 	// HTTPClient 由 tempemail.init 注入
-	HTTPClient func() tls_client.HttpClient
+	HTTPClient func(ctx context.Context) tls_client.HttpClient
 	// HTTPClientTenmailWangtz 10mail.wangtz.cn 等渠道默认跳过 TLS 校验的客户端；nil 时回退 HTTPClient
-	HTTPClientTenmailWangtz func() tls_client.HttpClient
+	HTTPClientTenmailWangtz func(ctx context.Context) tls_client.HttpClient
 	// HTTPClientNoRedirect 由 tempemail.init 注入（不跟随重定向）
-	HTTPClientNoRedirect func() tls_client.HttpClient
+	HTTPClientNoRedirect func(ctx context.Context) tls_client.HttpClient
 	// HTTPClientNoCookieJar 由 tempemail.init 注入（不持久化 Cookie，供 tempmailg 等）
-	HTTPClientNoCookieJar func() tls_client.HttpClient
+	HTTPClientNoCookieJar func(ctx context.Context) tls_client.HttpClient
+	// HTTPClientHooks 由 tempemail.init 注入（实例的请求前 / 响应后钩子，如按主机限速），供自建 TLS 客户端的渠道附加
+	HTTPClientHooks func(ctx context.Context) []tls_client.HttpClientOption
 	// CheckHTTPStatus 由 tempemail.init 注入（状态码 >=400 返回 error）
 	CheckHTTPStatus func(*http.Response, string) error
 	// GetCurrentUA 由 tempemail.init 注入
-	GetCurrentUA func() string
+	GetCurrentUA func(ctx context.Context) string
 	// GetConfigSnapshot 由 tempemail.init 注入
-	GetConfigSnapshot func() ConfigSnapshot
+	GetConfigSnapshot func(ctx context.Context) ConfigSnapshot
 	// NormalizeMap 将原始 map 转为 NormEmail
 	NormalizeMap func(raw map[string]interface{}, recipientEmail string) NormEmail
 	// NormalizeRawMessages 将 JSON 消息列表转为 NormEmail（由 tempemail.init 注入）
 	NormalizeRawMessages func([]json.RawMessage, string) ([]NormEmail, error)
 )
 
-func getCurrentUA() string {
+func getCurrentUA(ctx context.Context) string {
 	if GetCurrentUA != nil {
-		return GetCurrentUA()
+		return GetCurrentUA(ctx)
 	}
 	return ""
 }
 
+/* httpClientHooks 返回 ctx 所属实例的请求钩子，未注入时为 nil */
+func httpClientHooks(ctx context.Context) []tls_client.HttpClientOption {
+	if HTTPClientHooks != nil {
+		return HTTPClientHooks(ctx)
+	}
+	return nil
+}
+
 func normEmailsFromMaps(maps []map[string]interface{}, recipient string) []NormEmail {
 	out := make([]NormEmail, 0, len(maps))
 	for _, m := range maps {
@@ -87,3 +111,40 @@ func normEmailsFromMaps(maps []map[string]interface{}, recipient string) []NormE
 	}
 	return out
 }
+
+/* doGet 以 GET 方式发起请求，替代 client.Get 以便请求携带调用方的 ctx */
+func doGet(ctx context.Context, client tls_client.HttpClient, u string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req)
+}
+
+/* sleepCtx 可被 ctx 打断的等待，ctx 取消时立即返回 ctx.Err() */
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+/* localPartCtxKey ctx 中携带调用方指定邮箱用户名的键 */
+type localPartCtxKey struct{}
+
+// WithLocalPart 在 ctx 中携带调用方指定的邮箱用户名（@ 前的部分），支持自定义用户名的渠道据此生成地址
+func WithLocalPart(ctx context.Context, local string) context.Context {
+	return context.WithValue(ctx, localPartCtxKey{}, local)
+}
+
+// localPartOr 返回 ctx 指定的邮箱用户名，未指定时返回 fallback（渠道原有的随机用户名）
+func localPartOr(ctx context.Context, fallback string) string {
+	if local, ok := ctx.Value(localPartCtxKey{}).(string); ok && local != "" {
+		return local
+	}
+	return fallback
+}
@@ -1,8 +1,12 @@
 package provider
 
-func Bsdu32BuzzGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func Bsdu32BuzzGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@bsdu32.buzz"
 	return &CreatedMailbox{Channel: "bsdu32-buzz", Email: email, Token: email}, nil
 }
-func Bsdu32BuzzGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func Bsdu32BuzzGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
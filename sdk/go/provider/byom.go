@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -36,15 +37,15 @@ func byomDefaultHeaders(req *http.Request) {
 	req.Header.Set("Cache-Control", "no-cache")
 	req.Header.Set("DNT", "1")
 	req.Header.Set("Pragma", "no-cache")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 }
 
 /*
  * ByomGenerate 创建 byom.de 临时邮箱
  * 无需 API 调用，直接生成随机用户名 + "@byom.de"
  */
-func ByomGenerate(channel ...string) (*CreatedMailbox, error) {
-	user := byomRandomLocal(10)
+func ByomGenerate(ctx context.Context, channel ...string) (*CreatedMailbox, error) {
+	user := localPartOr(ctx, byomRandomLocal(10))
 	email := user + "@" + byomDomain
 	ch := "byom"
 	if len(channel) > 0 && channel[0] != "" {
@@ -57,7 +58,7 @@ func ByomGenerate(channel ...string) (*CreatedMailbox, error) {
  * ByomGetEmails 获取 byom.de 邮件列表
  * GET https://api.byom.de/mails/{username}
  */
-func ByomGetEmails(email string) ([]NormEmail, error) {
+func ByomGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("byom: empty email")
@@ -67,13 +68,13 @@ func ByomGetEmails(email string) ([]NormEmail, error) {
 	username := parts[0]
 
 	u := fmt.Sprintf("%s/mails/%s", byomAPIBase, username)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	byomDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +85,7 @@ func ByomGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("byom mails: %d", resp.StatusCode)
+		return nil, statusError(resp, "byom mails: %d", resp.StatusCode)
 	}
 
 	var rawMails []map[string]interface{}
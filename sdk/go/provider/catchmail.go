@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -73,38 +74,38 @@ func catchmailCleanAddress(v any) string {
 	}
 }
 
-func CatchmailGenerate(domain *string, channel ...string) (*CreatedMailbox, error) {
+func CatchmailGenerate(ctx context.Context, domain *string, channel ...string) (*CreatedMailbox, error) {
 	ch := "catchmail"
 	if len(channel) > 0 && channel[0] != "" {
 		ch = channel[0]
 	}
 	email := fmt.Sprintf("%s@%s", catchmailRandomLocal(), catchmailPickDomain(domain))
 	u := fmt.Sprintf("%s/mailbox?address=%s", catchmailBase, url.QueryEscape(email))
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	catchmailHeaders(req)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 	io.ReadAll(resp.Body)
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("catchmail create mailbox: %d", resp.StatusCode)
+		return nil, statusError(resp, "catchmail create mailbox: %d", resp.StatusCode)
 	}
 	return &CreatedMailbox{Channel: ch, Email: email}, nil
 }
 
-func catchmailFetchMessage(id, email string) (map[string]any, error) {
+func catchmailFetchMessage(ctx context.Context, id, email string) (map[string]any, error) {
 	u := fmt.Sprintf("%s/message/%s?mailbox=%s", catchmailBase, url.PathEscape(id), url.QueryEscape(email))
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	catchmailHeaders(req)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +115,7 @@ func catchmailFetchMessage(id, email string) (map[string]any, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("catchmail message: %d", resp.StatusCode)
+		return nil, statusError(resp, "catchmail message: %d", resp.StatusCode)
 	}
 	var raw map[string]any
 	if err := json.Unmarshal(body, &raw); err != nil {
@@ -145,18 +146,18 @@ func catchmailFlattenDetail(raw map[string]any, recipient string) map[string]any
 	return flat
 }
 
-func CatchmailGetEmails(email string) ([]NormEmail, error) {
+func CatchmailGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("catchmail: empty email")
 	}
 	u := fmt.Sprintf("%s/mailbox?address=%s", catchmailBase, url.QueryEscape(email))
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	catchmailHeaders(req)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +167,7 @@ func CatchmailGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("catchmail mailbox: %d", resp.StatusCode)
+		return nil, statusError(resp, "catchmail mailbox: %d", resp.StatusCode)
 	}
 	var data struct {
 		Messages []map[string]any `json:"messages"`
@@ -180,7 +181,7 @@ func CatchmailGetEmails(email string) ([]NormEmail, error) {
 		if id == "" {
 			continue
 		}
-		if detail, err := catchmailFetchMessage(id, email); err == nil {
+		if detail, err := catchmailFetchMessage(ctx, id, email); err == nil {
 			out = append(out, NormalizeMap(catchmailFlattenDetail(detail, email), email))
 			continue
 		}
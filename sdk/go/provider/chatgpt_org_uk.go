@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
@@ -34,7 +35,7 @@ func setChatgptOrgUkHeaders(req *http.Request) {
 	for k, v := range chatgptOrgUkHeaders {
 		req.Header.Set(k, v)
 	}
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(req.Context()))
 }
 
 // chatgptOrgUkDomainsResponse 对应 GET /api/domains/public 响应
@@ -99,8 +100,8 @@ func chatgptOrgUkExtractGmSid(resp *http.Response) string {
 }
 
 // chatgptOrgUkFetchDomains 获取可用域名列表（过滤 is_active=1）
-func chatgptOrgUkFetchDomains(client tls_client.HttpClient) ([]string, error) {
-	req, err := http.NewRequest("GET", chatgptOrgUkBaseURL+"/domains/public", nil)
+func chatgptOrgUkFetchDomains(ctx context.Context, client tls_client.HttpClient) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", chatgptOrgUkBaseURL+"/domains/public", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -142,13 +143,13 @@ func chatgptOrgUkFetchDomains(client tls_client.HttpClient) ([]string, error) {
 }
 
 // chatgptOrgUkCreateInbox 调用 inbox-token 创建收件箱，返回 inbox JWT 与 gm_sid
-func chatgptOrgUkCreateInbox(client tls_client.HttpClient, email string) (inbox string, gmSid string, err error) {
+func chatgptOrgUkCreateInbox(ctx context.Context, client tls_client.HttpClient, email string) (inbox string, gmSid string, err error) {
 	payload, err := json.Marshal(map[string]string{"email": email})
 	if err != nil {
 		return "", "", err
 	}
 
-	req, err := http.NewRequest("POST", chatgptOrgUkBaseURL+"/inbox-token", bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, "POST", chatgptOrgUkBaseURL+"/inbox-token", bytes.NewReader(payload))
 	if err != nil {
 		return "", "", err
 	}
@@ -194,10 +195,10 @@ func chatgptOrgUkParsePackedToken(packed string) (gmSid string, inbox string) {
 	return "", packed
 }
 
-func ChatgptOrgUkGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func ChatgptOrgUkGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
-	domains, err := chatgptOrgUkFetchDomains(client)
+	domains, err := chatgptOrgUkFetchDomains(ctx, client)
 	if err != nil {
 		return nil, err
 	}
@@ -214,7 +215,7 @@ func ChatgptOrgUkGenerate() (*CreatedMailbox, error) {
 	}
 	email := username + "@" + domain
 
-	inbox, gmSid, err := chatgptOrgUkCreateInbox(client, email)
+	inbox, gmSid, err := chatgptOrgUkCreateInbox(ctx, client, email)
 	if err != nil {
 		return nil, err
 	}
@@ -227,9 +228,9 @@ func ChatgptOrgUkGenerate() (*CreatedMailbox, error) {
 	return &CreatedMailbox{Channel: "chatgpt-org-uk", Email: email, Token: string(packed)}, nil
 }
 
-func ChatgptOrgUkGetEmails(email string, token string) ([]NormEmail, error) {
+func ChatgptOrgUkGetEmails(ctx context.Context, email string, token string) ([]NormEmail, error) {
 	if token == "" {
-		return nil, fmt.Errorf("missing inbox token")
+		return nil, markError(ErrInvalidToken, "missing inbox token")
 	}
 	encodedEmail := url.QueryEscape(email)
 
@@ -237,19 +238,19 @@ func ChatgptOrgUkGetEmails(email string, token string) ([]NormEmail, error) {
 	if inbox == "" {
 		return nil, fmt.Errorf("chatgpt-org-uk: inbox token 缺失")
 	}
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	// gm_sid 丢失时重新创建 session
 	if gmSid == "" {
 		var err error
-		inbox, gmSid, err = chatgptOrgUkCreateInbox(client, email)
+		inbox, gmSid, err = chatgptOrgUkCreateInbox(ctx, client, email)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	fetchEmails := func(inboxToken string, sid string) ([]NormEmail, error) {
-		req, err := http.NewRequest("GET", chatgptOrgUkBaseURL+"/emails?email="+encodedEmail, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", chatgptOrgUkBaseURL+"/emails?email="+encodedEmail, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -289,7 +290,7 @@ func ChatgptOrgUkGetEmails(email string, token string) ([]NormEmail, error) {
 	}
 	// 401/403 时重新创建 session 后重试一次
 	if strings.Contains(err.Error(), ": 401") || strings.Contains(err.Error(), ": 403") {
-		refreshed, sid, refreshErr := chatgptOrgUkCreateInbox(client, email)
+		refreshed, sid, refreshErr := chatgptOrgUkCreateInbox(ctx, client, email)
 		if refreshErr != nil {
 			return nil, err
 		}
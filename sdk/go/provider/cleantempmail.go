@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -42,13 +43,13 @@ func cleanTempMailHeaders(req *http.Request) {
 	req.Header.Set("X-API-Key", cleanTempMailAPIKey())
 }
 
-func cleanTempMailGetJSON(u string) ([]byte, error) {
-	req, err := http.NewRequest("GET", u, nil)
+func cleanTempMailGetJSON(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	cleanTempMailHeaders(req)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -58,13 +59,13 @@ func cleanTempMailGetJSON(u string) ([]byte, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("cleantempmail: http %d", resp.StatusCode)
+		return nil, statusError(resp, "cleantempmail: http %d", resp.StatusCode)
 	}
 	return body, nil
 }
 
-func CleanTempMailGenerate() (*CreatedMailbox, error) {
-	body, err := cleanTempMailGetJSON(cleanTempMailBase + "/generate-email")
+func CleanTempMailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	body, err := cleanTempMailGetJSON(ctx, cleanTempMailBase+"/generate-email")
 	if err != nil {
 		return nil, err
 	}
@@ -83,14 +84,14 @@ func CleanTempMailGenerate() (*CreatedMailbox, error) {
 	return &CreatedMailbox{Channel: "cleantempmail", Email: email}, nil
 }
 
-func CleanTempMailGetEmails(email string) ([]NormEmail, error) {
+func CleanTempMailGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("cleantempmail: empty email")
 	}
 
 	u := fmt.Sprintf("%s/emails?email=%s", cleanTempMailBase, url.QueryEscape(address))
-	body, err := cleanTempMailGetJSON(u)
+	body, err := cleanTempMailGetJSON(ctx, u)
 	if err != nil {
 		return nil, err
 	}
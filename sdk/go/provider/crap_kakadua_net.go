@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // crap.kakadua.net：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // CrapKakaduaNetGenerate 创建 crap.kakadua.net 临时邮箱
-func CrapKakaduaNetGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func CrapKakaduaNetGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "crap-kakadua-net",
 		Email:   local + "@crap.kakadua.net",
@@ -12,6 +14,6 @@ func CrapKakaduaNetGenerate() (*CreatedMailbox, error) {
 }
 
 // CrapKakaduaNetGetEmails 读取邮件（复用 mailinator public API）
-func CrapKakaduaNetGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func CrapKakaduaNetGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,6 +1,10 @@
 package provider
 
-func DeaSoonItGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "dea-soon-it", Email: randomStr(12) + "@dea.soon.it"}, nil
+import "context"
+
+func DeaSoonItGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "dea-soon-it", Email: localPartOr(ctx, randomStr(12)) + "@dea.soon.it"}, nil
+}
+func DeaSoonItGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func DeaSoonItGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
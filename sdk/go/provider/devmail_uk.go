@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,13 +25,13 @@ type devmailUKInboxResponse struct {
 	Emails  []map[string]any `json:"emails"`
 }
 
-func devmailUKGetJSON(u string) ([]byte, error) {
-	req, err := http.NewRequest("GET", u, nil)
+func devmailUKGetJSON(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +41,7 @@ func devmailUKGetJSON(u string) ([]byte, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("devmail-uk: http %d", resp.StatusCode)
+		return nil, statusError(resp, "devmail-uk: http %d", resp.StatusCode)
 	}
 	return body, nil
 }
@@ -56,8 +57,8 @@ func devmailUKMailbox(email string) string {
 	return email
 }
 
-func DevmailUkGenerate() (*CreatedMailbox, error) {
-	body, err := devmailUKGetJSON(devmailUKBase + "/new")
+func DevmailUkGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	body, err := devmailUKGetJSON(ctx, devmailUKBase+"/new")
 	if err != nil {
 		return nil, err
 	}
@@ -76,14 +77,14 @@ func DevmailUkGenerate() (*CreatedMailbox, error) {
 	return &CreatedMailbox{Channel: "devmail-uk", Email: email}, nil
 }
 
-func DevmailUkGetEmails(email string) ([]NormEmail, error) {
+func DevmailUkGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	mailbox := devmailUKMailbox(email)
 	if mailbox == "" {
 		return nil, fmt.Errorf("devmail-uk: empty email")
 	}
 
 	u := fmt.Sprintf("%s/inbox/%s?detail=true", devmailUKBase, url.QueryEscape(mailbox))
-	body, err := devmailUKGetJSON(u)
+	body, err := devmailUKGetJSON(ctx, u)
 	if err != nil {
 		return nil, err
 	}
@@ -1,8 +1,10 @@
 package provider
 
-func DisposableAlSudaniComGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "disposable-al-sudani-com", Email: randomStr(12) + "@disposable.al-sudani.com"}, nil
+import "context"
+
+func DisposableAlSudaniComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "disposable-al-sudani-com", Email: localPartOr(ctx, randomStr(12)) + "@disposable.al-sudani.com"}, nil
 }
-func DisposableAlSudaniComGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func DisposableAlSudaniComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,8 +1,10 @@
 package provider
 
-func DisposableNogonadNlGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "disposable-nogonad-nl", Email: randomStr(12) + "@disposable.nogonad.nl"}, nil
+import "context"
+
+func DisposableNogonadNlGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "disposable-nogonad-nl", Email: localPartOr(ctx, randomStr(12)) + "@disposable.nogonad.nl"}, nil
 }
-func DisposableNogonadNlGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func DisposableNogonadNlGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,14 +25,14 @@ var disposablemailCSRFRe = regexp.MustCompile(`CSRF="([^"]+)"`)
 
 /* disposablemailBrowserHeaders 设置浏览器模拟请求头 */
 func disposablemailBrowserHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 }
 
 /* disposablemailAjaxHeaders 设置 AJAX 请求头 */
 func disposablemailAjaxHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "application/json, text/javascript, */*; q=0.01")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
@@ -44,10 +45,10 @@ func disposablemailAjaxHeaders(req *http.Request) {
  *   2. GET /index/index?csrf_token={csrf} 创建邮箱，返回 {"email":"user@domain.com"}
  * token: CSRF token 值
  */
-func DisposablemailGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func DisposablemailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", disposablemailBaseURL+"/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", disposablemailBaseURL+"/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("disposablemail: 创建首页请求失败: %w", err)
 	}
@@ -75,7 +76,7 @@ func DisposablemailGenerate() (*CreatedMailbox, error) {
 	csrfToken := m[1]
 
 	createURL := fmt.Sprintf("%s/index/index?csrf_token=%s", disposablemailBaseURL, csrfToken)
-	req2, err := http.NewRequest("GET", createURL, nil)
+	req2, err := http.NewRequestWithContext(ctx, "GET", createURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("disposablemail: 创建邮箱请求失败: %w", err)
 	}
@@ -121,15 +122,15 @@ func DisposablemailGenerate() (*CreatedMailbox, error) {
  *   2. 对每封邮件 POST /index/email（body: id={id}）获取 HTML 正文
  * 字段说明（捷克语）: predmet=subject, od=from, id=邮件ID, kdy=when, precteno=read status
  */
-func DisposablemailGetEmails(email, token string) ([]NormEmail, error) {
+func DisposablemailGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("disposablemail: 邮箱地址为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", disposablemailBaseURL+"/index/refresh", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", disposablemailBaseURL+"/index/refresh", nil)
 	if err != nil {
 		return nil, fmt.Errorf("disposablemail: 创建刷新请求失败: %w", err)
 	}
@@ -174,7 +175,7 @@ func DisposablemailGetEmails(email, token string) ([]NormEmail, error) {
 	for _, item := range mailList {
 		mailID := item.ID.String()
 
-		htmlBody := disposablemailFetchMailBody(client, mailID)
+		htmlBody := disposablemailFetchMailBody(ctx, client, mailID)
 
 		isRead := item.Precteno == "precteno"
 
@@ -194,7 +195,7 @@ func DisposablemailGetEmails(email, token string) ([]NormEmail, error) {
 }
 
 /* disposablemailFetchMailBody 获取单封邮件的 HTML 正文 */
-func disposablemailFetchMailBody(client interface {
+func disposablemailFetchMailBody(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }, mailID string) string {
 	if mailID == "" {
@@ -202,7 +203,7 @@ func disposablemailFetchMailBody(client interface {
 	}
 
 	reqURL := fmt.Sprintf("%s/email/id/%s", disposablemailBaseURL, mailID)
-	req, err := http.NewRequest("GET", reqURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
 	if err != nil {
 		return ""
 	}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -26,7 +27,7 @@ func disposablemailAppHeaders(req *http.Request) {
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("Referer", "https://disposablemail.app/")
 	req.Header.Set("Origin", "https://disposablemail.app")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 }
 
 /*
@@ -36,14 +37,14 @@ func disposablemailAppHeaders(req *http.Request) {
  *   2. 解析响应中的 address、token
  *   3. token 直接存储 API 返回的 token 字符串
  */
-func DisposablemailAppGenerate(channel ...string) (*CreatedMailbox, error) {
-	req, err := http.NewRequest("POST", disposablemailAppAPIBase+"/inbox", strings.NewReader("{}"))
+func DisposablemailAppGenerate(ctx context.Context, channel ...string) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", disposablemailAppAPIBase+"/inbox", strings.NewReader("{}"))
 	if err != nil {
 		return nil, fmt.Errorf("disposablemail-app: 创建请求失败: %w", err)
 	}
 	disposablemailAppHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("disposablemail-app: 请求创建收件箱失败: %w", err)
 	}
@@ -98,7 +99,7 @@ func DisposablemailAppGenerate(channel ...string) (*CreatedMailbox, error) {
  *   1. GET /api/inbox/emails?token={token}
  *   2. 解析响应中的 emails 数组并归一化
  */
-func DisposablemailAppGetEmails(email, token string) ([]NormEmail, error) {
+func DisposablemailAppGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("disposablemail-app: 邮箱地址为空")
@@ -108,7 +109,7 @@ func DisposablemailAppGetEmails(email, token string) ([]NormEmail, error) {
 	}
 
 	u := fmt.Sprintf("%s/inbox/emails?token=%s", disposablemailAppAPIBase, token)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, fmt.Errorf("disposablemail-app: 创建请求失败: %w", err)
 	}
@@ -116,7 +117,7 @@ func DisposablemailAppGetEmails(email, token string) ([]NormEmail, error) {
 	/* GET 请求不需要 Content-Type */
 	req.Header.Del("Content-Type")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("disposablemail-app: 请求获取邮件失败: %w", err)
 	}
@@ -1,8 +1,12 @@
 package provider
 
-func Doxu243BuzzGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func Doxu243BuzzGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@doxu243.buzz"
 	return &CreatedMailbox{Channel: "doxu243-buzz", Email: email, Token: email}, nil
 }
-func Doxu243BuzzGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func Doxu243BuzzGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -32,6 +33,14 @@ const dropmailGetMailsQuery = `query ($id: ID!) {
   }
 }`
 
+const dropmailSessionAddressesQuery = `query ($id: ID!) {session(id:$id) {addresses {id, address}}}`
+
+const dropmailMailSourcesQuery = `query ($id: ID!) {session(id:$id) {mails {id, downloadUrl}}}`
+
+const dropmailSessionExpiryQuery = `query ($id: ID!) {session(id:$id) {expiresAt}}`
+
+const dropmailDeleteAddressQuery = `mutation ($id: ID!) {deleteAddress(input: {addressId: $id})}`
+
 type dropmailGraphQLResponse struct {
 	Data   json.RawMessage `json:"data"`
 	Errors []struct {
@@ -53,7 +62,8 @@ type dropmailIntroduceSessionResponse struct {
 }
 
 type dropmailSessionQueryResponse struct {
-	Session struct {
+	/* 会话过期或不存在时为 null */
+	Session *struct {
 		Mails []map[string]interface{} `json:"mails"`
 	} `json:"session"`
 }
@@ -77,8 +87,8 @@ var (
 	}
 )
 
-func explicitDropmailAuthToken() string {
-	cfg := GetConfigSnapshot()
+func explicitDropmailAuthToken(ctx context.Context) string {
+	cfg := GetConfigSnapshot(ctx)
 	if s := strings.TrimSpace(cfg.DropmailAuthToken); s != "" {
 		return s
 	}
@@ -91,16 +101,16 @@ func explicitDropmailAuthToken() string {
 	return ""
 }
 
-func dropmailAutoTokenDisabled() bool {
-	if GetConfigSnapshot().DropmailDisableAutoToken {
+func dropmailAutoTokenDisabled(ctx context.Context) bool {
+	if GetConfigSnapshot(ctx).DropmailDisableAutoToken {
 		return true
 	}
 	v := strings.ToLower(strings.TrimSpace(os.Getenv("DROPMAIL_NO_AUTO_TOKEN")))
 	return v == "1" || v == "true" || v == "yes"
 }
 
-func dropmailRenewLifetimeStr() string {
-	if s := strings.TrimSpace(GetConfigSnapshot().DropmailRenewLifetime); s != "" {
+func dropmailRenewLifetimeStr(ctx context.Context) string {
+	if s := strings.TrimSpace(GetConfigSnapshot(ctx).DropmailRenewLifetime); s != "" {
 		return s
 	}
 	if s := strings.TrimSpace(os.Getenv("DROPMAIL_RENEW_LIFETIME")); s != "" {
@@ -125,21 +135,21 @@ func dropmailCacheDurationForLifetime(lifetime string) time.Duration {
 	return dropmailAutoTokenCache
 }
 
-func dropmailPostJSON(u string, payload any) ([]byte, error) {
+func dropmailPostJSON(ctx context.Context, u string, payload any) ([]byte, error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 	for k, v := range dropmailTokenJSON {
 		req.Header.Set(k, v)
 	}
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -149,13 +159,13 @@ func dropmailPostJSON(u string, payload any) ([]byte, error) {
 		return nil, err
 	}
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("DropMail token HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "DropMail token HTTP %d", resp.StatusCode)
 	}
 	return raw, nil
 }
 
-func dropmailFetchAfToken() (string, error) {
-	raw, err := dropmailPostJSON(dropmailTokenGenerateURL, map[string]string{"type": "af", "lifetime": "1h"})
+func dropmailFetchAfToken(ctx context.Context) (string, error) {
+	raw, err := dropmailPostJSON(ctx, dropmailTokenGenerateURL, map[string]string{"type": "af", "lifetime": "1h"})
 	if err != nil {
 		return "", err
 	}
@@ -173,8 +183,8 @@ func dropmailFetchAfToken() (string, error) {
 	return tok, nil
 }
 
-func dropmailRenewAfToken(current, lifetime string) (string, error) {
-	raw, err := dropmailPostJSON(dropmailTokenRenewURL, map[string]string{"token": current, "lifetime": lifetime})
+func dropmailRenewAfToken(ctx context.Context, current, lifetime string) (string, error) {
+	raw, err := dropmailPostJSON(ctx, dropmailTokenRenewURL, map[string]string{"token": current, "lifetime": lifetime})
 	if err != nil {
 		return "", err
 	}
@@ -192,11 +202,11 @@ func dropmailRenewAfToken(current, lifetime string) (string, error) {
 	return tok, nil
 }
 
-func resolveDropmailAuthToken() (string, error) {
-	if t := explicitDropmailAuthToken(); t != "" {
+func resolveDropmailAuthToken(ctx context.Context) (string, error) {
+	if t := explicitDropmailAuthToken(ctx); t != "" {
 		return t, nil
 	}
-	if dropmailAutoTokenDisabled() {
+	if dropmailAutoTokenDisabled(ctx) {
 		return "", fmt.Errorf("DropMail 已禁用自动令牌：请设置 DROPMAIL_AUTH_TOKEN 或 SetConfig(SDKConfig{DropmailAuthToken: \"af_...\"})，见 https://dropmail.me/api/")
 	}
 
@@ -208,9 +218,9 @@ func resolveDropmailAuthToken() (string, error) {
 		return dropmailAfCached.value, nil
 	}
 
-	renewLife := dropmailRenewLifetimeStr()
+	renewLife := dropmailRenewLifetimeStr(ctx)
 	if dropmailAfCached != nil && dropmailAfCached.value != "" {
-		if renewed, err := dropmailRenewAfToken(dropmailAfCached.value, renewLife); err == nil {
+		if renewed, err := dropmailRenewAfToken(ctx, dropmailAfCached.value, renewLife); err == nil {
 			dropmailAfCached = &struct {
 				value     string
 				expiresAt time.Time
@@ -220,7 +230,7 @@ func resolveDropmailAuthToken() (string, error) {
 		dropmailAfCached = nil
 	}
 
-	tok, err := dropmailFetchAfToken()
+	tok, err := dropmailFetchAfToken(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -232,8 +242,8 @@ func resolveDropmailAuthToken() (string, error) {
 }
 
 // dropmailGraphQLRequest 执行 GraphQL 请求
-func dropmailGraphQLRequest(query string, variables map[string]interface{}) (json.RawMessage, error) {
-	af, err := resolveDropmailAuthToken()
+func dropmailGraphQLRequest(ctx context.Context, query string, variables map[string]interface{}) (json.RawMessage, error) {
+	af, err := resolveDropmailAuthToken(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -246,14 +256,14 @@ func dropmailGraphQLRequest(query string, variables map[string]interface{}) (jso
 		form.Set("variables", string(varsJSON))
 	}
 
-	req, err := http.NewRequest(http.MethodPost, base, strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, base, strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -266,7 +276,7 @@ func dropmailGraphQLRequest(query string, variables map[string]interface{}) (jso
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("GraphQL request failed: %d", resp.StatusCode)
+		return nil, statusError(resp, "GraphQL request failed: %d", resp.StatusCode)
 	}
 
 	var result dropmailGraphQLResponse
@@ -283,8 +293,8 @@ func dropmailGraphQLRequest(query string, variables map[string]interface{}) (jso
 
 // DropmailGenerate 创建临时邮箱
 // GraphQL mutation: introduceSession
-func DropmailGenerate() (*CreatedMailbox, error) {
-	data, err := dropmailGraphQLRequest(dropmailCreateSessionQuery, nil)
+func DropmailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	data, err := dropmailGraphQLRequest(ctx, dropmailCreateSessionQuery, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -309,6 +319,7 @@ func dropmailFlattenMessage(mail map[string]interface{}, recipientEmail string)
 	flat := map[string]interface{}{
 		"id":          mail["id"],
 		"from":        mail["fromAddr"],
+		"header_from": mail["headerFrom"],
 		"to":          mail["toAddr"],
 		"subject":     mail["headerSubject"],
 		"text":        mail["text"],
@@ -326,8 +337,8 @@ func dropmailFlattenMessage(mail map[string]interface{}, recipientEmail string)
 
 // DropmailGetEmails 获取邮件列表
 // GraphQL query: session(id) { mails {...} }
-func DropmailGetEmails(token string, email string) ([]NormEmail, error) {
-	data, err := dropmailGraphQLRequest(dropmailGetMailsQuery, map[string]interface{}{
+func DropmailGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
+	data, err := dropmailGraphQLRequest(ctx, dropmailGetMailsQuery, map[string]interface{}{
 		"id": token,
 	})
 	if err != nil {
@@ -338,6 +349,9 @@ func DropmailGetEmails(token string, email string) ([]NormEmail, error) {
 	if err := json.Unmarshal(data, &resp); err != nil {
 		return nil, err
 	}
+	if resp.Session == nil {
+		return nil, markError(ErrMailboxExpired, "dropmail: session expired")
+	}
 
 	mails := resp.Session.Mails
 	if len(mails) == 0 {
@@ -351,3 +365,159 @@ func DropmailGetEmails(token string, email string) ([]NormEmail, error) {
 	}
 	return emails, nil
 }
+
+// DropmailGetEmailsSince 增量获取邮件：按 receivedAt 只返回 cursor 之后的邮件
+// cursor 形如 "<receivedAt>|<id>,<id>"：最新的 receivedAt（RFC3339）及该时刻已返回的邮件 ID，
+// 同一时刻稍后到达的邮件不会因时间相同而被跳过；空串表示从头开始
+func DropmailGetEmailsSince(ctx context.Context, token, email, cursor string) ([]NormEmail, string, error) {
+	var since time.Time
+	var seenAtSince map[string]bool
+	if cursor != "" {
+		at, ids, hasIDs := strings.Cut(cursor, "|")
+		t, err := time.Parse(time.RFC3339Nano, at)
+		if err != nil {
+			return nil, "", fmt.Errorf("dropmail: invalid cursor %q", cursor)
+		}
+		since = t
+		if hasIDs {
+			seenAtSince = make(map[string]bool)
+			for _, id := range strings.Split(ids, ",") {
+				seenAtSince[id] = true
+			}
+		}
+	}
+
+	data, err := dropmailGraphQLRequest(ctx, dropmailGetMailsQuery, map[string]interface{}{
+		"id": token,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	var resp dropmailSessionQueryResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, "", err
+	}
+	if resp.Session == nil {
+		return nil, "", markError(ErrMailboxExpired, "dropmail: session expired")
+	}
+
+	latest := since
+	var latestIDs []string
+	emails := make([]NormEmail, 0)
+	for _, mail := range resp.Session.Mails {
+		receivedAt, _ := mail["receivedAt"].(string)
+		id, _ := mail["id"].(string)
+		t, err := time.Parse(time.RFC3339Nano, receivedAt)
+		if err != nil || t.Before(since) {
+			continue
+		}
+		switch {
+		case t.After(latest):
+			latest, latestIDs = t, []string{id}
+		case t.Equal(latest):
+			latestIDs = append(latestIDs, id)
+		}
+		/* 旧格式 cursor（无 ID 列表）沿用严格晚于的判断 */
+		if t.Equal(since) && (seenAtSince == nil || seenAtSince[id]) {
+			continue
+		}
+		emails = append(emails, NormalizeMap(dropmailFlattenMessage(mail, email), email))
+	}
+	next := cursor
+	if !latest.IsZero() {
+		next = latest.UTC().Format(time.RFC3339Nano) + "|" + strings.Join(latestIDs, ",")
+	}
+	return emails, next, nil
+}
+
+// DropmailGetRawEmail 获取邮件原始 MIME 源码：查询邮件的 downloadUrl 后下载
+func DropmailGetRawEmail(ctx context.Context, token, id string) ([]byte, error) {
+	data, err := dropmailGraphQLRequest(ctx, dropmailMailSourcesQuery, map[string]interface{}{
+		"id": token,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Session *struct {
+			Mails []struct {
+				ID          string `json:"id"`
+				DownloadURL string `json:"downloadUrl"`
+			} `json:"mails"`
+		} `json:"session"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Session == nil {
+		return nil, markError(ErrMailboxExpired, "dropmail: session expired")
+	}
+	for _, m := range resp.Session.Mails {
+		if m.ID != id || m.DownloadURL == "" {
+			continue
+		}
+		rc, err := DownloadAttachmentURL(ctx, m.DownloadURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+	return nil, markError(ErrEmailNotFound, "dropmail: email %s not found", id)
+}
+
+// DropmailDeleteAddress 删除会话中的邮箱地址，不再接收该地址的邮件（会话随后自然过期）
+// GraphQL: session(id) { addresses } → mutation deleteAddress
+func DropmailDeleteAddress(ctx context.Context, token string, email string) error {
+	data, err := dropmailGraphQLRequest(ctx, dropmailSessionAddressesQuery, map[string]interface{}{
+		"id": token,
+	})
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Session *dropmailSession `json:"session"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+	if resp.Session == nil {
+		return markError(ErrMailboxExpired, "dropmail: session expired")
+	}
+	for _, addr := range resp.Session.Addresses {
+		if strings.EqualFold(addr.Address, email) {
+			_, err := dropmailGraphQLRequest(ctx, dropmailDeleteAddressQuery, map[string]interface{}{
+				"id": addr.ID,
+			})
+			return err
+		}
+	}
+	return markError(ErrMailboxExpired, "dropmail: address %s not found in session", email)
+}
+
+// DropmailExtendSession 续期会话：dropmail 会话在每次访问时自动顺延，查询后返回新的过期时间
+// GraphQL query: session(id) { expiresAt }
+func DropmailExtendSession(ctx context.Context, token string) (time.Time, error) {
+	data, err := dropmailGraphQLRequest(ctx, dropmailSessionExpiryQuery, map[string]interface{}{
+		"id": token,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var resp struct {
+		Session *dropmailSession `json:"session"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return time.Time{}, err
+	}
+	if resp.Session == nil {
+		return time.Time{}, markError(ErrMailboxExpired, "dropmail: session expired")
+	}
+	t, err := time.Parse(time.RFC3339, resp.Session.ExpiresAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("dropmail: invalid expiresAt %q", resp.Session.ExpiresAt)
+	}
+	return t, nil
+}
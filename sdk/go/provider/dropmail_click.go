@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -16,13 +17,13 @@ const dropmailClickBaseURL = "https://dropmail.click"
 
 // DropmailClickGenerate 创建 dropmail.click 临时邮箱
 // POST /api/v1/public/mailbox → {address, created_at, expires_at}
-func DropmailClickGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
-	req, err := http.NewRequest("POST", dropmailClickBaseURL+"/api/v1/public/mailbox", nil)
+func DropmailClickGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
+	req, err := http.NewRequestWithContext(ctx, "POST", dropmailClickBaseURL+"/api/v1/public/mailbox", nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := client.Do(req)
@@ -57,17 +58,17 @@ func DropmailClickGenerate() (*CreatedMailbox, error) {
 
 // DropmailClickGetEmails 获取 dropmail.click 邮件列表
 // GET /api/v1/public/mailbox/{email} → {messages:[{id, address, from, subject, text, html, ...}]}
-func DropmailClickGetEmails(email string) ([]NormEmail, error) {
+func DropmailClickGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	if email == "" {
 		return nil, fmt.Errorf("dropmail-click: 缺少邮箱地址")
 	}
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	reqURL := fmt.Sprintf("%s/api/v1/public/mailbox/%s", dropmailClickBaseURL, url.PathEscape(email))
-	req, err := http.NewRequest("GET", reqURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := client.Do(req)
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
@@ -60,14 +61,14 @@ func dropmailMeExtractSecret(dataK string) (string, error) {
 }
 
 /* dropmailMeGenerateToken 从页面提取 data-k 并生成 auth token */
-func dropmailMeGenerateToken() (string, error) {
-	client := HTTPClient()
+func dropmailMeGenerateToken(ctx context.Context) (string, error) {
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", dropmailMeBaseURL+"/en/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", dropmailMeBaseURL+"/en/", nil)
 	if err != nil {
 		return "", fmt.Errorf("dropmail-me: 创建页面请求失败: %w", err)
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 
 	resp, err := client.Do(req)
@@ -101,18 +102,18 @@ func dropmailMeGenerateToken() (string, error) {
 }
 
 /* dropmailMeGraphQL 执行 GraphQL 请求 */
-func dropmailMeGraphQL(authToken, query string) ([]byte, error) {
-	client := HTTPClient()
+func dropmailMeGraphQL(ctx context.Context, authToken, query string) ([]byte, error) {
+	client := HTTPClient(ctx)
 
 	payload, _ := json.Marshal(map[string]string{"query": query})
 	apiURL := fmt.Sprintf("%s/api/graphql/%s", dropmailMeBaseURL, authToken)
 
-	req, err := http.NewRequest("POST", apiURL, bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, "POST", apiURL, bytes.NewReader(payload))
 	if err != nil {
 		return nil, fmt.Errorf("dropmail-me: 创建 GraphQL 请求失败: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -136,14 +137,14 @@ func dropmailMeGraphQL(authToken, query string) ([]byte, error) {
  * 2. GraphQL introduceSession 创建会话
  * duration 和 domain 参数保留以匹配接口签名（当前未使用）
  */
-func DropmailMeGenerate(duration int, domain string) (*CreatedMailbox, error) {
-	authToken, err := dropmailMeGenerateToken()
+func DropmailMeGenerate(ctx context.Context, duration int, domain string) (*CreatedMailbox, error) {
+	authToken, err := dropmailMeGenerateToken(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	query := `mutation { introduceSession { id expiresAt addresses { address } } }`
-	body, err := dropmailMeGraphQL(authToken, query)
+	body, err := dropmailMeGraphQL(ctx, authToken, query)
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +190,7 @@ func DropmailMeGenerate(duration int, domain string) (*CreatedMailbox, error) {
 }
 
 /* DropmailMeGetEmails 获取 dropmail.me 邮件列表 */
-func DropmailMeGetEmails(token, email string) ([]NormEmail, error) {
+func DropmailMeGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	token = strings.TrimSpace(token)
 	if token == "" {
 		return nil, fmt.Errorf("dropmail-me: token 为空")
@@ -208,7 +209,7 @@ func DropmailMeGetEmails(token, email string) ([]NormEmail, error) {
 	}
 
 	query := fmt.Sprintf(`{ session(id:"%s") { mails { id headerFrom headerSubject text html receivedAt } } }`, tokenData.SessionID)
-	body, err := dropmailMeGraphQL(tokenData.AuthToken, query)
+	body, err := dropmailMeGraphQL(ctx, tokenData.AuthToken, query)
 	if err != nil {
 		return nil, err
 	}
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
+	"net/url"
 	"strings"
 	"sync"
 
@@ -41,15 +43,15 @@ type duckmailMessagesResponse struct {
 	Members []duckmailMessageItem `json:"hydra:member"`
 }
 
-func duckmailGetDomains() ([]string, error) {
-	req, err := http.NewRequest("GET", duckmailBaseURL+"/domains?page=1", nil)
+func duckmailGetDomains(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", duckmailBaseURL+"/domains?page=1", nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -79,21 +81,21 @@ func duckmailGetDomains() ([]string, error) {
 	return domains, nil
 }
 
-func duckmailCreateAccount(address, password string) (*duckmailAccountResponse, error) {
+func duckmailCreateAccount(ctx context.Context, address, password string) (*duckmailAccountResponse, error) {
 	reqBody, _ := json.Marshal(map[string]string{
 		"address":  address,
 		"password": password,
 	})
 
-	req, err := http.NewRequest("POST", duckmailBaseURL+"/accounts", bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", duckmailBaseURL+"/accounts", bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/ld+json")
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -106,7 +108,7 @@ func duckmailCreateAccount(address, password string) (*duckmailAccountResponse,
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("failed to create account: %d %s", resp.StatusCode, string(body))
+		return nil, statusError(resp, "failed to create account: %d %s", resp.StatusCode, string(body))
 	}
 
 	var result duckmailAccountResponse
@@ -116,21 +118,21 @@ func duckmailCreateAccount(address, password string) (*duckmailAccountResponse,
 	return &result, nil
 }
 
-func duckmailGetToken(address, password string) (string, error) {
+func duckmailGetToken(ctx context.Context, address, password string) (string, error) {
 	reqBody, _ := json.Marshal(map[string]string{
 		"address":  address,
 		"password": password,
 	})
 
-	req, err := http.NewRequest("POST", duckmailBaseURL+"/token", bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", duckmailBaseURL+"/token", bytes.NewReader(reqBody))
 	if err != nil {
 		return "", err
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -143,7 +145,7 @@ func duckmailGetToken(address, password string) (string, error) {
 	}
 
 	if resp.StatusCode >= 400 {
-		return "", fmt.Errorf("failed to get token: %d", resp.StatusCode)
+		return "", statusError(resp, "failed to get token: %d", resp.StatusCode)
 	}
 
 	var result duckmailTokenResponse
@@ -153,8 +155,8 @@ func duckmailGetToken(address, password string) (string, error) {
 	return result.Token, nil
 }
 
-func DuckmailGenerate() (*CreatedMailbox, error) {
-	domains, err := duckmailGetDomains()
+func DuckmailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	domains, err := duckmailGetDomains(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -163,16 +165,16 @@ func DuckmailGenerate() (*CreatedMailbox, error) {
 	}
 
 	domain := domains[rand.Intn(len(domains))]
-	username := randomStr(12)
+	username := localPartOr(ctx, randomStr(12))
 	address := fmt.Sprintf("%s@%s", username, domain)
 	password := randomStr(16)
 
-	account, err := duckmailCreateAccount(address, password)
+	account, err := duckmailCreateAccount(ctx, address, password)
 	if err != nil {
 		return nil, err
 	}
 
-	token, err := duckmailGetToken(address, password)
+	token, err := duckmailGetToken(ctx, address, password)
 	if err != nil {
 		return nil, err
 	}
@@ -193,8 +195,13 @@ func duckmailFlattenMessage(raw map[string]interface{}, recipientEmail string) m
 
 	if from, ok := raw["from"].(map[string]interface{}); ok {
 		flat["from"] = from["address"]
+		flat["from_name"] = from["name"]
 	}
 
+	// 完整收件人 / 抄送列表 [{name, address}]，由归一化层解析为结构化地址
+	flat["to_list"] = raw["to"]
+	flat["cc"] = raw["cc"]
+
 	if to, ok := raw["to"].([]interface{}); ok && len(to) > 0 {
 		if first, ok := to[0].(map[string]interface{}); ok {
 			flat["to"] = first["address"]
@@ -228,18 +235,18 @@ func duckmailFlattenMessage(raw map[string]interface{}, recipientEmail string) m
 	return flat
 }
 
-func DuckmailGetEmails(token string, email string) ([]NormEmail, error) {
+func DuckmailGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	_ = email
 
-	req, err := http.NewRequest("GET", duckmailBaseURL+"/messages?page=1", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", duckmailBaseURL+"/messages?page=1", nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Authorization", "Bearer "+token)
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -252,7 +259,7 @@ func DuckmailGetEmails(token string, email string) ([]NormEmail, error) {
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("failed to get messages: %d", resp.StatusCode)
+		return nil, statusError(resp, "failed to get messages: %d", resp.StatusCode)
 	}
 
 	var msgItems []duckmailMessageItem
@@ -282,14 +289,14 @@ func DuckmailGetEmails(token string, email string) ([]NormEmail, error) {
 		go func(idx int, msgID string) {
 			defer wg.Done()
 
-			detailReq, err := http.NewRequest("GET", duckmailBaseURL+"/messages/"+msgID, nil)
+			detailReq, err := http.NewRequestWithContext(ctx, "GET", duckmailBaseURL+"/messages/"+msgID, nil)
 			if err != nil {
 				results[idx] = detailResult{index: idx, err: err}
 				return
 			}
 			detailReq.Header.Set("Accept", "application/json")
 			detailReq.Header.Set("Authorization", "Bearer "+token)
-			detailReq.Header.Set("User-Agent", getCurrentUA())
+			detailReq.Header.Set("User-Agent", getCurrentUA(ctx))
 
 			detailResp, err := client.Do(detailReq)
 			if err != nil {
@@ -327,3 +334,31 @@ func DuckmailGetEmails(token string, email string) ([]NormEmail, error) {
 
 	return emails, nil
 }
+
+// duckmailAuthDo 携带 Bearer Token 请求 duckmail（与 mail.tm 协议相同）
+func duckmailAuthDo(ctx context.Context, method, path, token, action string) ([]byte, error) {
+	return mailTmStyleAuthDo(ctx, duckmailBaseURL, method, path, token, action)
+}
+
+// DuckmailGetSource 获取邮件原始 MIME 源码
+// API: GET /sources/{id}（与 mail.tm 协议相同）
+func DuckmailGetSource(ctx context.Context, token, id string) ([]byte, error) {
+	return mailTmStyleSource(duckmailAuthDo(ctx, "GET", "/sources/"+url.PathEscape(id), token, "get source"))
+}
+
+// DuckmailDownloadAttachment 携带 Bearer Token 下载附件（downloadUrl 需要鉴权）
+func DuckmailDownloadAttachment(ctx context.Context, token, rawURL string) (io.ReadCloser, error) {
+	return DownloadAttachmentURL(ctx, rawURL, map[string]string{"Authorization": "Bearer " + token})
+}
+
+// DuckmailDeleteMessage 删除单封邮件
+// API: DELETE /messages/{id}
+func DuckmailDeleteMessage(ctx context.Context, token, id string) error {
+	_, err := duckmailAuthDo(ctx, "DELETE", "/messages/"+url.PathEscape(id), token, "delete message")
+	return err
+}
+
+// DuckmailDeleteAccount 删除账号，邮箱及其全部邮件随之销毁
+func DuckmailDeleteAccount(ctx context.Context, token string) error {
+	return mailTmStyleDeleteAccount(ctx, duckmailBaseURL, token)
+}
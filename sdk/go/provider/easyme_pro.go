@@ -1,8 +1,12 @@
 package provider
 
-func EasymeProGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func EasymeProGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@easyme.pro"
 	return &CreatedMailbox{Channel: "easyme-pro", Email: email, Token: email}, nil
 }
-func EasymeProGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func EasymeProGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -70,7 +71,7 @@ func email10minEncodeToken(cookie, csrf string) string {
 
 func email10minDecodeToken(token string) (cookie, csrf string, err error) {
 	if !strings.HasPrefix(token, email10minTokPrefix) {
-		return "", "", fmt.Errorf("email10min: invalid session token")
+		return "", "", markError(ErrInvalidToken, "email10min: invalid session token")
 	}
 	decoded, err := base64.StdEncoding.DecodeString(token[len(email10minTokPrefix):])
 	if err != nil {
@@ -81,7 +82,7 @@ func email10minDecodeToken(token string) (cookie, csrf string, err error) {
 		return "", "", fmt.Errorf("email10min: invalid session token: %w", err)
 	}
 	if data.Cookie == "" || data.CSRF == "" {
-		return "", "", fmt.Errorf("email10min: invalid session token (empty fields)")
+		return "", "", markError(ErrInvalidToken, "email10min: invalid session token (empty fields)")
 	}
 	return data.Cookie, data.CSRF, nil
 }
@@ -122,14 +123,14 @@ func email10minExtractEmail(html string) (string, error) {
 }
 
 // Email10minGenerate 创建 email10min 临时邮箱
-func Email10minGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest("GET", email10minBase+"/zh", nil)
+func Email10minGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", email10minBase+"/zh", nil)
 	if err != nil {
 		return nil, err
 	}
 	email10minBrowserHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +165,7 @@ func Email10minGenerate() (*CreatedMailbox, error) {
 }
 
 // Email10minGetEmails 获取 email10min 邮件列表
-func Email10minGetEmails(email string, token string) ([]NormEmail, error) {
+func Email10minGetEmails(ctx context.Context, email string, token string) ([]NormEmail, error) {
 	cookie, csrf, err := email10minDecodeToken(token)
 	if err != nil {
 		return nil, err
@@ -172,14 +173,14 @@ func Email10minGetEmails(email string, token string) ([]NormEmail, error) {
 
 	ts := fmt.Sprintf("%d", time.Now().UnixMilli())
 	body := fmt.Sprintf("_token=%s&captcha=", csrf)
-	req, err := http.NewRequest("POST", email10minBase+"/messages?"+ts, strings.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", email10minBase+"/messages?"+ts, strings.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 	email10minAjaxHeaders(req)
 	req.Header.Set("Cookie", cookie)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -34,15 +35,15 @@ type emailnatorListResponse struct {
 	MessageData []emailnatorMessageRow `json:"messageData"`
 }
 
-func emailnatorInitSession() (emailnatorSession, error) {
-	req, err := http.NewRequest("GET", emailnatorBase, nil)
+func emailnatorInitSession(ctx context.Context) (emailnatorSession, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", emailnatorBase, nil)
 	if err != nil {
 		return emailnatorSession{}, err
 	}
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/146.0.0.0 Safari/537.36")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return emailnatorSession{}, err
 	}
@@ -68,12 +69,12 @@ func emailnatorInitSession() (emailnatorSession, error) {
 	return emailnatorSession{XSRFToken: xsrf, Cookie: cookie}, nil
 }
 
-func emailnatorPost(session emailnatorSession, path string, body any) ([]byte, error) {
+func emailnatorPost(ctx context.Context, session emailnatorSession, path string, body any) ([]byte, error) {
 	reqBody, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", emailnatorBase+path, bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", emailnatorBase+path, bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +87,7 @@ func emailnatorPost(session emailnatorSession, path string, body any) ([]byte, e
 	req.Header.Set("X-XSRF-TOKEN", session.XSRFToken)
 	req.Header.Set("Cookie", session.Cookie)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +97,7 @@ func emailnatorPost(session emailnatorSession, path string, body any) ([]byte, e
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("emailnator %s: %d %s", path, resp.StatusCode, string(raw))
+		return nil, statusError(resp, "emailnator %s: %d %s", path, resp.StatusCode, string(raw))
 	}
 	return raw, nil
 }
@@ -112,17 +113,17 @@ func emailnatorDecodeSession(token string) (emailnatorSession, error) {
 		return session, fmt.Errorf("emailnator: invalid session token: %w", err)
 	}
 	if session.Cookie == "" || session.XSRFToken == "" {
-		return session, fmt.Errorf("emailnator: invalid session token")
+		return session, markError(ErrInvalidToken, "emailnator: invalid session token")
 	}
 	return session, nil
 }
 
-func EmailnatorGenerate() (*CreatedMailbox, error) {
-	session, err := emailnatorInitSession()
+func EmailnatorGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	session, err := emailnatorInitSession(ctx)
 	if err != nil {
 		return nil, err
 	}
-	raw, err := emailnatorPost(session, "/generate-email", map[string][]string{"email": emailnatorOptions})
+	raw, err := emailnatorPost(ctx, session, "/generate-email", map[string][]string{"email": emailnatorOptions})
 	if err != nil {
 		return nil, err
 	}
@@ -147,8 +148,8 @@ func EmailnatorGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func emailnatorFetchDetail(session emailnatorSession, email string, messageID string) string {
-	raw, err := emailnatorPost(session, "/message-list", map[string]string{"email": email, "messageID": messageID})
+func emailnatorFetchDetail(ctx context.Context, session emailnatorSession, email string, messageID string) string {
+	raw, err := emailnatorPost(ctx, session, "/message-list", map[string]string{"email": email, "messageID": messageID})
 	if err != nil {
 		return ""
 	}
@@ -159,12 +160,56 @@ func emailnatorFetchDetail(session emailnatorSession, email string, messageID st
 	return string(raw)
 }
 
-func EmailnatorGetEmails(token string, email string) ([]NormEmail, error) {
+func EmailnatorGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
+	return emailnatorList(ctx, token, email, true)
+}
+
+/* EmailnatorListEmails 仅获取邮件摘要，不逐封请求 HTML 正文 */
+func EmailnatorListEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
+	return emailnatorList(ctx, token, email, false)
+}
+
+/* EmailnatorGetEmail 获取单封邮件：列表提供元数据，只为该邮件请求 HTML 正文 */
+func EmailnatorGetEmail(ctx context.Context, token, email, id string) (NormEmail, error) {
+	session, err := emailnatorDecodeSession(token)
+	if err != nil {
+		return NormEmail{}, err
+	}
+	rows, err := emailnatorListRows(ctx, session, email)
+	if err != nil {
+		return NormEmail{}, err
+	}
+	for _, row := range rows {
+		if row.MessageID == id {
+			return emailnatorRowToEmail(row, email, emailnatorFetchDetail(ctx, session, email, row.MessageID)), nil
+		}
+	}
+	return NormEmail{}, markError(ErrEmailNotFound, "emailnator: email %s not found", id)
+}
+
+func emailnatorList(ctx context.Context, token string, email string, withBody bool) ([]NormEmail, error) {
 	session, err := emailnatorDecodeSession(token)
 	if err != nil {
 		return nil, err
 	}
-	raw, err := emailnatorPost(session, "/message-list", map[string]string{"email": email})
+	rows, err := emailnatorListRows(ctx, session, email)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]NormEmail, 0, len(rows))
+	for _, row := range rows {
+		html := ""
+		if withBody {
+			html = emailnatorFetchDetail(ctx, session, email, row.MessageID)
+		}
+		out = append(out, emailnatorRowToEmail(row, email, html))
+	}
+	return out, nil
+}
+
+/* emailnatorListRows 拉取邮件列表并过滤广告条目（ADS 前缀） */
+func emailnatorListRows(ctx context.Context, session emailnatorSession, email string) ([]emailnatorMessageRow, error) {
+	raw, err := emailnatorPost(ctx, session, "/message-list", map[string]string{"email": email})
 	if err != nil {
 		return nil, err
 	}
@@ -172,21 +217,26 @@ func EmailnatorGetEmails(token string, email string) ([]NormEmail, error) {
 	if err := json.Unmarshal(raw, &data); err != nil {
 		return nil, err
 	}
-	out := make([]NormEmail, 0, len(data.MessageData))
+	rows := make([]emailnatorMessageRow, 0, len(data.MessageData))
 	for _, row := range data.MessageData {
 		if row.MessageID == "" || len(row.MessageID) >= 3 && row.MessageID[:3] == "ADS" {
 			continue
 		}
-		out = append(out, NormalizeMap(map[string]interface{}{
-			"id":          row.MessageID,
-			"from":        row.From,
-			"to":          email,
-			"subject":     row.Subject,
-			"html":        emailnatorFetchDetail(session, email, row.MessageID),
-			"date":        row.Time,
-			"isRead":      false,
-			"attachments": []interface{}{},
-		}, email))
+		rows = append(rows, row)
 	}
-	return out, nil
+	return rows, nil
+}
+
+/* emailnatorRowToEmail 详情接口只返回渲染后的 HTML 正文、没有附件元数据，附件恒为空 */
+func emailnatorRowToEmail(row emailnatorMessageRow, email, html string) NormEmail {
+	return NormalizeMap(map[string]interface{}{
+		"id":          row.MessageID,
+		"from":        row.From,
+		"to":          email,
+		"subject":     row.Subject,
+		"html":        html,
+		"date":        row.Time,
+		"isRead":      false,
+		"attachments": []interface{}{},
+	}, email)
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,14 +26,14 @@ var emailtempOrgCSRFRe = regexp.MustCompile(`<meta\s+name="csrf-token"\s+content
 
 /* emailtempOrgBrowserHeaders 设置浏览器模拟请求头 */
 func emailtempOrgBrowserHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 }
 
 /* emailtempOrgAjaxHeaders 设置 AJAX 请求头 */
 func emailtempOrgAjaxHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "application/json, text/javascript, */*; q=0.01")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
@@ -40,10 +41,10 @@ func emailtempOrgAjaxHeaders(req *http.Request) {
 }
 
 /* EmailtempOrgGenerate 创建 emailtemp.org 临时邮箱 */
-func EmailtempOrgGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func EmailtempOrgGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", emailtempOrgBaseURL+"/en", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", emailtempOrgBaseURL+"/en", nil)
 	if err != nil {
 		return nil, fmt.Errorf("emailtemp-org: 创建首页请求失败: %w", err)
 	}
@@ -74,7 +75,7 @@ func EmailtempOrgGenerate() (*CreatedMailbox, error) {
 	form.Set("_token", csrfToken)
 	form.Set("captcha", "")
 
-	req2, err := http.NewRequest("POST", emailtempOrgBaseURL+"/messages", strings.NewReader(form.Encode()))
+	req2, err := http.NewRequestWithContext(ctx, "POST", emailtempOrgBaseURL+"/messages", strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("emailtemp-org: 创建消息请求失败: %w", err)
 	}
@@ -124,7 +125,7 @@ func EmailtempOrgGenerate() (*CreatedMailbox, error) {
 }
 
 /* EmailtempOrgGetEmails 获取 emailtemp.org 邮件列表 */
-func EmailtempOrgGetEmails(email, token string) ([]NormEmail, error) {
+func EmailtempOrgGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("emailtemp-org: 邮箱地址为空")
@@ -134,13 +135,13 @@ func EmailtempOrgGetEmails(email, token string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("emailtemp-org: 缺少 CSRF token")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	form := url.Values{}
 	form.Set("_token", csrfToken)
 	form.Set("captcha", "")
 
-	req, err := http.NewRequest("POST", emailtempOrgBaseURL+"/messages", strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", emailtempOrgBaseURL+"/messages", strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("emailtemp-org: 创建消息请求失败: %w", err)
 	}
@@ -189,7 +190,7 @@ func EmailtempOrgGetEmails(email, token string) ([]NormEmail, error) {
 			fromAddr = fmt.Sprintf("%s <%s>", msg.From, msg.FromEmail)
 		}
 
-		htmlBody := emailtempOrgFetchView(client, id)
+		htmlBody := emailtempOrgFetchView(ctx, client, id)
 
 		flat := map[string]interface{}{
 			"id":      id,
@@ -206,14 +207,14 @@ func EmailtempOrgGetEmails(email, token string) ([]NormEmail, error) {
 }
 
 /* emailtempOrgFetchView 获取单封邮件 HTML 正文 */
-func emailtempOrgFetchView(client interface {
+func emailtempOrgFetchView(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }, id string) string {
 	if id == "" {
 		return ""
 	}
 
-	req, err := http.NewRequest("GET", emailtempOrgBaseURL+"/view/"+url.PathEscape(id), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", emailtempOrgBaseURL+"/view/"+url.PathEscape(id), nil)
 	if err != nil {
 		return ""
 	}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	stdhttp "net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	http "github.com/bogdanfinn/fhttp"
+)
+
+/*
+ * 错误分类
+ * provider 返回的错误通过 errors.Is / errors.As 归类，根包的重试策略与调用方均据此判断，
+ * 不再依赖错误消息文本。根包 tempemail 以同名别名导出这些类型与哨兵值。
+ */
+
+var (
+	// ErrChannelUnavailable 渠道暂不可用（服务端 5xx、后端熔断中、站点下线等）
+	ErrChannelUnavailable = errors.New("channel unavailable")
+	// ErrCaptchaRequired 渠道要求人机验证（Cloudflare 质询、captcha 等），重试无意义
+	ErrCaptchaRequired = errors.New("captcha required")
+	// ErrMailboxExpired 邮箱已过期或已被服务端回收
+	ErrMailboxExpired = errors.New("mailbox expired")
+	// ErrInvalidToken 会话令牌无效或鉴权失败
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrEmailNotFound 邮箱中不存在指定 ID 的邮件
+	ErrEmailNotFound = errors.New("email not found")
+)
+
+// ErrRateLimited 渠道限流；RetryAfter 为服务端建议的等待时长（未提供时为 0）
+type ErrRateLimited struct {
+	RetryAfter time.Duration
+}
+
+func (e *ErrRateLimited) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited (retry after %s)", e.RetryAfter)
+	}
+	return "rate limited"
+}
+
+// HTTPStatusError 非 2xx/3xx 的 HTTP 响应
+// 按状态码展开为对应分类：429 → *ErrRateLimited，401 → ErrInvalidToken，410 → ErrMailboxExpired，
+// 5xx → ErrChannelUnavailable，Cloudflare 质询 → ErrCaptchaRequired
+type HTTPStatusError struct {
+	StatusCode int
+	// RetryAfter 解析自 Retry-After 响应头
+	RetryAfter time.Duration
+	// Captcha 响应为人机验证质询
+	Captcha bool
+	msg     string
+}
+
+func (e *HTTPStatusError) Error() string { return e.msg }
+
+func (e *HTTPStatusError) Unwrap() error {
+	switch {
+	case e.Captcha:
+		return ErrCaptchaRequired
+	case e.StatusCode == http.StatusTooManyRequests:
+		return &ErrRateLimited{RetryAfter: e.RetryAfter}
+	case e.StatusCode == http.StatusUnauthorized:
+		return ErrInvalidToken
+	case e.StatusCode == http.StatusGone:
+		return ErrMailboxExpired
+	case e.StatusCode >= 500:
+		return ErrChannelUnavailable
+	}
+	return nil
+}
+
+// Temporary 状态码是否属于暂时性失败（408/425/429/5xx），供重试策略使用
+func (e *HTTPStatusError) Temporary() bool {
+	if e.Captcha {
+		return false
+	}
+	switch e.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooEarly, http.StatusTooManyRequests:
+		return true
+	}
+	return e.StatusCode >= 500
+}
+
+// NewHTTPStatusError 由响应构造 HTTPStatusError，消息按 format/args 格式化（保持各渠道原有文案）
+func NewHTTPStatusError(resp *http.Response, format string, args ...any) *HTTPStatusError {
+	return buildHTTPStatusError(resp.StatusCode, resp.Header, format, args...)
+}
+
+// headerGetter 兼容 fhttp.Header 与 net/http.Header
+type headerGetter interface {
+	Get(key string) string
+}
+
+func buildHTTPStatusError(code int, header headerGetter, format string, args ...any) *HTTPStatusError {
+	return &HTTPStatusError{
+		StatusCode: code,
+		RetryAfter: ParseRetryAfter(header.Get("Retry-After")),
+		Captcha:    isCaptchaResponse(code, header),
+		msg:        fmt.Sprintf(format, args...),
+	}
+}
+
+// statusError 渠道内构造 HTTP 状态错误的简写
+func statusError(resp *http.Response, format string, args ...any) error {
+	return NewHTTPStatusError(resp, format, args...)
+}
+
+// stdStatusError 同 statusError，供使用标准库 net/http 的渠道
+func stdStatusError(resp *stdhttp.Response, format string, args ...any) error {
+	return buildHTTPStatusError(resp.StatusCode, resp.Header, format, args...)
+}
+
+// kindError 保留原错误文案并归入某一分类（errors.Is(err, kind) 为 true）
+type kindError struct {
+	kind error
+	msg  string
+}
+
+func (e *kindError) Error() string { return e.msg }
+func (e *kindError) Unwrap() error { return e.kind }
+
+// markError 构造归入 kind 分类的错误，消息按 format/args 格式化
+func markError(kind error, format string, args ...any) error {
+	return &kindError{kind: kind, msg: fmt.Sprintf(format, args...)}
+}
+
+// statusCodeError 仅有状态码（响应头已不可得）时构造 HTTP 状态错误
+func statusCodeError(code int, format string, args ...any) error {
+	return buildHTTPStatusError(code, http.Header{}, format, args...)
+}
+
+// ParseRetryAfter 解析 Retry-After（秒数或 HTTP 日期），无法解析时返回 0
+func ParseRetryAfter(v string) time.Duration {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// isCaptchaResponse 识别 Cloudflare 托管质询（403/503 + cf-mitigated: challenge）
+func isCaptchaResponse(code int, header headerGetter) bool {
+	if code != http.StatusForbidden && code != http.StatusServiceUnavailable {
+		return false
+	}
+	return strings.EqualFold(header.Get("cf-mitigated"), "challenge")
+}
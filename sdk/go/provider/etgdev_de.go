@@ -1,15 +1,17 @@
 package provider
 
+import "context"
+
 // etgdev.de：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
-func EtgdevDeGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func EtgdevDeGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "etgdev-de",
 		Email:   local + "@etgdev.de",
 	}, nil
 }
 
-func EtgdevDeGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func EtgdevDeGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
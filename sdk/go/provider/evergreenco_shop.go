@@ -1,8 +1,12 @@
 package provider
 
-func EvergreencoShopGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func EvergreencoShopGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@evergreenco.shop"
 	return &CreatedMailbox{Channel: "evergreenco-shop", Email: email, Token: email}, nil
 }
-func EvergreencoShopGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func EvergreencoShopGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
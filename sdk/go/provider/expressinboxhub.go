@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -71,15 +72,15 @@ func expressinboxhubMergeCookies(existing string, cookies []*http.Cookie) string
  * ExpressinboxhubGenerate 创建 expressinboxhub 临时邮箱
  * 流程: GET 首页获取 CSRF token 和 session cookies → POST /messages 创建邮箱
  */
-func ExpressinboxhubGenerate() (*CreatedMailbox, error) {
+func ExpressinboxhubGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	/* 第一步: GET 首页，提取 CSRF token 并收集 session cookies */
-	getReq, err := http.NewRequest("GET", expressinboxhubBase, nil)
+	getReq, err := http.NewRequestWithContext(ctx, "GET", expressinboxhubBase, nil)
 	if err != nil {
 		return nil, err
 	}
 	expressinboxhubBrowserHeaders(getReq)
 
-	getResp, err := HTTPClient().Do(getReq)
+	getResp, err := HTTPClient(ctx).Do(getReq)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +103,7 @@ func ExpressinboxhubGenerate() (*CreatedMailbox, error) {
 
 	/* 第二步: POST /messages 携带 CSRF token 创建邮箱 */
 	body := "_token=" + csrf
-	postReq, err := http.NewRequest("POST", expressinboxhubBase+"/messages", strings.NewReader(body))
+	postReq, err := http.NewRequestWithContext(ctx, "POST", expressinboxhubBase+"/messages", strings.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +111,7 @@ func ExpressinboxhubGenerate() (*CreatedMailbox, error) {
 	postReq.Header.Set("Cookie", cookie)
 	postReq.Header.Set("X-CSRF-TOKEN", csrf)
 
-	postResp, err := HTTPClient().Do(postReq)
+	postResp, err := HTTPClient(ctx).Do(postReq)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +155,7 @@ func ExpressinboxhubGenerate() (*CreatedMailbox, error) {
  * ExpressinboxhubGetEmails 获取 expressinboxhub 邮件列表
  * token 格式: "cookie\ncsrf"
  */
-func ExpressinboxhubGetEmails(email string, token string) ([]NormEmail, error) {
+func ExpressinboxhubGetEmails(ctx context.Context, email string, token string) ([]NormEmail, error) {
 	parts := strings.SplitN(token, "\n", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		return nil, fmt.Errorf("expressinboxhub: 无效的 session token")
@@ -163,7 +164,7 @@ func ExpressinboxhubGetEmails(email string, token string) ([]NormEmail, error) {
 	csrf := parts[1]
 
 	body := "_token=" + csrf
-	req, err := http.NewRequest("POST", expressinboxhubBase+"/messages", strings.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", expressinboxhubBase+"/messages", strings.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -171,7 +172,7 @@ func ExpressinboxhubGetEmails(email string, token string) ([]NormEmail, error) {
 	req.Header.Set("Cookie", cookie)
 	req.Header.Set("X-CSRF-TOKEN", csrf)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
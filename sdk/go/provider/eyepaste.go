@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -39,7 +40,7 @@ func eyepasteDefaultHeaders(req *http.Request) {
 	req.Header.Set("DNT", "1")
 	req.Header.Set("Pragma", "no-cache")
 	req.Header.Set("Referer", "https://www.eyepaste.com/")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 }
 
 /* RSS 2.0 XML 结构定义 */
@@ -101,8 +102,8 @@ func eyepasteParseDescription(desc string) (from, to, subject, date, body string
  * EyepasteGenerate 创建 eyepaste.com 临时邮箱
  * 无需 API 调用，直接生成随机用户名 + "@eyepaste.com"
  */
-func EyepasteGenerate(channel ...string) (*CreatedMailbox, error) {
-	user := eyepasteRandomLocal(10)
+func EyepasteGenerate(ctx context.Context, channel ...string) (*CreatedMailbox, error) {
+	user := localPartOr(ctx, eyepasteRandomLocal(10))
 	email := user + "@" + eyepasteDomain
 	ch := "eyepaste"
 	if len(channel) > 0 && channel[0] != "" {
@@ -115,20 +116,20 @@ func EyepasteGenerate(channel ...string) (*CreatedMailbox, error) {
  * EyepasteGetEmails 获取 eyepaste.com 邮件列表
  * GET https://www.eyepaste.com/inbox/{email}.rss → RSS 2.0 XML
  */
-func EyepasteGetEmails(email string) ([]NormEmail, error) {
+func EyepasteGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("eyepaste: empty email")
 	}
 
 	u := fmt.Sprintf("%s/inbox/%s.rss", eyepasteBase, email)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	eyepasteDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +140,7 @@ func EyepasteGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("eyepaste rss: %d", resp.StatusCode)
+		return nil, statusError(resp, "eyepaste rss: %d", resp.StatusCode)
 	}
 
 	var rss eyepasteRSS
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -40,15 +41,15 @@ type fakeEmailSiteInboxResponse struct {
  * FakeEmailSiteGenerate — 创建临时邮箱
  * POST /api/temporary-address，body 为空对象 {}，解析 temp_email_addr
  */
-func FakeEmailSiteGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest("POST", fakeEmailSiteBase+"/api/temporary-address", strings.NewReader("{}"))
+func FakeEmailSiteGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fakeEmailSiteBase+"/api/temporary-address", strings.NewReader("{}"))
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("fake-email-site 创建请求失败: %w", err)
 	}
@@ -60,7 +61,7 @@ func FakeEmailSiteGenerate() (*CreatedMailbox, error) {
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("fake-email-site 创建: HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "fake-email-site 创建: HTTP %d", resp.StatusCode)
 	}
 
 	var data fakeEmailSiteCreateResponse
@@ -82,20 +83,20 @@ func FakeEmailSiteGenerate() (*CreatedMailbox, error) {
  * 404 返回空数组（邮箱未找到或暂无邮件）
  * 每条消息构造为 map[string]any 后调用 NormalizeMap 归一化
  */
-func FakeEmailSiteGetEmails(email string) ([]NormEmail, error) {
+func FakeEmailSiteGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("fake-email-site: 邮箱地址为空")
 	}
 
 	u := fmt.Sprintf("%s/api/inbox/poll?address=%s", fakeEmailSiteBase, url.QueryEscape(email))
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("fake-email-site 轮询请求失败: %w", err)
 	}
@@ -105,7 +106,7 @@ func FakeEmailSiteGetEmails(email string) ([]NormEmail, error) {
 		return []NormEmail{}, nil
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("fake-email-site 轮询: HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "fake-email-site 轮询: HTTP %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
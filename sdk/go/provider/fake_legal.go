@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -44,13 +45,13 @@ type fakeLegalInboxResp struct {
 	Emails  []map[string]interface{} `json:"emails"`
 }
 
-func fakeLegalFetchDomains() ([]string, error) {
-	req, err := http.NewRequest("GET", fakeLegalBase+"/api/domains", nil)
+func fakeLegalFetchDomains(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fakeLegalBase+"/api/domains", nil)
 	if err != nil {
 		return nil, err
 	}
 	fakeLegalDefaultHeaders(req)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +61,7 @@ func fakeLegalFetchDomains() ([]string, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("fake-legal domains: %d", resp.StatusCode)
+		return nil, statusError(resp, "fake-legal domains: %d", resp.StatusCode)
 	}
 	var dr fakeLegalDomainsResp
 	if err := json.Unmarshal(body, &dr); err != nil {
@@ -104,8 +105,8 @@ func fakeLegalRandomUsername(n int) string {
 	return string(b)
 }
 
-func FakeLegalGenerate(domain *string, channel ...string) (*CreatedMailbox, error) {
-	domains, err := fakeLegalFetchDomains()
+func FakeLegalGenerate(ctx context.Context, domain *string, channel ...string) (*CreatedMailbox, error) {
+	domains, err := fakeLegalFetchDomains(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -125,20 +126,20 @@ func FakeLegalGenerate(domain *string, channel ...string) (*CreatedMailbox, erro
 			return nil, err
 		}
 		u := fmt.Sprintf("%s/api/inbox/custom", fakeLegalBase)
-		req, err = http.NewRequest("POST", u, bytes.NewReader(body))
+		req, err = http.NewRequestWithContext(ctx, "POST", u, bytes.NewReader(body))
 		if err != nil {
 			return nil, err
 		}
 		req.Header.Set("Content-Type", "application/json")
 	} else {
 		u := fmt.Sprintf("%s/api/inbox/new?domain=%s", fakeLegalBase, url.QueryEscape(d))
-		req, err = http.NewRequest("GET", u, nil)
+		req, err = http.NewRequestWithContext(ctx, "GET", u, nil)
 		if err != nil {
 			return nil, err
 		}
 	}
 	fakeLegalDefaultHeaders(req)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +149,7 @@ func FakeLegalGenerate(domain *string, channel ...string) (*CreatedMailbox, erro
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("fake-legal new inbox: %d", resp.StatusCode)
+		return nil, statusError(resp, "fake-legal new inbox: %d", resp.StatusCode)
 	}
 	var nr fakeLegalNewResp
 	if err := json.Unmarshal(body, &nr); err != nil {
@@ -164,19 +165,19 @@ func FakeLegalGenerate(domain *string, channel ...string) (*CreatedMailbox, erro
 	return &CreatedMailbox{Channel: ch, Email: strings.TrimSpace(nr.Address), Token: ""}, nil
 }
 
-func FakeLegalGetEmails(email string) ([]NormEmail, error) {
+func FakeLegalGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("fake-legal: empty email")
 	}
 	seg := url.PathEscape(email)
 	u := fmt.Sprintf("%s/api/inbox/%s", fakeLegalBase, seg)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	fakeLegalDefaultHeaders(req)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +187,7 @@ func FakeLegalGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("fake-legal inbox: %d", resp.StatusCode)
+		return nil, statusError(resp, "fake-legal inbox: %d", resp.StatusCode)
 	}
 	var ir fakeLegalInboxResp
 	if err := json.Unmarshal(body, &ir); err != nil {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -61,8 +62,8 @@ func fakemailMergeCookie(prev string, headers stdhttp.Header) string {
 	return strings.Join(parts, "; ")
 }
 
-func fakemailRequest(method, u, cookie string, body io.Reader) (int, []byte, string, error) {
-	req, err := stdhttp.NewRequest(method, u, body)
+func fakemailRequest(ctx context.Context, method, u, cookie string, body io.Reader) (int, []byte, string, error) {
+	req, err := stdhttp.NewRequestWithContext(ctx, method, u, body)
 	if err != nil {
 		return 0, nil, "", err
 	}
@@ -98,25 +99,25 @@ func fakemailCleanJSON(data []byte) []byte {
 	return data
 }
 
-func FakemailGenerate() (*CreatedMailbox, error) {
-	status, body, cookie, err := fakemailRequest(stdhttp.MethodGet, fakemailBase+"/", "", nil)
+func FakemailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	status, body, cookie, err := fakemailRequest(ctx, stdhttp.MethodGet, fakemailBase+"/", "", nil)
 	if err != nil {
 		return nil, err
 	}
 	if status < 200 || status >= 300 {
-		return nil, fmt.Errorf("fakemail home: %d", status)
+		return nil, statusCodeError(status, "fakemail home: %d", status)
 	}
 	m := fakemailCSRFRe.FindStringSubmatch(string(body))
 	if len(m) < 2 {
 		return nil, fmt.Errorf("fakemail: csrf token not found")
 	}
 	u := fakemailBase + "/index/index?csrf_token=" + url.QueryEscape(m[1])
-	status, body, cookie, err = fakemailRequest(stdhttp.MethodGet, u, cookie, nil)
+	status, body, cookie, err = fakemailRequest(ctx, stdhttp.MethodGet, u, cookie, nil)
 	if err != nil {
 		return nil, err
 	}
 	if status < 200 || status >= 300 {
-		return nil, fmt.Errorf("fakemail generate: %d", status)
+		return nil, statusCodeError(status, "fakemail generate: %d", status)
 	}
 	var data fakemailGenerateResponse
 	if err := json.Unmarshal(fakemailCleanJSON(body), &data); err != nil {
@@ -129,14 +130,14 @@ func FakemailGenerate() (*CreatedMailbox, error) {
 	return &CreatedMailbox{Channel: "fakemail", Email: email, Token: cookie}, nil
 }
 
-func fakemailFetchDetail(cookie, id string) (*fakemailDetailResponse, error) {
+func fakemailFetchDetail(ctx context.Context, cookie, id string) (*fakemailDetailResponse, error) {
 	form := url.Values{"id": {id}}
-	status, body, _, err := fakemailRequest(stdhttp.MethodPost, fakemailBase+"/index/email", cookie, strings.NewReader(form.Encode()))
+	status, body, _, err := fakemailRequest(ctx, stdhttp.MethodPost, fakemailBase+"/index/email", cookie, strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, err
 	}
 	if status < 200 || status >= 300 {
-		return nil, fmt.Errorf("fakemail detail: %d", status)
+		return nil, statusCodeError(status, "fakemail detail: %d", status)
 	}
 	var detail fakemailDetailResponse
 	if err := json.Unmarshal(fakemailCleanJSON(body), &detail); err != nil {
@@ -176,21 +177,21 @@ func fakemailFlatten(row fakemailListRow, detail *fakemailDetailResponse, recipi
 	}
 }
 
-func FakemailGetEmails(token, email string) ([]NormEmail, error) {
+func FakemailGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	cookie := strings.TrimSpace(token)
 	address := strings.TrimSpace(email)
 	if cookie == "" {
-		return nil, fmt.Errorf("fakemail: empty session token")
+		return nil, markError(ErrInvalidToken, "fakemail: empty session token")
 	}
 	if address == "" {
 		return nil, fmt.Errorf("fakemail: empty email")
 	}
-	status, body, _, err := fakemailRequest(stdhttp.MethodGet, fakemailBase+"/index/refresh", cookie, nil)
+	status, body, _, err := fakemailRequest(ctx, stdhttp.MethodGet, fakemailBase+"/index/refresh", cookie, nil)
 	if err != nil {
 		return nil, err
 	}
 	if status < 200 || status >= 300 {
-		return nil, fmt.Errorf("fakemail refresh: %d", status)
+		return nil, statusCodeError(status, "fakemail refresh: %d", status)
 	}
 	var rows []fakemailListRow
 	if err := json.Unmarshal(fakemailCleanJSON(body), &rows); err != nil {
@@ -201,7 +202,7 @@ func FakemailGetEmails(token, email string) ([]NormEmail, error) {
 		id := strings.TrimSpace(fmt.Sprintf("%v", row.ID))
 		var detail *fakemailDetailResponse
 		if id != "" {
-			detail, _ = fakemailFetchDetail(cookie, id)
+			detail, _ = fakemailFetchDetail(ctx, cookie, id)
 		}
 		out = append(out, NormalizeMap(fakemailFlatten(row, detail, address), address))
 	}
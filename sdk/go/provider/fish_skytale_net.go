@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // fish.skytale.net：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // FishSkytaleNetGenerate 创建 fish.skytale.net 临时邮箱
-func FishSkytaleNetGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func FishSkytaleNetGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "fish-skytale-net",
 		Email:   local + "@fish.skytale.net",
@@ -12,6 +14,6 @@ func FishSkytaleNetGenerate() (*CreatedMailbox, error) {
 }
 
 // FishSkytaleNetGetEmails 读取邮件（复用 mailinator public API）
-func FishSkytaleNetGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func FishSkytaleNetGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,15 +26,15 @@ func fmailAnyString(v any) string {
 	}
 }
 
-func fmailJSON(method, u string) (map[string]any, error) {
-	req, err := http.NewRequest(method, u, nil)
+func fmailJSON(ctx context.Context, method, u string) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +45,7 @@ func fmailJSON(method, u string) (map[string]any, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("fmail http %d", resp.StatusCode)
+		return nil, statusError(resp, "fmail http %d", resp.StatusCode)
 	}
 
 	var out map[string]any
@@ -120,13 +121,13 @@ func fmailFlattenMessage(raw map[string]any, recipient string) map[string]any {
 	}
 }
 
-func FmailGenerate(domain *string) (*CreatedMailbox, error) {
+func FmailGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
 	selected := fmailNormalizeDomain(domain)
 	u := fmailBase + "/v1/random"
 	if selected != nil {
 		u += "?domain=" + url.QueryEscape(*selected)
 	}
-	data, err := fmailJSON(http.MethodGet, u)
+	data, err := fmailJSON(ctx, http.MethodGet, u)
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +150,7 @@ func FmailGenerate(domain *string) (*CreatedMailbox, error) {
 	}, nil
 }
 
-func FmailGetEmails(email string) ([]NormEmail, error) {
+func FmailGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("fmail: invalid email")
@@ -160,7 +161,7 @@ func FmailGetEmails(email string) ([]NormEmail, error) {
 	}
 
 	u := fmt.Sprintf("%s/v1/inbox/%s?domain=%s&limit=50", fmailBase, url.PathEscape(strings.TrimSpace(parts[0])), url.QueryEscape(strings.TrimSpace(parts[1])))
-	data, err := fmailJSON(http.MethodGet, u)
+	data, err := fmailJSON(ctx, http.MethodGet, u)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +182,7 @@ func FmailGetEmails(email string) ([]NormEmail, error) {
 			continue
 		}
 
-		detail, err := fmailJSON(http.MethodGet, fmailBase+"/v1/email/"+url.PathEscape(token))
+		detail, err := fmailJSON(ctx, http.MethodGet, fmailBase+"/v1/email/"+url.PathEscape(token))
 		if err != nil {
 			out = append(out, NormalizeMap(fmailFlattenMessage(raw, address), address))
 			continue
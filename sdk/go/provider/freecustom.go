@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -40,9 +41,9 @@ type freecustomDomain struct {
 // freecustomPickDomain 挑选一个当前可收信的域名。
 // /domains 无需鉴权，优先选择 tier=="free" 且未过期（expiring_soon 非 true）的域名；
 // 若全部标记过期则退回全量列表随机。
-func freecustomPickDomain() (string, error) {
-	client := HTTPClient()
-	req, err := http.NewRequest("GET", freecustomDomainURL, nil)
+func freecustomPickDomain(ctx context.Context) (string, error) {
+	client := HTTPClient(ctx)
+	req, err := http.NewRequestWithContext(ctx, "GET", freecustomDomainURL, nil)
 	if err != nil {
 		return "", err
 	}
@@ -91,9 +92,9 @@ func freecustomPickDomain() (string, error) {
 
 // freecustomFetchAuthToken 获取匿名访问令牌（JWT，有效期约 2 小时）
 // POST /api/auth → { token }
-func freecustomFetchAuthToken() (string, error) {
-	client := HTTPClient()
-	req, err := http.NewRequest("POST", freecustomSiteURL+"/api/auth", nil)
+func freecustomFetchAuthToken(ctx context.Context) (string, error) {
+	client := HTTPClient(ctx)
+	req, err := http.NewRequestWithContext(ctx, "POST", freecustomSiteURL+"/api/auth", nil)
 	if err != nil {
 		return "", err
 	}
@@ -129,8 +130,8 @@ func freecustomFetchAuthToken() (string, error) {
 }
 
 // FreecustomGenerate 创建 freecustom.email 临时邮箱
-func FreecustomGenerate() (*CreatedMailbox, error) {
-	domain, err := freecustomPickDomain()
+func FreecustomGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	domain, err := freecustomPickDomain(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -151,14 +152,16 @@ type freecustomMessage struct {
 	Date    string `json:"date"`
 	HTML    string `json:"html"`
 	Text    string `json:"text"`
+	// 附件元数据（filename / contentType / size / url），url 可能为站内相对路径且需要 JWT
+	Attachments []map[string]interface{} `json:"attachments"`
 }
 
 // freecustomFetchMessage 补全单封邮件正文；失败时返回 nil（由调用方退回列表元数据）
-func freecustomFetchMessage(email, msgID string, authHeaders map[string]string) *freecustomMessage {
-	client := HTTPClient()
+func freecustomFetchMessage(ctx context.Context, email, msgID string, authHeaders map[string]string) *freecustomMessage {
+	client := HTTPClient(ctx)
 	u := fmt.Sprintf("%s/api/public-mailbox?fullMailboxId=%s&messageId=%s",
 		freecustomSiteURL, url.QueryEscape(email), url.QueryEscape(msgID))
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil
 	}
@@ -194,12 +197,12 @@ func freecustomFetchMessage(email, msgID string, authHeaders map[string]string)
 //  1. POST /api/auth 取 JWT
 //  2. GET /api/public-mailbox?fullMailboxId=<email> 取邮件元数据列表
 //  3. 对每封 GET /api/public-mailbox?fullMailboxId=<email>&messageId=<id> 补全正文
-func FreecustomGetEmails(email string) ([]NormEmail, error) {
+func FreecustomGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	if email == "" {
 		return nil, fmt.Errorf("freecustom: 缺少邮箱地址")
 	}
 
-	jwt, err := freecustomFetchAuthToken()
+	jwt, err := freecustomFetchAuthToken(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -211,9 +214,9 @@ func FreecustomGetEmails(email string) ([]NormEmail, error) {
 		"x-fce-client":  "web-client",
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	listURL := fmt.Sprintf("%s/api/public-mailbox?fullMailboxId=%s", freecustomSiteURL, url.QueryEscape(email))
-	req, err := http.NewRequest("GET", listURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", listURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -252,7 +255,7 @@ func FreecustomGetEmails(email string) ([]NormEmail, error) {
 		}
 		full := item
 		// 补全正文，失败则退回列表元数据
-		if body := freecustomFetchMessage(email, item.ID, authHeaders); body != nil {
+		if body := freecustomFetchMessage(ctx, email, item.ID, authHeaders); body != nil {
 			full = *body
 		}
 
@@ -270,7 +273,31 @@ func FreecustomGetEmails(email string) ([]NormEmail, error) {
 			"date":    full.Date,
 			"isRead":  false,
 		}
+		if len(full.Attachments) > 0 {
+			atts := make([]interface{}, 0, len(full.Attachments))
+			for _, att := range full.Attachments {
+				if u, ok := att["url"].(string); ok && len(u) > 0 && u[0] == '/' {
+					att["url"] = freecustomSiteURL + u
+				}
+				atts = append(atts, att)
+			}
+			flat["attachments"] = atts
+		}
 		emails = append(emails, NormalizeMap(flat, email))
 	}
 	return emails, nil
 }
+
+// FreecustomDownloadAttachment 下载附件：附件地址需要与读信相同的匿名 JWT
+func FreecustomDownloadAttachment(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	jwt, err := freecustomFetchAuthToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return DownloadAttachmentURL(ctx, rawURL, map[string]string{
+		"User-Agent":    freecustomUA,
+		"Referer":       freecustomReferer,
+		"Authorization": "Bearer " + jwt,
+		"x-fce-client":  "web-client",
+	})
+}
@@ -1,6 +1,10 @@
 package provider
 
-func Fwd2mEszettEsGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "fwd2m-eszett-es", Email: randomStr(12) + "@fwd2m.eszett.es"}, nil
+import "context"
+
+func Fwd2mEszettEsGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "fwd2m-eszett-es", Email: localPartOr(ctx, randomStr(12)) + "@fwd2m.eszett.es"}, nil
+}
+func Fwd2mEszettEsGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func Fwd2mEszettEsGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
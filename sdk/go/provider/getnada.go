@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -43,8 +44,8 @@ func getnadaRandomLocal() string {
 	return b.String()
 }
 
-func getnadaJSON(method, u string, body []byte, out any) error {
-	req, err := http.NewRequest(method, u, bytes.NewReader(body))
+func getnadaJSON(ctx context.Context, method, u string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
 	if err != nil {
 		return err
 	}
@@ -52,7 +53,7 @@ func getnadaJSON(method, u string, body []byte, out any) error {
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -62,7 +63,7 @@ func getnadaJSON(method, u string, body []byte, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("getnada: http %d", resp.StatusCode)
+		return statusError(resp, "getnada: http %d", resp.StatusCode)
 	}
 	if out == nil {
 		return nil
@@ -70,9 +71,9 @@ func getnadaJSON(method, u string, body []byte, out any) error {
 	return json.Unmarshal(raw, out)
 }
 
-func getnadaPickDomain(preferred *string) (string, error) {
+func getnadaPickDomain(ctx context.Context, preferred *string) (string, error) {
 	var data getnadaDomainsResponse
-	if err := getnadaJSON(http.MethodGet, getnadaBase+"/public/domains", nil, &data); err != nil {
+	if err := getnadaJSON(ctx, http.MethodGet, getnadaBase+"/public/domains", nil, &data); err != nil {
 		return "", err
 	}
 	domains := make([]string, 0, len(data.Domains))
@@ -144,8 +145,8 @@ func getnadaFlattenMessage(raw map[string]any, recipient string) map[string]any
 	return out
 }
 
-func GetnadaGenerate(domain *string, channel ...string) (*CreatedMailbox, error) {
-	selectedDomain, err := getnadaPickDomain(domain)
+func GetnadaGenerate(ctx context.Context, domain *string, channel ...string) (*CreatedMailbox, error) {
+	selectedDomain, err := getnadaPickDomain(ctx, domain)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +156,7 @@ func GetnadaGenerate(domain *string, channel ...string) (*CreatedMailbox, error)
 		return nil, err
 	}
 	var data getnadaOpenResponse
-	if err := getnadaJSON(http.MethodPost, getnadaBase+"/inbox/open", body, &data); err != nil {
+	if err := getnadaJSON(ctx, http.MethodPost, getnadaBase+"/inbox/open", body, &data); err != nil {
 		return nil, err
 	}
 	token := strings.TrimSpace(data.Token)
@@ -178,12 +179,12 @@ func GetnadaGenerate(domain *string, channel ...string) (*CreatedMailbox, error)
 	}, nil
 }
 
-func getnadaFetchDetail(token, messageID string) (map[string]any, error) {
+func getnadaFetchDetail(ctx context.Context, token, messageID string) (map[string]any, error) {
 	q := url.Values{}
 	q.Set("id", messageID)
 	q.Set("token", token)
 	var data getnadaDetailResponse
-	if err := getnadaJSON(http.MethodGet, getnadaBase+"/inbox/message?"+q.Encode(), nil, &data); err != nil {
+	if err := getnadaJSON(ctx, http.MethodGet, getnadaBase+"/inbox/message?"+q.Encode(), nil, &data); err != nil {
 		return nil, err
 	}
 	if data.Message == nil {
@@ -192,11 +193,11 @@ func getnadaFetchDetail(token, messageID string) (map[string]any, error) {
 	return data.Message, nil
 }
 
-func GetnadaGetEmails(token, email string) ([]NormEmail, error) {
+func GetnadaGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	auth := strings.TrimSpace(token)
 	address := strings.TrimSpace(email)
 	if auth == "" {
-		return nil, fmt.Errorf("getnada: empty token")
+		return nil, markError(ErrInvalidToken, "getnada: empty token")
 	}
 	if address == "" {
 		return nil, fmt.Errorf("getnada: empty email")
@@ -205,14 +206,14 @@ func GetnadaGetEmails(token, email string) ([]NormEmail, error) {
 	q := url.Values{}
 	q.Set("token", auth)
 	var data getnadaMessagesResponse
-	if err := getnadaJSON(http.MethodGet, getnadaBase+"/inbox/messages?"+q.Encode(), nil, &data); err != nil {
+	if err := getnadaJSON(ctx, http.MethodGet, getnadaBase+"/inbox/messages?"+q.Encode(), nil, &data); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(data.Messages))
 	for _, row := range data.Messages {
 		raw := row
 		if id := strings.TrimSpace(getnadaString(row["id"])); id != "" {
-			if detail, err := getnadaFetchDetail(auth, id); err == nil {
+			if detail, err := getnadaFetchDetail(ctx, auth, id); err == nil {
 				raw = detail
 			}
 		}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -47,17 +48,17 @@ type goneboxEmailMessagesResponse struct {
  * Body: {"domain":"gonebox.email"}
  * 返回邮箱地址，无需 token
  */
-func GoneboxEmailGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func GoneboxEmailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	body := `{"domain":"gonebox.email"}`
-	req, err := http.NewRequest("POST", goneboxEmailBaseURL+"/inboxes", strings.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", goneboxEmailBaseURL+"/inboxes", strings.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("gonebox-email: 创建请求失败: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	ua := getCurrentUA()
+	ua := getCurrentUA(ctx)
 	if ua != "" {
 		req.Header.Set("User-Agent", ua)
 	}
@@ -98,21 +99,21 @@ func GoneboxEmailGenerate() (*CreatedMailbox, error) {
  * API: GET /api/v1/inboxes/{address}/messages
  * 无需认证
  */
-func GoneboxEmailGetEmails(email string) ([]NormEmail, error) {
+func GoneboxEmailGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("gonebox-email: 缺少邮箱地址")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	u := fmt.Sprintf("%s/inboxes/%s/messages", goneboxEmailBaseURL, address)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, fmt.Errorf("gonebox-email: 创建获取邮件请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "application/json")
-	ua := getCurrentUA()
+	ua := getCurrentUA(ctx)
 	if ua != "" {
 		req.Header.Set("User-Agent", ua)
 	}
@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
+	"strconv"
+	"time"
 )
 
 /**
@@ -21,18 +24,18 @@ const guerrillaMailBaseURL = "https://api.guerrillamail.com/ajax.php"
 
 /*
  * GuerrillaMailGenerate 创建临时邮箱
- * API: GET ajax.php?f=get_email_address
+ * API: GET ajax.php?f=get_email_address；ctx 指定用户名时再调用 set_email_user
  */
-func GuerrillaMailGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
-	resp, err := client.Get(guerrillaMailBaseURL + "?f=get_email_address&lang=en")
+func GuerrillaMailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
+	resp, err := doGet(ctx, client, guerrillaMailBaseURL+"?f=get_email_address&lang=en")
 	if err != nil {
 		return nil, fmt.Errorf("guerrillamail generate request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("guerrillamail generate failed: %d", resp.StatusCode)
+		return nil, statusError(resp, "guerrillamail generate failed: %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -54,81 +57,296 @@ func GuerrillaMailGenerate() (*CreatedMailbox, error) {
 		return nil, fmt.Errorf("guerrillamail generate failed: missing email_addr or sid_token")
 	}
 
+	if user := localPartOr(ctx, ""); user != "" {
+		result.EmailAddr, result.SidToken, err = guerrillaSetEmailUser(ctx, guerrillaMailBaseURL, "guerrillamail", result.SidToken, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	info := &CreatedMailbox{Channel: "guerrillamail", Email: result.EmailAddr, Token: result.SidToken}
 	info.ExpiresAt = (result.EmailTimestamp + 3600) * 1000
 	return info, nil
 }
 
+/*
+ * guerrillaSetEmailUser 将会话邮箱改为指定用户名（主站与镜像共用）
+ * API: GET <baseURL>?f=set_email_user&email_user=xxx&sid_token=xxx
+ * 返回新的邮箱地址与 sid_token
+ */
+func guerrillaSetEmailUser(ctx context.Context, baseURL, channel, sidToken, user string) (string, string, error) {
+	u := baseURL + "?f=set_email_user&lang=en&email_user=" + url.QueryEscape(user) + "&sid_token=" + url.QueryEscape(sidToken)
+	resp, err := doGet(ctx, HTTPClient(ctx), u)
+	if err != nil {
+		return "", "", fmt.Errorf("%s set email user request failed: %w", channel, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return "", "", statusError(resp, "%s set email user failed: %d", channel, resp.StatusCode)
+	}
+
+	var result struct {
+		EmailAddr string `json:"email_addr"`
+		SidToken  string `json:"sid_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", "", fmt.Errorf("%s parse set email user response failed: %w", channel, err)
+	}
+	if result.EmailAddr == "" {
+		return "", "", fmt.Errorf("%s set email user failed: missing email_addr", channel)
+	}
+	if result.SidToken == "" {
+		result.SidToken = sidToken
+	}
+	return result.EmailAddr, result.SidToken, nil
+}
+
+/*
+ * guerrillaCall 调用只需确认成功的接口（del_email / forget_me），主站与镜像共用
+ */
+func guerrillaCall(ctx context.Context, baseURL, channel, fn string, params url.Values) error {
+	params.Set("f", fn)
+	resp, err := doGet(ctx, HTTPClient(ctx), baseURL+"?"+params.Encode())
+	if err != nil {
+		return fmt.Errorf("%s %s request failed: %w", channel, fn, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return statusError(resp, "%s %s failed: %d", channel, fn, resp.StatusCode)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+/*
+ * GuerrillaMailDeleteEmail 删除单封邮件
+ * API: GET ajax.php?f=del_email&email_ids[]=xxx&sid_token=xxx
+ */
+func GuerrillaMailDeleteEmail(ctx context.Context, token, id string) error {
+	return guerrillaDeleteEmail(ctx, guerrillaMailBaseURL, "guerrillamail", token, id)
+}
+
+/*
+ * GuerrillaMailForgetMe 放弃当前邮箱地址，会话不再接收该地址的邮件
+ * API: GET ajax.php?f=forget_me&email_addr=xxx&sid_token=xxx
+ */
+func GuerrillaMailForgetMe(ctx context.Context, token, email string) error {
+	return guerrillaForgetMe(ctx, guerrillaMailBaseURL, "guerrillamail", token, email)
+}
+
+func guerrillaDeleteEmail(ctx context.Context, baseURL, channel, token, id string) error {
+	return guerrillaCall(ctx, baseURL, channel, "del_email", url.Values{"email_ids[]": {id}, "sid_token": {token}})
+}
+
+func guerrillaForgetMe(ctx context.Context, baseURL, channel, token, email string) error {
+	return guerrillaCall(ctx, baseURL, channel, "forget_me", url.Values{"email_addr": {email}, "sid_token": {token}})
+}
+
+/*
+ * GuerrillaMailExtend 延长邮箱有效期（服务端固定续至当前起 60 分钟），返回新的过期时间
+ * API: GET ajax.php?f=extend&sid_token=xxx
+ */
+func GuerrillaMailExtend(ctx context.Context, token string) (time.Time, error) {
+	return guerrillaExtend(ctx, guerrillaMailBaseURL, "guerrillamail", token)
+}
+
+func guerrillaExtend(ctx context.Context, baseURL, channel, token string) (time.Time, error) {
+	u := baseURL + "?f=extend&sid_token=" + url.QueryEscape(token)
+	resp, err := doGet(ctx, HTTPClient(ctx), u)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s extend request failed: %w", channel, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return time.Time{}, statusError(resp, "%s extend failed: %d", channel, resp.StatusCode)
+	}
+
+	var result struct {
+		Expired        bool  `json:"expired"`
+		EmailTimestamp int64 `json:"email_timestamp"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return time.Time{}, fmt.Errorf("%s parse extend response failed: %w", channel, err)
+	}
+	if result.Expired {
+		return time.Time{}, markError(ErrMailboxExpired, "%s: mailbox expired", channel)
+	}
+	if result.EmailTimestamp <= 0 {
+		return time.Time{}, fmt.Errorf("%s extend failed: missing email_timestamp", channel)
+	}
+	return time.Unix(result.EmailTimestamp+3600, 0), nil
+}
+
 /*
  * GuerrillaMailGetEmails 获取邮件列表
  * API: GET ajax.php?f=check_email&seq=0&sid_token=xxx
  * 对 mail_body 为空的邮件，调用 fetch_email 获取完整正文
  */
-func GuerrillaMailGetEmails(token string, email string) ([]NormEmail, error) {
-	u := guerrillaMailBaseURL + "?f=check_email&seq=0&sid_token=" + url.QueryEscape(token)
-	client := HTTPClient()
-	resp, err := client.Get(u)
+func GuerrillaMailGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
+	items, err := guerrillaCheckEmail(ctx, guerrillaMailBaseURL, "guerrillamail", token)
 	if err != nil {
-		return nil, fmt.Errorf("guerrillamail get emails request failed: %w", err)
+		return nil, err
+	}
+	out := make([]NormEmail, 0, len(items))
+	for _, item := range items {
+		guerrillaFillBody(ctx, guerrillaMailBaseURL, token, item)
+		out = append(out, NormalizeMap(item, email))
+	}
+	return out, nil
+}
+
+/*
+ * GuerrillaMailGetEmailsSince 增量获取邮件：check_email 的 seq 参数只返回 mail_id 更大的邮件
+ * cursor 为上次返回的最大 mail_id，空串表示从头开始；返回新邮件与新的 cursor
+ */
+func GuerrillaMailGetEmailsSince(ctx context.Context, token, email, cursor string) ([]NormEmail, string, error) {
+	return guerrillaGetEmailsSince(ctx, guerrillaMailBaseURL, "guerrillamail", token, cursor, func(item map[string]interface{}) NormEmail {
+		return NormalizeMap(item, email)
+	})
+}
+
+func guerrillaGetEmailsSince(ctx context.Context, baseURL, channel, token, cursor string, norm func(map[string]interface{}) NormEmail) ([]NormEmail, string, error) {
+	seq := int64(0)
+	if cursor != "" {
+		n, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil || n < 0 {
+			return nil, "", fmt.Errorf("%s: invalid cursor %q", channel, cursor)
+		}
+		seq = n
+	}
+	items, err := guerrillaCheckEmailSeq(ctx, baseURL, channel, token, strconv.FormatInt(seq, 10))
+	if err != nil {
+		return nil, "", err
+	}
+	out := make([]NormEmail, 0, len(items))
+	next := seq
+	for _, item := range items {
+		id, err := strconv.ParseInt(guerrillaMailID(item), 10, 64)
+		if err != nil || id <= seq {
+			continue
+		}
+		next = max(next, id)
+		guerrillaFillBody(ctx, baseURL, token, item)
+		out = append(out, norm(item))
+	}
+	return out, strconv.FormatInt(next, 10), nil
+}
+
+/* GuerrillaMailListEmails 仅获取邮件摘要（check_email 的 mail_excerpt），不调用 fetch_email */
+func GuerrillaMailListEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
+	items, err := guerrillaCheckEmail(ctx, guerrillaMailBaseURL, "guerrillamail", token)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]NormEmail, 0, len(items))
+	for _, item := range items {
+		out = append(out, NormalizeMap(item, email))
+	}
+	return out, nil
+}
+
+/*
+ * GuerrillaMailGetEmail 获取单封邮件完整内容
+ * API: GET ajax.php?f=fetch_email&sid_token=xxx&email_id=xxx
+ */
+func GuerrillaMailGetEmail(ctx context.Context, token, email, id string) (NormEmail, error) {
+	item, err := guerrillaFetchEmail(ctx, guerrillaMailBaseURL, "guerrillamail", token, id)
+	if err != nil {
+		return NormEmail{}, err
+	}
+	return NormalizeMap(item, email), nil
+}
+
+/* guerrillaCheckEmail 拉取收件箱列表（check_email），仅含摘要 */
+func guerrillaCheckEmail(ctx context.Context, baseURL, channel, token string) ([]map[string]interface{}, error) {
+	return guerrillaCheckEmailSeq(ctx, baseURL, channel, token, "0")
+}
+
+/* guerrillaCheckEmailSeq 拉取 mail_id 大于 seq 的邮件摘要 */
+func guerrillaCheckEmailSeq(ctx context.Context, baseURL, channel, token, seq string) ([]map[string]interface{}, error) {
+	u := baseURL + "?f=check_email&seq=" + url.QueryEscape(seq) + "&sid_token=" + url.QueryEscape(token)
+	resp, err := doGet(ctx, HTTPClient(ctx), u)
+	if err != nil {
+		return nil, fmt.Errorf("%s get emails request failed: %w", channel, err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("guerrillamail get emails failed: %d", resp.StatusCode)
+		return nil, statusError(resp, "%s get emails failed: %d", channel, resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("guerrillamail read body failed: %w", err)
+		return nil, fmt.Errorf("%s read body failed: %w", channel, err)
 	}
 
 	var result struct {
 		List []json.RawMessage `json:"list"`
 	}
-
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("guerrillamail parse response failed: %w", err)
+		return nil, fmt.Errorf("%s parse response failed: %w", channel, err)
 	}
 
-	if len(result.List) == 0 {
-		return []NormEmail{}, nil
-	}
-
-	out := make([]NormEmail, 0, len(result.List))
+	items := make([]map[string]interface{}, 0, len(result.List))
 	for _, raw := range result.List {
 		var item map[string]interface{}
 		if err := json.Unmarshal(raw, &item); err != nil {
 			continue
 		}
+		items = append(items, item)
+	}
+	return items, nil
+}
 
-		mailBody, _ := item["mail_body"].(string)
-		mailID, _ := item["mail_id"].(string)
-		if mailID == "" {
-			// mail_id 也可能是数字
-			if v, ok := item["mail_id"].(float64); ok {
-				mailID = fmt.Sprintf("%.0f", v)
-			}
-		}
+/* guerrillaFetchEmail 拉取单封邮件（fetch_email），邮件不存在时返回 ErrEmailNotFound */
+func guerrillaFetchEmail(ctx context.Context, baseURL, channel, token, id string) (map[string]interface{}, error) {
+	u := baseURL + "?f=fetch_email&sid_token=" + url.QueryEscape(token) + "&email_id=" + url.QueryEscape(id)
+	resp, err := doGet(ctx, HTTPClient(ctx), u)
+	if err != nil {
+		return nil, fmt.Errorf("%s fetch email request failed: %w", channel, err)
+	}
+	defer resp.Body.Close()
 
-		// check_email 只返回摘要，需要调用 fetch_email 获取完整 HTML 正文
-		if mailBody == "" && mailID != "" {
-			fetchURL := guerrillaMailBaseURL + "?f=fetch_email&sid_token=" + url.QueryEscape(token) + "&email_id=" + url.QueryEscape(mailID)
-			if fetchResp, err := client.Get(fetchURL); err == nil {
-				if fetchResp.StatusCode == 200 {
-					if fetchBody, err := io.ReadAll(fetchResp.Body); err == nil {
-						var detail map[string]interface{}
-						if json.Unmarshal(fetchBody, &detail) == nil {
-							if detailBody, ok := detail["mail_body"].(string); ok && detailBody != "" {
-								item["mail_body"] = detailBody
-							}
-						}
-					}
-				}
-				fetchResp.Body.Close()
-			}
-		}
+	if resp.StatusCode != 200 {
+		return nil, statusError(resp, "%s fetch email failed: %d", channel, resp.StatusCode)
+	}
 
-		out = append(out, NormalizeMap(item, email))
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s read body failed: %w", channel, err)
 	}
-	return out, nil
+	/* 邮件不存在时服务端返回 false */
+	var detail map[string]interface{}
+	if err := json.Unmarshal(body, &detail); err != nil || detail == nil || guerrillaMailID(detail) == "" {
+		return nil, markError(ErrEmailNotFound, "%s: email %s not found", channel, id)
+	}
+	return detail, nil
+}
+
+/* guerrillaFillBody check_email 只返回摘要，对 mail_body 为空的邮件调用 fetch_email 补全 HTML 正文，失败时保留摘要 */
+func guerrillaFillBody(ctx context.Context, baseURL, token string, item map[string]interface{}) {
+	mailBody, _ := item["mail_body"].(string)
+	mailID := guerrillaMailID(item)
+	if mailBody != "" || mailID == "" {
+		return
+	}
+	detail, err := guerrillaFetchEmail(ctx, baseURL, "guerrillamail", token, mailID)
+	if err != nil {
+		return
+	}
+	if detailBody, ok := detail["mail_body"].(string); ok && detailBody != "" {
+		item["mail_body"] = detailBody
+	}
+}
+
+/* guerrillaMailID 读取 mail_id（可能为字符串或数字） */
+func guerrillaMailID(item map[string]interface{}) string {
+	if id, ok := item["mail_id"].(string); ok {
+		return id
+	}
+	if v, ok := item["mail_id"].(float64); ok {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return ""
 }
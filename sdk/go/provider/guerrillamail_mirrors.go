@@ -1,12 +1,13 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/url"
 	"regexp"
 	"strings"
+	"time"
 )
 
 /**
@@ -16,18 +17,18 @@ import (
 
 /*
  * GuerrillamailMirrorGenerate 创建临时邮箱（镜像渠道）
- * API: GET <baseURL>?f=get_email_address
+ * API: GET <baseURL>?f=get_email_address；ctx 指定用户名时再调用 set_email_user
  */
-func GuerrillamailMirrorGenerate(channel string, baseURL string) (*CreatedMailbox, error) {
-	client := HTTPClient()
-	resp, err := client.Get(baseURL + "?f=get_email_address&lang=en")
+func GuerrillamailMirrorGenerate(ctx context.Context, channel string, baseURL string) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
+	resp, err := doGet(ctx, client, baseURL+"?f=get_email_address&lang=en")
 	if err != nil {
 		return nil, fmt.Errorf("%s generate request failed: %w", channel, err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("%s generate failed: %d", channel, resp.StatusCode)
+		return nil, statusError(resp, "%s generate failed: %d", channel, resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -49,6 +50,13 @@ func GuerrillamailMirrorGenerate(channel string, baseURL string) (*CreatedMailbo
 		return nil, fmt.Errorf("%s generate failed: missing email_addr or sid_token", channel)
 	}
 
+	if user := localPartOr(ctx, ""); user != "" {
+		result.EmailAddr, result.SidToken, err = guerrillaSetEmailUser(ctx, baseURL, channel, result.SidToken, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	info := &CreatedMailbox{Channel: channel, Email: result.EmailAddr, Token: result.SidToken}
 	info.ExpiresAt = (result.EmailTimestamp + 3600) * 1000
 	return info, nil
@@ -58,74 +66,48 @@ func GuerrillamailMirrorGenerate(channel string, baseURL string) (*CreatedMailbo
  * GuerrillamailMirrorGetEmails 获取邮件列表（镜像渠道）
  * API: GET <baseURL>?f=check_email&seq=0&sid_token=xxx
  */
-func GuerrillamailMirrorGetEmails(baseURL string, token string, email string) ([]NormEmail, error) {
-	u := baseURL + "?f=check_email&seq=0&sid_token=" + url.QueryEscape(token)
-	client := HTTPClient()
-	resp, err := client.Get(u)
+func GuerrillamailMirrorGetEmails(ctx context.Context, baseURL string, token string, email string) ([]NormEmail, error) {
+	items, err := guerrillaCheckEmail(ctx, baseURL, "guerrillamail mirror", token)
 	if err != nil {
-		return nil, fmt.Errorf("guerrillamail mirror get emails request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("guerrillamail mirror get emails failed: %d", resp.StatusCode)
+		return nil, err
 	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("guerrillamail mirror read body failed: %w", err)
-	}
-
-	var result struct {
-		List []json.RawMessage `json:"list"`
+	out := make([]NormEmail, 0, len(items))
+	for _, item := range items {
+		guerrillaFillBody(ctx, baseURL, token, item)
+		out = append(out, NormalizeMap(guerrillamailMirrorFlatten(item, email), email))
 	}
+	return out, nil
+}
 
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("guerrillamail mirror parse response failed: %w", err)
-	}
+/* GuerrillamailMirrorGetEmailsSince 增量获取邮件（镜像渠道），见 GuerrillaMailGetEmailsSince */
+func GuerrillamailMirrorGetEmailsSince(ctx context.Context, baseURL, token, email, cursor string) ([]NormEmail, string, error) {
+	return guerrillaGetEmailsSince(ctx, baseURL, "guerrillamail mirror", token, cursor, func(item map[string]interface{}) NormEmail {
+		return NormalizeMap(guerrillamailMirrorFlatten(item, email), email)
+	})
+}
 
-	if len(result.List) == 0 {
-		return []NormEmail{}, nil
+/* GuerrillamailMirrorListEmails 仅获取邮件摘要（镜像渠道），见 GuerrillaMailListEmails */
+func GuerrillamailMirrorListEmails(ctx context.Context, baseURL string, token string, email string) ([]NormEmail, error) {
+	items, err := guerrillaCheckEmail(ctx, baseURL, "guerrillamail mirror", token)
+	if err != nil {
+		return nil, err
 	}
-
-	out := make([]NormEmail, 0, len(result.List))
-	for _, raw := range result.List {
-		var item map[string]interface{}
-		if err := json.Unmarshal(raw, &item); err != nil {
-			continue
-		}
-
-		mailBody, _ := item["mail_body"].(string)
-		mailID, _ := item["mail_id"].(string)
-		if mailID == "" {
-			if v, ok := item["mail_id"].(float64); ok {
-				mailID = fmt.Sprintf("%.0f", v)
-			}
-		}
-
-		// check_email 只返回摘要，需要调用 fetch_email 获取完整 HTML 正文
-		if mailBody == "" && mailID != "" {
-			fetchURL := baseURL + "?f=fetch_email&sid_token=" + url.QueryEscape(token) + "&email_id=" + url.QueryEscape(mailID)
-			if fetchResp, err := client.Get(fetchURL); err == nil {
-				if fetchResp.StatusCode == 200 {
-					if fetchBody, err := io.ReadAll(fetchResp.Body); err == nil {
-						var detail map[string]interface{}
-						if json.Unmarshal(fetchBody, &detail) == nil {
-							if detailBody, ok := detail["mail_body"].(string); ok && detailBody != "" {
-								item["mail_body"] = detailBody
-							}
-						}
-					}
-				}
-				fetchResp.Body.Close()
-			}
-		}
-
+	out := make([]NormEmail, 0, len(items))
+	for _, item := range items {
 		out = append(out, NormalizeMap(guerrillamailMirrorFlatten(item, email), email))
 	}
 	return out, nil
 }
 
+/* GuerrillamailMirrorGetEmail 获取单封邮件完整内容（镜像渠道），见 GuerrillaMailGetEmail */
+func GuerrillamailMirrorGetEmail(ctx context.Context, channel, baseURL, token, email, id string) (NormEmail, error) {
+	item, err := guerrillaFetchEmail(ctx, baseURL, channel, token, id)
+	if err != nil {
+		return NormEmail{}, err
+	}
+	return NormalizeMap(guerrillamailMirrorFlatten(item, email), email), nil
+}
+
 var guerrillamailMirrorTagRe = regexp.MustCompile(`<[^>]+>`)
 
 func guerrillamailMirrorFlatten(item map[string]interface{}, email string) map[string]interface{} {
@@ -146,3 +128,18 @@ func guerrillamailMirrorFlatten(item map[string]interface{}, email string) map[s
 		"isRead":  item["mail_read"],
 	}
 }
+
+/* GuerrillamailMirrorDeleteEmail 删除单封邮件（镜像渠道），见 GuerrillaMailDeleteEmail */
+func GuerrillamailMirrorDeleteEmail(ctx context.Context, channel, baseURL, token, id string) error {
+	return guerrillaDeleteEmail(ctx, baseURL, channel, token, id)
+}
+
+/* GuerrillamailMirrorForgetMe 放弃当前邮箱地址（镜像渠道），见 GuerrillaMailForgetMe */
+func GuerrillamailMirrorForgetMe(ctx context.Context, channel, baseURL, token, email string) error {
+	return guerrillaForgetMe(ctx, baseURL, channel, token, email)
+}
+
+/* GuerrillamailMirrorExtend 延长邮箱有效期（镜像渠道），见 GuerrillaMailExtend */
+func GuerrillamailMirrorExtend(ctx context.Context, channel, baseURL, token string) (time.Time, error) {
+	return guerrillaExtend(ctx, baseURL, channel, token)
+}
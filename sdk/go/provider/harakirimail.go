@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -30,19 +31,19 @@ func harakirimailRandomName() string {
 }
 
 /* HarakirimailGenerate 创建 harakirimail 临时邮箱 */
-func HarakirimailGenerate() (*CreatedMailbox, error) {
-	name := harakirimailRandomName()
+func HarakirimailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	name := localPartOr(ctx, harakirimailRandomName())
 	email := fmt.Sprintf("%s@harakirimail.com", name)
 
 	/* 可选：调用收件箱接口验证地址可用 */
 	u := fmt.Sprintf("%s/api/v1/inbox/%s", harakirimailBase, name)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	harakirimailDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -50,14 +51,14 @@ func HarakirimailGenerate() (*CreatedMailbox, error) {
 	io.ReadAll(resp.Body)
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("harakirimail: 验证收件箱失败 %d", resp.StatusCode)
+		return nil, statusError(resp, "harakirimail: 验证收件箱失败 %d", resp.StatusCode)
 	}
 
 	return &CreatedMailbox{Channel: "harakirimail", Email: email, Token: ""}, nil
 }
 
 /* HarakirimailGetEmails 获取 harakirimail 邮件列表 */
-func HarakirimailGetEmails(email string) ([]NormEmail, error) {
+func HarakirimailGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("harakirimail: 邮箱地址为空")
@@ -71,13 +72,13 @@ func HarakirimailGetEmails(email string) ([]NormEmail, error) {
 
 	/* 获取收件箱列表 */
 	u := fmt.Sprintf("%s/api/v1/inbox/%s", harakirimailBase, name)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	harakirimailDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +89,7 @@ func HarakirimailGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("harakirimail inbox: %d", resp.StatusCode)
+		return nil, statusError(resp, "harakirimail inbox: %d", resp.StatusCode)
 	}
 
 	var inboxResp struct {
@@ -106,7 +107,7 @@ func HarakirimailGetEmails(email string) ([]NormEmail, error) {
 	emails := make([]NormEmail, 0, len(inboxResp.Emails))
 	for _, raw := range inboxResp.Emails {
 		/* 获取单封邮件详情以拿到正文 */
-		htmlBody, textBody := harakirimailFetchBody(raw.ID)
+		htmlBody, textBody := harakirimailFetchBody(ctx, raw.ID)
 
 		flat := map[string]interface{}{
 			"id":      raw.ID,
@@ -124,18 +125,18 @@ func HarakirimailGetEmails(email string) ([]NormEmail, error) {
 }
 
 /* harakirimailFetchBody 获取单封邮件正文 */
-func harakirimailFetchBody(id string) (string, string) {
+func harakirimailFetchBody(ctx context.Context, id string) (string, string) {
 	if id == "" {
 		return "", ""
 	}
 	u := fmt.Sprintf("%s/api/v1/email/%s", harakirimailBase, id)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return "", ""
 	}
 	harakirimailDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return "", ""
 	}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -91,16 +92,16 @@ func haribuExtractBodyHTML(page string) string {
 }
 
 /* haribuHTTPClient 获取 haribu 专用 HTTP 客户端（无 cookie jar，手动管理 cookie） */
-func haribuHTTPClient() tls_client.HttpClient {
+func haribuHTTPClient(ctx context.Context) tls_client.HttpClient {
 	if HTTPClientNoCookieJar != nil {
-		return HTTPClientNoCookieJar()
+		return HTTPClientNoCookieJar(ctx)
 	}
-	return HTTPClient()
+	return HTTPClient(ctx)
 }
 
 /* haribuSetHeaders 设置 haribu 请求的通用 HTTP 头 */
 func haribuSetHeaders(req *http.Request, referer string) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("Cache-Control", "no-cache")
@@ -211,11 +212,11 @@ func haribuStripTags(s string) string {
  * HaribuGenerate 创建 haribu 临时邮箱
  * 流程：GET haribu.net → 获取 session cookie → 从 HTML 提取邮箱地址
  */
-func HaribuGenerate() (*CreatedMailbox, error) {
-	client := haribuHTTPClient()
+func HaribuGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := haribuHTTPClient(ctx)
 
 	/* 第一步：GET 首页获取 session cookie 和邮箱地址 */
-	req, err := http.NewRequest("GET", haribuBase, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", haribuBase, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -260,16 +261,16 @@ func HaribuGenerate() (*CreatedMailbox, error) {
  * HaribuGetEmails 获取 haribu 邮件列表
  * 流程：先调用 api-kontrol 检查新邮件 → GET 首页解析邮件列表 → 提取各封邮件详情
  */
-func HaribuGetEmails(email, token string) ([]NormEmail, error) {
+func HaribuGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	sess, err := haribuDecodeSess(token)
 	if err != nil {
 		return nil, err
 	}
-	client := haribuHTTPClient()
+	client := haribuHTTPClient(ctx)
 
 	/* 调用 kontrol API 触发新邮件检查 */
 	kontrolURL := haribuBase + "/en/api-kontrol/"
-	reqK, err := http.NewRequest("GET", kontrolURL, nil)
+	reqK, err := http.NewRequestWithContext(ctx, "GET", kontrolURL, nil)
 	if err == nil {
 		haribuSetHeaders(reqK, haribuBase)
 		reqK.Header.Set("Cookie", sess.CookieHdr)
@@ -282,7 +283,7 @@ func HaribuGetEmails(email, token string) ([]NormEmail, error) {
 	}
 
 	/* GET 首页获取收件箱页面 */
-	req, err := http.NewRequest("GET", haribuBase, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", haribuBase, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -340,7 +341,7 @@ func HaribuGetEmails(email, token string) ([]NormEmail, error) {
 			if !strings.HasPrefix(detailURL, "http") {
 				detailURL = haribuBase + "/" + strings.TrimPrefix(detailURL, "/")
 			}
-			htmlBody := haribuFetchDetail(client, detailURL, sess.CookieHdr)
+			htmlBody := haribuFetchDetail(ctx, client, detailURL, sess.CookieHdr)
 			if htmlBody != "" {
 				raw["html"] = htmlBody
 			}
@@ -352,8 +353,8 @@ func HaribuGetEmails(email, token string) ([]NormEmail, error) {
 }
 
 /* haribuFetchDetail 获取单封邮件的详情页正文 */
-func haribuFetchDetail(client tls_client.HttpClient, detailURL, cookieHdr string) string {
-	req, err := http.NewRequest("GET", detailURL, nil)
+func haribuFetchDetail(ctx context.Context, client tls_client.HttpClient, detailURL, cookieHdr string) string {
+	req, err := http.NewRequestWithContext(ctx, "GET", detailURL, nil)
 	if err != nil {
 		return ""
 	}
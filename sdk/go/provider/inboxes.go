@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -21,13 +22,13 @@ func inboxesHeaders(req *http.Request) {
 	req.Header.Set("User-Agent", "Mozilla/5.0")
 }
 
-func inboxesJSON(u string, out any) error {
-	req, err := http.NewRequest(http.MethodGet, u, nil)
+func inboxesJSON(ctx context.Context, u string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return err
 	}
 	inboxesHeaders(req)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -37,7 +38,7 @@ func inboxesJSON(u string, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("inboxes http %d", resp.StatusCode)
+		return statusError(resp, "inboxes http %d", resp.StatusCode)
 	}
 	return json.Unmarshal(body, out)
 }
@@ -52,13 +53,13 @@ func inboxesRandomLocal() string {
 	return string(b)
 }
 
-func inboxesGetDomains() ([]string, error) {
+func inboxesGetDomains(ctx context.Context) ([]string, error) {
 	var data struct {
 		Domains []struct {
 			QDN string `json:"qdn"`
 		} `json:"domains"`
 	}
-	if err := inboxesJSON(inboxesBase+"/domain", &data); err != nil {
+	if err := inboxesJSON(ctx, inboxesBase+"/domain", &data); err != nil {
 		return nil, err
 	}
 	domains := make([]string, 0, len(data.Domains))
@@ -92,8 +93,8 @@ func inboxesPickDomain(domains []string, preferred *string) string {
 	return domains[0]
 }
 
-func InboxesGenerate(domain *string) (*CreatedMailbox, error) {
-	domains, err := inboxesGetDomains()
+func InboxesGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
+	domains, err := inboxesGetDomains(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -101,10 +102,10 @@ func InboxesGenerate(domain *string) (*CreatedMailbox, error) {
 	return &CreatedMailbox{Channel: "inboxes", Email: email}, nil
 }
 
-func inboxesFetchDetail(uid string) (map[string]any, error) {
+func inboxesFetchDetail(ctx context.Context, uid string) (map[string]any, error) {
 	var raw map[string]any
 	u := inboxesBase + "/message/" + url.PathEscape(uid)
-	if err := inboxesJSON(u, &raw); err != nil {
+	if err := inboxesJSON(ctx, u, &raw); err != nil {
 		return nil, err
 	}
 	return raw, nil
@@ -138,7 +139,7 @@ func inboxesFirstNonEmpty(value any, fallback string) any {
 	return fallback
 }
 
-func InboxesGetEmails(email string) ([]NormEmail, error) {
+func InboxesGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("inboxes: empty email")
@@ -147,14 +148,14 @@ func InboxesGetEmails(email string) ([]NormEmail, error) {
 	var data struct {
 		Messages []map[string]any `json:"msgs"`
 	}
-	if err := inboxesJSON(u, &data); err != nil {
+	if err := inboxesJSON(ctx, u, &data); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(data.Messages))
 	for _, row := range data.Messages {
 		raw := row
 		if uid := strings.TrimSpace(fmt.Sprint(row["uid"])); uid != "" {
-			if detail, err := inboxesFetchDetail(uid); err == nil {
+			if detail, err := inboxesFetchDetail(ctx, uid); err == nil {
 				raw = detail
 			}
 		}
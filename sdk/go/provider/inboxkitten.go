@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -50,13 +51,13 @@ func inboxkittenLocal() string {
 	return b.String()
 }
 
-func inboxkittenGet(u string, accept string) ([]byte, error) {
-	req, err := http.NewRequest("GET", u, nil)
+func inboxkittenGet(ctx context.Context, u string, accept string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", accept)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +67,7 @@ func inboxkittenGet(u string, accept string) ([]byte, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("inboxkitten: http %d", resp.StatusCode)
+		return nil, statusError(resp, "inboxkitten: http %d", resp.StatusCode)
 	}
 	return body, nil
 }
@@ -92,7 +93,7 @@ func InboxkittenGenerate() (*CreatedMailbox, error) {
 	return &CreatedMailbox{Channel: "inboxkitten", Email: local + "@" + inboxkittenDomain}, nil
 }
 
-func inboxkittenFetchDetail(row inboxkittenListItem, recipient string) map[string]any {
+func inboxkittenFetchDetail(ctx context.Context, row inboxkittenListItem, recipient string) map[string]any {
 	raw := map[string]any{
 		"id":        row.Storage.Key,
 		"messageId": row.Storage.Key,
@@ -116,8 +117,8 @@ func inboxkittenFetchDetail(row inboxkittenListItem, recipient string) map[strin
 	q := url.Values{}
 	q.Set("region", row.Storage.Region)
 	q.Set("key", row.Storage.Key)
-	metaBody, metaErr := inboxkittenGet(inboxkittenBase+"/getKey?"+q.Encode(), "application/json")
-	htmlBody, htmlErr := inboxkittenGet(inboxkittenBase+"/getHtml?"+q.Encode(), "text/html,*/*")
+	metaBody, metaErr := inboxkittenGet(ctx, inboxkittenBase+"/getKey?"+q.Encode(), "application/json")
+	htmlBody, htmlErr := inboxkittenGet(ctx, inboxkittenBase+"/getHtml?"+q.Encode(), "text/html,*/*")
 	if metaErr == nil {
 		var meta inboxkittenMeta
 		if json.Unmarshal(metaBody, &meta) == nil {
@@ -140,7 +141,7 @@ func inboxkittenFetchDetail(row inboxkittenListItem, recipient string) map[strin
 	return raw
 }
 
-func InboxkittenGetEmails(email string) ([]NormEmail, error) {
+func InboxkittenGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	parts := strings.Split(strings.TrimSpace(email), "@")
 	local := parts[0]
 	if local == "" {
@@ -149,7 +150,7 @@ func InboxkittenGetEmails(email string) ([]NormEmail, error) {
 
 	q := url.Values{}
 	q.Set("recipient", local)
-	body, err := inboxkittenGet(inboxkittenBase+"/list?"+q.Encode(), "application/json")
+	body, err := inboxkittenGet(ctx, inboxkittenBase+"/list?"+q.Encode(), "application/json")
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +160,7 @@ func InboxkittenGetEmails(email string) ([]NormEmail, error) {
 	}
 	out := make([]NormEmail, 0, len(rows))
 	for _, row := range rows {
-		out = append(out, NormalizeMap(inboxkittenFetchDetail(row, email), email))
+		out = append(out, NormalizeMap(inboxkittenFetchDetail(ctx, row, email), email))
 	}
 	return out, nil
 }
@@ -1,6 +1,10 @@
 package provider
 
-func JFairuseOrgGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "j-fairuse-org", Email: randomStr(12) + "@j.fairuse.org"}, nil
+import "context"
+
+func JFairuseOrgGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "j-fairuse-org", Email: localPartOr(ctx, randomStr(12)) + "@j.fairuse.org"}, nil
+}
+func JFairuseOrgGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func JFairuseOrgGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
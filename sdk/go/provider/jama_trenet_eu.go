@@ -1,15 +1,17 @@
 package provider
 
+import "context"
+
 // jama.trenet.eu：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
-func JamaTrenetEuGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func JamaTrenetEuGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "jama-trenet-eu",
 		Email:   local + "@jama.trenet.eu",
 	}, nil
 }
 
-func JamaTrenetEuGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func JamaTrenetEuGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
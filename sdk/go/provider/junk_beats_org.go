@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // junk.beats.org：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // JunkBeatsOrgGenerate 创建 junk.beats.org 临时邮箱
-func JunkBeatsOrgGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func JunkBeatsOrgGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "junk-beats-org",
 		Email:   local + "@junk.beats.org",
@@ -12,6 +14,6 @@ func JunkBeatsOrgGenerate() (*CreatedMailbox, error) {
 }
 
 // JunkBeatsOrgGetEmails 读取邮件（复用 mailinator public API）
-func JunkBeatsOrgGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func JunkBeatsOrgGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
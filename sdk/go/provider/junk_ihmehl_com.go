@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // junk.ihmehl.com：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // JunkIhmehlComGenerate 创建 junk.ihmehl.com 临时邮箱
-func JunkIhmehlComGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func JunkIhmehlComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "junk-ihmehl-com",
 		Email:   local + "@junk.ihmehl.com",
@@ -12,6 +14,6 @@ func JunkIhmehlComGenerate() (*CreatedMailbox, error) {
 }
 
 // JunkIhmehlComGetEmails 读取邮件（复用 mailinator public API）
-func JunkIhmehlComGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func JunkIhmehlComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // junk.vanillasystem.com：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // JunkVanillasystemComGenerate 创建 junk.vanillasystem.com 临时邮箱
-func JunkVanillasystemComGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func JunkVanillasystemComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "junk-vanillasystem-com",
 		Email:   local + "@junk.vanillasystem.com",
@@ -12,6 +14,6 @@ func JunkVanillasystemComGenerate() (*CreatedMailbox, error) {
 }
 
 // JunkVanillasystemComGetEmails 读取邮件（复用 mailinator public API）
-func JunkVanillasystemComGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func JunkVanillasystemComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,8 +1,12 @@
 package provider
 
-func LayuemingPicsGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func LayuemingPicsGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@layueming.pics"
 	return &CreatedMailbox{Channel: "layueming-pics", Email: email, Token: email}, nil
 }
-func LayuemingPicsGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func LayuemingPicsGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"html"
 	"io"
@@ -32,7 +33,7 @@ func setLinshiyouHeaders(req *http.Request) {
 	for k, v := range linshiyouHeaders {
 		req.Header.Set(k, v)
 	}
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(req.Context()))
 }
 
 var (
@@ -149,14 +150,14 @@ func linshiyouParseMailSegments(raw, recipientEmail string) []NormEmail {
 	return out
 }
 
-func LinshiyouGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest("GET", linshiyouOrigin+"/api/user?user", nil)
+func LinshiyouGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", linshiyouOrigin+"/api/user?user", nil)
 	if err != nil {
 		return nil, err
 	}
 	setLinshiyouHeaders(req)
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -192,8 +193,8 @@ func LinshiyouGenerate() (*CreatedMailbox, error) {
 	return &CreatedMailbox{Channel: "linshiyou", Email: email, Token: cookieTok}, nil
 }
 
-func LinshiyouGetEmails(token string, email string) ([]NormEmail, error) {
-	req, err := http.NewRequest("GET", linshiyouOrigin+"/api/mail?unseen=1", nil)
+func LinshiyouGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", linshiyouOrigin+"/api/mail?unseen=1", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +202,7 @@ func LinshiyouGetEmails(token string, email string) ([]NormEmail, error) {
 	req.Header.Set("Cookie", token)
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
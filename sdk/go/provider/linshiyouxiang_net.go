@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -31,7 +32,7 @@ func linshiyouxiangNetHeaders(req *http.Request) {
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("Referer", linshiyouxiangNetBase+"/")
 	req.Header.Set("Origin", linshiyouxiangNetBase)
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 }
 
 /*
@@ -41,16 +42,16 @@ func linshiyouxiangNetHeaders(req *http.Request) {
  *   2. 从 HTML 正则提取 tempMailGlobal（邮箱）和 mailCodeGlobal（校验 code）
  *   3. 邮箱来自 tempMailGlobal，token 存储 mailCodeGlobal
  */
-func LinshiyouxiangNetGenerate(channel ...string) (*CreatedMailbox, error) {
-	req, err := http.NewRequest("GET", linshiyouxiangNetBase+"/", nil)
+func LinshiyouxiangNetGenerate(ctx context.Context, channel ...string) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", linshiyouxiangNetBase+"/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("linshiyouxiang-net: 创建请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("linshiyouxiang-net: 请求首页失败: %w", err)
 	}
@@ -102,7 +103,7 @@ func LinshiyouxiangNetGenerate(channel ...string) (*CreatedMailbox, error) {
  *   2. 解析响应 {"emails":null|[...],"success":true}
  *   3. 将邮件列表归一化
  */
-func LinshiyouxiangNetGetEmails(email, token string) ([]NormEmail, error) {
+func LinshiyouxiangNetGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("linshiyouxiang-net: 邮箱地址为空")
@@ -117,7 +118,7 @@ func LinshiyouxiangNetGetEmails(email, token string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("linshiyouxiang-net: 序列化请求体失败: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", linshiyouxiangNetBase+"/get-messages", strings.NewReader(string(reqBody)))
+	req, err := http.NewRequestWithContext(ctx, "POST", linshiyouxiangNetBase+"/get-messages", strings.NewReader(string(reqBody)))
 	if err != nil {
 		return nil, fmt.Errorf("linshiyouxiang-net: 创建请求失败: %w", err)
 	}
@@ -125,7 +126,7 @@ func LinshiyouxiangNetGetEmails(email, token string) ([]NormEmail, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("linshiyouxiang-net: 请求获取邮件失败: %w", err)
 	}
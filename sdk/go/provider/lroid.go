@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"regexp"
@@ -75,14 +76,14 @@ func lroidExtractEmail(html string) string {
  * LroidGenerate 创建 lroid.com 临时邮箱
  * 流程: GET https://lroid.com → 从 HTML 中提取自动分配的邮箱地址，保存 session cookies
  */
-func LroidGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest("GET", lroidBase, nil)
+func LroidGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", lroidBase, nil)
 	if err != nil {
 		return nil, err
 	}
 	lroidBrowserHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +97,7 @@ func LroidGenerate() (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("lroid: 首页请求失败 %d", resp.StatusCode)
+		return nil, statusError(resp, "lroid: 首页请求失败 %d", resp.StatusCode)
 	}
 
 	html := string(raw)
@@ -118,7 +119,7 @@ func LroidGenerate() (*CreatedMailbox, error) {
  * 使用 session cookies 重新访问首页，解析邮件列表
  * token: session cookies 字符串
  */
-func LroidGetEmails(token string, email string) ([]NormEmail, error) {
+func LroidGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("lroid: 邮箱地址为空")
@@ -128,14 +129,14 @@ func LroidGetEmails(token string, email string) ([]NormEmail, error) {
 	}
 
 	/* 携带 session cookies 访问首页获取邮件列表 */
-	req, err := http.NewRequest("GET", lroidBase, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", lroidBase, nil)
 	if err != nil {
 		return nil, err
 	}
 	lroidBrowserHeaders(req)
 	req.Header.Set("Cookie", token)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +147,7 @@ func LroidGetEmails(token string, email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("lroid: 获取邮件列表失败 %d", resp.StatusCode)
+		return nil, statusError(resp, "lroid: 获取邮件列表失败 %d", resp.StatusCode)
 	}
 
 	html := string(raw)
@@ -189,7 +190,7 @@ func LroidGetEmails(token string, email string) ([]NormEmail, error) {
 		}
 
 		/* 尝试获取邮件正文 */
-		htmlBody, textBody := lroidFetchMailBody(token, mailID)
+		htmlBody, textBody := lroidFetchMailBody(ctx, token, mailID)
 
 		flat := map[string]interface{}{
 			"id":      mailID,
@@ -207,7 +208,7 @@ func LroidGetEmails(token string, email string) ([]NormEmail, error) {
 }
 
 /* lroidFetchMailBody 获取单封邮件正文内容 */
-func lroidFetchMailBody(cookie string, mailID string) (string, string) {
+func lroidFetchMailBody(ctx context.Context, cookie string, mailID string) (string, string) {
 	if mailID == "" || cookie == "" {
 		return "", ""
 	}
@@ -216,14 +217,14 @@ func lroidFetchMailBody(cookie string, mailID string) (string, string) {
 	mailID = strings.TrimLeft(mailID, "/")
 
 	u := fmt.Sprintf("%s/%s", lroidBase, mailID)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return "", ""
 	}
 	lroidBrowserHeaders(req)
 	req.Header.Set("Cookie", cookie)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return "", ""
 	}
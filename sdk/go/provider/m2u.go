@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -32,12 +33,12 @@ type m2uDetailResponse struct {
 	Message map[string]any `json:"message"`
 }
 
-func m2uJSON(method, u string, body []byte, out any) error {
+func m2uJSON(ctx context.Context, method, u string, body []byte, out any) error {
 	var reader io.Reader
 	if body != nil {
 		reader = bytes.NewReader(body)
 	}
-	req, err := http.NewRequest(method, u, reader)
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
 	if err != nil {
 		return err
 	}
@@ -47,7 +48,7 @@ func m2uJSON(method, u string, body []byte, out any) error {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -58,7 +59,7 @@ func m2uJSON(method, u string, body []byte, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("m2u: http %d", resp.StatusCode)
+		return statusError(resp, "m2u: http %d", resp.StatusCode)
 	}
 	if out == nil {
 		return nil
@@ -144,10 +145,10 @@ func m2uFlattenMessage(raw map[string]any, recipient string) map[string]any {
 	}
 }
 
-func m2uFetchDetail(token, viewToken, messageID string) map[string]any {
+func m2uFetchDetail(ctx context.Context, token, viewToken, messageID string) map[string]any {
 	u := fmt.Sprintf("%s/v1/mailboxes/%s/messages/%s?view=%s", m2uBase, url.PathEscape(token), url.PathEscape(messageID), url.QueryEscape(viewToken))
 	var data m2uDetailResponse
-	if err := m2uJSON(http.MethodGet, u, nil, &data); err != nil {
+	if err := m2uJSON(ctx, http.MethodGet, u, nil, &data); err != nil {
 		return nil
 	}
 	if data.Message == nil {
@@ -156,9 +157,9 @@ func m2uFetchDetail(token, viewToken, messageID string) map[string]any {
 	return data.Message
 }
 
-func M2uGenerate() (*CreatedMailbox, error) {
+func M2uGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	var data m2uMailboxResponse
-	if err := m2uJSON(http.MethodPost, m2uBase+"/v1/mailboxes/auto", []byte("{}"), &data); err != nil {
+	if err := m2uJSON(ctx, http.MethodPost, m2uBase+"/v1/mailboxes/auto", []byte("{}"), &data); err != nil {
 		return nil, err
 	}
 	localPart := strings.TrimSpace(data.Mailbox.LocalPart)
@@ -181,14 +182,14 @@ func M2uGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func M2uGetEmails(token, email string) ([]NormEmail, error) {
+func M2uGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	mailboxToken, viewToken := m2uUnpackToken(token)
 	address := strings.TrimSpace(email)
 	if mailboxToken == "" {
-		return nil, fmt.Errorf("m2u: missing token")
+		return nil, markError(ErrInvalidToken, "m2u: missing token")
 	}
 	if viewToken == "" {
-		return nil, fmt.Errorf("m2u: missing view token")
+		return nil, markError(ErrInvalidToken, "m2u: missing view token")
 	}
 	if address == "" {
 		return nil, fmt.Errorf("m2u: empty email")
@@ -196,14 +197,14 @@ func M2uGetEmails(token, email string) ([]NormEmail, error) {
 
 	u := fmt.Sprintf("%s/v1/mailboxes/%s/messages?view=%s", m2uBase, url.PathEscape(mailboxToken), url.QueryEscape(viewToken))
 	var data m2uListResponse
-	if err := m2uJSON(http.MethodGet, u, nil, &data); err != nil {
+	if err := m2uJSON(ctx, http.MethodGet, u, nil, &data); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(data.Messages))
 	for _, row := range data.Messages {
 		raw := row
 		if id := m2uAnyString(row["id"]); id != "" {
-			if detail := m2uFetchDetail(mailboxToken, viewToken, id); detail != nil {
+			if detail := m2uFetchDetail(ctx, mailboxToken, viewToken, id); detail != nil {
 				raw = detail
 			}
 		}
@@ -1,6 +1,10 @@
 package provider
 
-func M8rMcasalComGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "m8r-mcasal-com", Email: randomStr(12) + "@m8r.mcasal.com"}, nil
+import "context"
+
+func M8rMcasalComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "m8r-mcasal-com", Email: localPartOr(ctx, randomStr(12)) + "@m8r.mcasal.com"}, nil
+}
+func M8rMcasalComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func M8rMcasalComGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
@@ -1,6 +1,10 @@
 package provider
 
-func M887AtGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "m-887-at", Email: randomStr(12) + "@m.887.at"}, nil
+import "context"
+
+func M887AtGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "m-887-at", Email: localPartOr(ctx, randomStr(12)) + "@m.887.at"}, nil
+}
+func M887AtGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func M887AtGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
@@ -1,15 +1,17 @@
 package provider
 
+import "context"
+
 // m.nik.me：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
-func MNikMeGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func MNikMeGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "m-nik-me",
 		Email:   local + "@m.nik.me",
 	}, nil
 }
 
-func MNikMeGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func MNikMeGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
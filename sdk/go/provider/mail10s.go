@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -23,8 +24,8 @@ func mail10sRandomLocal() string {
 	return string(b)
 }
 
-func Mail10sGenerate() (*CreatedMailbox, error) {
-	email := mail10sRandomLocal() + "@mail10s.com"
+func Mail10sGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	email := localPartOr(ctx, mail10sRandomLocal()) + "@mail10s.com"
 	return &CreatedMailbox{
 		Channel: "mail10s",
 		Email:   email,
@@ -45,19 +46,19 @@ func mail10sFlatten(raw map[string]any, recipient string) map[string]any {
 	}
 }
 
-func Mail10sGetEmails(email string) ([]NormEmail, error) {
+func Mail10sGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("mail10s: empty email")
 	}
 	u := fmt.Sprintf("%s/api/emails/%s/inbox", mail10sBase, url.PathEscape(address))
-	req, err := http.NewRequest(http.MethodGet, u, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "Mozilla/5.0")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +68,7 @@ func Mail10sGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mail10s: http %d", resp.StatusCode)
+		return nil, statusError(resp, "mail10s: http %d", resp.StatusCode)
 	}
 	var data struct {
 		Data struct {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -26,14 +27,14 @@ type mail123DetailResponse struct {
 	Message map[string]any `json:"message"`
 }
 
-func mail123JSON(u string, out any) error {
-	req, err := http.NewRequest(http.MethodGet, u, nil)
+func mail123JSON(ctx context.Context, u string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "Mozilla/5.0")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -43,7 +44,7 @@ func mail123JSON(u string, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("mail123: http %d", resp.StatusCode)
+		return statusError(resp, "mail123: http %d", resp.StatusCode)
 	}
 	return json.Unmarshal(body, out)
 }
@@ -68,9 +69,9 @@ func mail123Flatten(raw map[string]any, recipient string) map[string]any {
 	return out
 }
 
-func Mail123Generate() (*CreatedMailbox, error) {
+func Mail123Generate(ctx context.Context) (*CreatedMailbox, error) {
 	var data mail123MailboxResponse
-	if err := mail123JSON(mail123Base+"/mailbox/new", &data); err != nil {
+	if err := mail123JSON(ctx, mail123Base+"/mailbox/new", &data); err != nil {
 		return nil, err
 	}
 	email := strings.TrimSpace(data.Address)
@@ -89,10 +90,10 @@ func Mail123Generate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func mail123FetchDetail(address, messageID string) (map[string]any, error) {
+func mail123FetchDetail(ctx context.Context, address, messageID string) (map[string]any, error) {
 	u := fmt.Sprintf("%s/mailbox/%s/messages/%s", mail123Base, url.PathEscape(address), url.PathEscape(messageID))
 	var data mail123DetailResponse
-	if err := mail123JSON(u, &data); err != nil {
+	if err := mail123JSON(ctx, u, &data); err != nil {
 		return nil, err
 	}
 	if data.Message == nil {
@@ -101,21 +102,21 @@ func mail123FetchDetail(address, messageID string) (map[string]any, error) {
 	return data.Message, nil
 }
 
-func Mail123GetEmails(email string) ([]NormEmail, error) {
+func Mail123GetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("mail123: empty email")
 	}
 	u := fmt.Sprintf("%s/mailbox/%s/messages?limit=50", mail123Base, url.PathEscape(address))
 	var data mail123ListResponse
-	if err := mail123JSON(u, &data); err != nil {
+	if err := mail123JSON(ctx, u, &data); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(data.Messages))
 	for _, row := range data.Messages {
 		raw := row
 		if id := strings.TrimSpace(fmt.Sprintf("%v", row["id"])); id != "" {
-			if detail, err := mail123FetchDetail(address, id); err == nil {
+			if detail, err := mail123FetchDetail(ctx, address, id); err == nil {
 				raw = detail
 			}
 		}
@@ -1,6 +1,10 @@
 package provider
 
-func MailBentraskComGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "mail-bentrask-com", Email: randomStr(12) + "@mail.bentrask.com"}, nil
+import "context"
+
+func MailBentraskComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "mail-bentrask-com", Email: localPartOr(ctx, randomStr(12)) + "@mail.bentrask.com"}, nil
+}
+func MailBentraskComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func MailBentraskComGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -41,10 +42,11 @@ func mailCxApplyHeaders(req *http.Request, clientID string) {
 	req.Header.Set("X-Client-ID", clientID)
 }
 
-func mailCxHTTPClient() tls_client.HttpClient {
+/* mailCxHTTPClient 每次新建带独立 Cookie 的客户端（mail-cx / ddker-com 共用），仍附加实例的限速钩子 */
+func mailCxHTTPClient(ctx context.Context) tls_client.HttpClient {
 	timeout := 35 * time.Second
 	if GetConfigSnapshot != nil {
-		cfg := GetConfigSnapshot()
+		cfg := GetConfigSnapshot(ctx)
 		if cfg.Timeout > int64(timeout) {
 			timeout = time.Duration(cfg.Timeout)
 		}
@@ -58,36 +60,37 @@ func mailCxHTTPClient() tls_client.HttpClient {
 		if cfg.Proxy != "" {
 			options = append(options, tls_client.WithProxyUrl(cfg.Proxy))
 		}
+		options = append(options, httpClientHooks(ctx)...)
 		client, err := tls_client.NewHttpClient(tls_client.NewNoopLogger(), options...)
 		if err == nil {
 			return client
 		}
 	}
-	client, err := tls_client.NewHttpClient(
-		tls_client.NewNoopLogger(),
+	options := []tls_client.HttpClientOption{
 		tls_client.WithTimeoutSeconds(int(timeout.Seconds())),
 		tls_client.WithCookieJar(tls_client.NewCookieJar()),
-	)
+	}
+	client, err := tls_client.NewHttpClient(tls_client.NewNoopLogger(), append(options, httpClientHooks(ctx)...)...)
 	if err == nil {
 		return client
 	}
-	return HTTPClient()
+	return HTTPClient(ctx)
 }
 
-func mailCxGetConfig(clientID string) (*mailCxConfig, error) {
-	req, err := http.NewRequest("GET", mailCxBaseURL+"/v1/config", nil)
+func mailCxGetConfig(ctx context.Context, clientID string) (*mailCxConfig, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", mailCxBaseURL+"/v1/config", nil)
 	if err != nil {
 		return nil, err
 	}
 	mailCxApplyHeaders(req, clientID)
 
-	resp, err := mailCxHTTPClient().Do(req)
+	resp, err := mailCxHTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mail-cx config: %d", resp.StatusCode)
+		return nil, statusError(resp, "mail-cx config: %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -158,20 +161,20 @@ func mailCxFlattenDetail(raw map[string]interface{}, recipient string) map[strin
 	}
 }
 
-func mailCxGetDetail(clientID, id string) (map[string]interface{}, error) {
-	req, err := http.NewRequest("GET", mailCxBaseURL+"/v1/email/"+url.PathEscape(id), nil)
+func mailCxGetDetail(ctx context.Context, clientID, id string) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", mailCxBaseURL+"/v1/email/"+url.PathEscape(id), nil)
 	if err != nil {
 		return nil, err
 	}
 	mailCxApplyHeaders(req, clientID)
 
-	resp, err := mailCxHTTPClient().Do(req)
+	resp, err := mailCxHTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mail-cx detail: %d", resp.StatusCode)
+		return nil, statusError(resp, "mail-cx detail: %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -185,9 +188,9 @@ func mailCxGetDetail(clientID, id string) (map[string]interface{}, error) {
 	return data, nil
 }
 
-func MailCxGenerate(domain *string) (*CreatedMailbox, error) {
+func MailCxGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
 	clientID := mailCxClientID()
-	cfg, err := mailCxGetConfig(clientID)
+	cfg, err := mailCxGetConfig(ctx, clientID)
 	if err != nil {
 		return nil, err
 	}
@@ -210,14 +213,14 @@ func MailCxGenerate(domain *string) (*CreatedMailbox, error) {
 	return info, nil
 }
 
-func MailCxGetEmails(clientID, email string) ([]NormEmail, error) {
-	req, err := http.NewRequest("GET", mailCxBaseURL+"/v1/inbox/"+url.PathEscape(email), nil)
+func MailCxGetEmails(ctx context.Context, clientID, email string) ([]NormEmail, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", mailCxBaseURL+"/v1/inbox/"+url.PathEscape(email), nil)
 	if err != nil {
 		return nil, err
 	}
 	mailCxApplyHeaders(req, clientID)
 
-	resp, err := mailCxHTTPClient().Do(req)
+	resp, err := mailCxHTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +229,7 @@ func MailCxGetEmails(clientID, email string) ([]NormEmail, error) {
 		return []NormEmail{}, nil
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mail-cx inbox: %d", resp.StatusCode)
+		return nil, statusError(resp, "mail-cx inbox: %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -242,7 +245,7 @@ func MailCxGetEmails(clientID, email string) ([]NormEmail, error) {
 	for _, row := range data.Emails {
 		raw := mailCxFlattenListMessage(row, email)
 		if id, ok := row["id"].(string); ok && id != "" {
-			if detail, err := mailCxGetDetail(clientID, id); err == nil {
+			if detail, err := mailCxGetDetail(ctx, clientID, id); err == nil {
 				raw = mailCxFlattenDetail(detail, email)
 			}
 		}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -39,18 +40,18 @@ func mailSunlsDefaultHeaders(req *http.Request) {
 	req.Header.Set("DNT", "1")
 	req.Header.Set("Pragma", "no-cache")
 	req.Header.Set("Referer", "https://mail.sunls.de/")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 }
 
 /* mailSunlsFetchDomains 获取可用域名列表 */
-func mailSunlsFetchDomains() ([]string, error) {
-	req, err := http.NewRequest("GET", mailSunlsBase+"/api/domain", nil)
+func mailSunlsFetchDomains(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", mailSunlsBase+"/api/domain", nil)
 	if err != nil {
 		return nil, err
 	}
 	mailSunlsDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +62,7 @@ func mailSunlsFetchDomains() ([]string, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mail-sunls domains: %d", resp.StatusCode)
+		return nil, statusError(resp, "mail-sunls domains: %d", resp.StatusCode)
 	}
 
 	var domains []string
@@ -89,8 +90,8 @@ func mailSunlsFetchDomains() ([]string, error) {
  * 2. 随机选取域名，生成 randomLocal(10) + "@" + 域名
  * 无需 token，无需 session
  */
-func MailSunlsGenerate(channel ...string) (*CreatedMailbox, error) {
-	domains, err := mailSunlsFetchDomains()
+func MailSunlsGenerate(ctx context.Context, channel ...string) (*CreatedMailbox, error) {
+	domains, err := mailSunlsFetchDomains(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -110,19 +111,19 @@ func MailSunlsGenerate(channel ...string) (*CreatedMailbox, error) {
  * GET https://mail.sunls.de/api/fetch/{id}
  * 返回单封邮件的完整 map（含 text/html 字段），失败时返回 nil
  */
-func mailSunlsFetchDetail(id string) map[string]interface{} {
+func mailSunlsFetchDetail(ctx context.Context, id string) map[string]interface{} {
 	id = strings.TrimSpace(id)
 	if id == "" {
 		return nil
 	}
 	u := fmt.Sprintf("%s/api/fetch/%s", mailSunlsBase, url.PathEscape(id))
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil
 	}
 	mailSunlsDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil
 	}
@@ -171,20 +172,20 @@ func mailSunlsExtractID(row map[string]interface{}) string {
  *   2. 对每封邮件 GET /api/fetch/{id} 拉取详情，用详情覆盖列表字段（text/html）
  * 详情接口失败时回退到列表数据，不阻断整体流程
  */
-func MailSunlsGetEmails(email string) ([]NormEmail, error) {
+func MailSunlsGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("mail-sunls: empty email")
 	}
 
 	u := fmt.Sprintf("%s/api/fetch?to=%s", mailSunlsBase, url.QueryEscape(email))
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	mailSunlsDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +196,7 @@ func MailSunlsGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mail-sunls fetch: %d", resp.StatusCode)
+		return nil, statusError(resp, "mail-sunls fetch: %d", resp.StatusCode)
 	}
 
 	var rawMails []map[string]interface{}
@@ -209,7 +210,7 @@ func MailSunlsGetEmails(email string) ([]NormEmail, error) {
 		if id == "" {
 			continue
 		}
-		detail := mailSunlsFetchDetail(id)
+		detail := mailSunlsFetchDetail(ctx, id)
 		if detail == nil {
 			continue
 		}
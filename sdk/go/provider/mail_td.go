@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
@@ -130,16 +131,16 @@ func mailTdRandomString(n int) string {
 /**
  * MailTdGenerate — 创建 mail.td 临时邮箱
  */
-func MailTdGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func MailTdGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	/* 获取可用域名 */
-	req, err := http.NewRequest("GET", mailTdBase+"/domains", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", mailTdBase+"/domains", nil)
 	if err != nil {
 		return nil, fmt.Errorf("mail-td: 创建域名请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -153,7 +154,7 @@ func MailTdGenerate() (*CreatedMailbox, error) {
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("mail-td: 域名请求 HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "mail-td: 域名请求 HTTP %d", resp.StatusCode)
 	}
 
 	var domsResp mailTdDomainsResponse
@@ -207,13 +208,13 @@ func MailTdGenerate() (*CreatedMailbox, error) {
 		}
 		reqBytes, _ := json.Marshal(reqBody)
 
-		req2, err := http.NewRequest("POST", mailTdBase+"/accounts", strings.NewReader(string(reqBytes)))
+		req2, err := http.NewRequestWithContext(ctx, "POST", mailTdBase+"/accounts", strings.NewReader(string(reqBytes)))
 		if err != nil {
 			return nil, fmt.Errorf("mail-td: 创建账户请求构建失败: %w", err)
 		}
 		req2.Header.Set("Content-Type", "application/json")
 		req2.Header.Set("Accept", "application/json")
-		req2.Header.Set("User-Agent", getCurrentUA())
+		req2.Header.Set("User-Agent", getCurrentUA(ctx))
 
 		resp2, err := client.Do(req2)
 		if err != nil {
@@ -246,7 +247,7 @@ func MailTdGenerate() (*CreatedMailbox, error) {
 			if e, ok := result["error"].(string); ok {
 				errMsg = e
 			}
-			return nil, fmt.Errorf("mail-td: 创建账户 HTTP %d: %s", resp2.StatusCode, errMsg)
+			return nil, statusError(resp2, "mail-td: 创建账户 HTTP %d: %s", resp2.StatusCode, errMsg)
 		}
 
 		resultAddr, _ := result["address"].(string)
@@ -273,7 +274,7 @@ func MailTdGenerate() (*CreatedMailbox, error) {
 /**
  * MailTdGetEmails — 获取 mail.td 邮件列表
  */
-func MailTdGetEmails(token, email string) ([]NormEmail, error) {
+func MailTdGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	if token == "" {
 		return nil, fmt.Errorf("mail-td: token 为空")
 	}
@@ -287,15 +288,15 @@ func MailTdGetEmails(token, email string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("mail-td: token 缺少 jwt 或 id")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	u := fmt.Sprintf("%s/accounts/%s/messages?page=1", mailTdBase, tokenData.ID)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, fmt.Errorf("mail-td: 创建邮件请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Authorization", "Bearer "+tokenData.JWT)
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -304,7 +305,7 @@ func MailTdGetEmails(token, email string) ([]NormEmail, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mail-td: 邮件请求 HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "mail-td: 邮件请求 HTTP %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -2,12 +2,17 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
+	"net/url"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	http "github.com/bogdanfinn/fhttp"
 )
@@ -44,7 +49,8 @@ type mailTmTokenResponse struct {
 }
 
 type mailTmMessageItem struct {
-	ID string `json:"id"`
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
 }
 
 type mailTmMessagesResponse struct {
@@ -52,14 +58,14 @@ type mailTmMessagesResponse struct {
 }
 
 // mailTmGetDomains 获取可用域名列表
-func mailTmGetDomains() ([]string, error) {
-	req, err := http.NewRequest("GET", mailTmBaseURL+"/domains", nil)
+func mailTmGetDomains(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", mailTmBaseURL+"/domains", nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -94,20 +100,20 @@ func mailTmGetDomains() ([]string, error) {
 }
 
 // mailTmCreateAccount 创建账号
-func mailTmCreateAccount(address, password string) (*mailTmAccountResponse, error) {
+func mailTmCreateAccount(ctx context.Context, address, password string) (*mailTmAccountResponse, error) {
 	reqBody, _ := json.Marshal(map[string]string{
 		"address":  address,
 		"password": password,
 	})
 
-	req, err := http.NewRequest("POST", mailTmBaseURL+"/accounts", bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", mailTmBaseURL+"/accounts", bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/ld+json")
 	req.Header.Set("Accept", "application/json")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -120,7 +126,7 @@ func mailTmCreateAccount(address, password string) (*mailTmAccountResponse, erro
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("failed to create account: %d %s", resp.StatusCode, string(body))
+		return nil, statusError(resp, "failed to create account: %d %s", resp.StatusCode, string(body))
 	}
 
 	var result mailTmAccountResponse
@@ -131,20 +137,20 @@ func mailTmCreateAccount(address, password string) (*mailTmAccountResponse, erro
 }
 
 // mailTmGetToken 获取 Bearer Token
-func mailTmGetToken(address, password string) (string, error) {
+func mailTmGetToken(ctx context.Context, address, password string) (string, error) {
 	reqBody, _ := json.Marshal(map[string]string{
 		"address":  address,
 		"password": password,
 	})
 
-	req, err := http.NewRequest("POST", mailTmBaseURL+"/token", bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", mailTmBaseURL+"/token", bytes.NewReader(reqBody))
 	if err != nil {
 		return "", err
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -157,7 +163,7 @@ func mailTmGetToken(address, password string) (string, error) {
 	}
 
 	if resp.StatusCode >= 400 {
-		return "", fmt.Errorf("failed to get token: %d", resp.StatusCode)
+		return "", statusError(resp, "failed to get token: %d", resp.StatusCode)
 	}
 
 	var result mailTmTokenResponse
@@ -169,9 +175,9 @@ func mailTmGetToken(address, password string) (string, error) {
 
 // MailTmGenerate 创建临时邮箱
 // 流程: 获取域名 → 生成随机邮箱/密码 → 创建账号 → 获取 Token
-func MailTmGenerate() (*CreatedMailbox, error) {
+func MailTmGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	// 1. 获取可用域名
-	domains, err := mailTmGetDomains()
+	domains, err := mailTmGetDomains(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -181,18 +187,18 @@ func MailTmGenerate() (*CreatedMailbox, error) {
 
 	// 2. 生成随机邮箱和密码
 	domain := domains[rand.Intn(len(domains))]
-	username := randomStr(12)
+	username := localPartOr(ctx, randomStr(12))
 	address := fmt.Sprintf("%s@%s", username, domain)
 	password := randomStr(16)
 
 	// 3. 创建账号
-	account, err := mailTmCreateAccount(address, password)
+	account, err := mailTmCreateAccount(ctx, address, password)
 	if err != nil {
 		return nil, err
 	}
 
 	// 4. 获取 Bearer Token
-	token, err := mailTmGetToken(address, password)
+	token, err := mailTmGetToken(ctx, address, password)
 	if err != nil {
 		return nil, err
 	}
@@ -215,8 +221,13 @@ func mailTmFlattenMessage(raw map[string]interface{}, recipientEmail string) map
 	// from: {name, address} → 提取 address
 	if from, ok := raw["from"].(map[string]interface{}); ok {
 		flat["from"] = from["address"]
+		flat["from_name"] = from["name"]
 	}
 
+	// 完整收件人 / 抄送列表 [{name, address}]，由归一化层解析为结构化地址
+	flat["to_list"] = raw["to"]
+	flat["cc"] = raw["cc"]
+
 	// to: [{name, address}] → 提取第一个 address
 	if to, ok := raw["to"].([]interface{}); ok && len(to) > 0 {
 		if first, ok := to[0].(map[string]interface{}); ok {
@@ -255,16 +266,16 @@ func mailTmFlattenMessage(raw map[string]interface{}, recipientEmail string) map
 
 // MailTmGetEmails 获取邮件列表
 // 流程: GET /messages 获取列表 → 并发 GET /messages/{id} 获取每封邮件详情
-func MailTmGetEmails(token string, email string) ([]NormEmail, error) {
+func MailTmGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	// 1. 获取邮件列表
-	req, err := http.NewRequest("GET", mailTmBaseURL+"/messages", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", mailTmBaseURL+"/messages", nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -277,7 +288,7 @@ func MailTmGetEmails(token string, email string) ([]NormEmail, error) {
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("failed to get messages: %d", resp.StatusCode)
+		return nil, statusError(resp, "failed to get messages: %d", resp.StatusCode)
 	}
 
 	/* 兼容 Hydra 格式和纯数组格式 */
@@ -295,21 +306,31 @@ func MailTmGetEmails(token string, email string) ([]NormEmail, error) {
 	}
 
 	// 2. 并发获取每封邮件的详情
+	ids := make([]string, len(msgItems))
+	for i, msg := range msgItems {
+		ids[i] = msg.ID
+	}
+	return mailTmFetchDetails(ctx, token, email, ids), nil
+}
+
+// mailTmFetchDetails 并发 GET /messages/{id} 获取邮件详情，扁平化并标准化；失败的条目跳过
+func mailTmFetchDetails(ctx context.Context, token, email string, ids []string) []NormEmail {
 	type detailResult struct {
 		index int
 		raw   map[string]interface{}
 		err   error
 	}
 
-	results := make([]detailResult, len(msgItems))
+	client := HTTPClient(ctx)
+	results := make([]detailResult, len(ids))
 	var wg sync.WaitGroup
 
-	for i, msg := range msgItems {
+	for i, id := range ids {
 		wg.Add(1)
 		go func(idx int, msgID string) {
 			defer wg.Done()
 
-			detailReq, err := http.NewRequest("GET", mailTmBaseURL+"/messages/"+msgID, nil)
+			detailReq, err := http.NewRequestWithContext(ctx, "GET", mailTmBaseURL+"/messages/"+msgID, nil)
 			if err != nil {
 				results[idx] = detailResult{index: idx, err: err}
 				return
@@ -337,7 +358,7 @@ func MailTmGetEmails(token string, email string) ([]NormEmail, error) {
 			}
 
 			results[idx] = detailResult{index: idx, raw: m}
-		}(i, msg.ID)
+		}(i, id)
 	}
 
 	wg.Wait()
@@ -351,6 +372,209 @@ func MailTmGetEmails(token string, email string) ([]NormEmail, error) {
 		flat := mailTmFlattenMessage(r.raw, email)
 		emails = append(emails, NormalizeMap(flat, email))
 	}
+	return emails
+}
+
+/* mailTmPageSize mail.tm 列表每页固定条数 */
+const mailTmPageSize = 30
+
+/* mailTmMaxSincePages 增量获取时最多翻页数 */
+const mailTmMaxSincePages = 10
+
+// MailTmGetEmailsSince 增量获取邮件
+// 列表按 createdAt 倒序分页（GET /messages?page=N），从第一页起读到上次最新的邮件为止，只为新邮件请求详情。
+// cursor 形如 "<createdAt>|<id>"，空串表示从头开始；返回新邮件（新到旧）与新的 cursor
+func MailTmGetEmailsSince(ctx context.Context, token, email, cursor string) ([]NormEmail, string, error) {
+	var lastAt time.Time
+	var lastID string
+	if cursor != "" {
+		at, id, ok := strings.Cut(cursor, "|")
+		t, err := time.Parse(time.RFC3339, at)
+		if !ok || err != nil {
+			return nil, "", fmt.Errorf("mail.tm: invalid cursor %q", cursor)
+		}
+		lastAt, lastID = t, id
+	}
+
+	var newIDs []string
+	next := cursor
+	for page := 1; page <= mailTmMaxSincePages; page++ {
+		body, err := mailTmAuthDo(ctx, "GET", "/messages?page="+strconv.Itoa(page), token, "get messages")
+		if err != nil {
+			return nil, "", err
+		}
+		/* 兼容 Hydra 格式和纯数组格式 */
+		var items []mailTmMessageItem
+		if err := json.Unmarshal(body, &items); err != nil {
+			var listResult mailTmMessagesResponse
+			if err2 := json.Unmarshal(body, &listResult); err2 != nil {
+				return nil, "", err2
+			}
+			items = listResult.Members
+		}
+
+		reached := false
+		for _, item := range items {
+			createdAt, _ := time.Parse(time.RFC3339, item.CreatedAt)
+			if item.ID == lastID || (!lastAt.IsZero() && createdAt.Before(lastAt)) {
+				reached = true
+				break
+			}
+			if len(newIDs) == 0 {
+				next = createdAt.UTC().Format(time.RFC3339) + "|" + item.ID
+			}
+			newIDs = append(newIDs, item.ID)
+		}
+		if reached || len(items) < mailTmPageSize {
+			break
+		}
+	}
+
+	if len(newIDs) == 0 {
+		return []NormEmail{}, next, nil
+	}
+	/* 详情加载不全时不推进 cursor，避免漏掉邮件 */
+	emails := mailTmFetchDetails(ctx, token, email, newIDs)
+	if len(emails) < len(newIDs) {
+		return nil, "", markError(ErrChannelUnavailable, "mail.tm: loaded %d of %d new messages", len(emails), len(newIDs))
+	}
+	return emails, next, nil
+}
+
+// MailTmListEmails 仅获取邮件摘要：GET /messages 的条目含发件人、主题与 intro 预览，不逐封请求详情
+func MailTmListEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
+	body, err := mailTmAuthDo(ctx, "GET", "/messages", token, "get messages")
+	if err != nil {
+		return nil, err
+	}
 
+	/* 兼容 Hydra 格式和纯数组格式 */
+	var items []map[string]interface{}
+	if err := json.Unmarshal(body, &items); err != nil {
+		var listResult struct {
+			Members []map[string]interface{} `json:"hydra:member"`
+		}
+		if err2 := json.Unmarshal(body, &listResult); err2 != nil {
+			return nil, err2
+		}
+		items = listResult.Members
+	}
+
+	emails := make([]NormEmail, 0, len(items))
+	for _, raw := range items {
+		flat := mailTmFlattenMessage(raw, email)
+		if flat["text"] == nil {
+			flat["text"] = raw["intro"]
+		}
+		emails = append(emails, NormalizeMap(flat, email))
+	}
 	return emails, nil
 }
+
+// MailTmGetEmail 获取单封邮件详情
+// API: GET /messages/{id}
+func MailTmGetEmail(ctx context.Context, token, email, id string) (NormEmail, error) {
+	body, err := mailTmAuthDo(ctx, "GET", "/messages/"+url.PathEscape(id), token, "get message")
+	if err != nil {
+		var se *HTTPStatusError
+		if errors.As(err, &se) && se.StatusCode == 404 {
+			return NormEmail{}, markError(ErrEmailNotFound, "mail.tm: email %s not found", id)
+		}
+		return NormEmail{}, err
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return NormEmail{}, err
+	}
+	return NormalizeMap(mailTmFlattenMessage(raw, email), email), nil
+}
+
+// mailTmStyleAuthDo 向 mail.tm 协议的服务（mail.tm / duckmail）携带 Bearer Token 发起请求，状态码 >= 400 时返回错误
+func mailTmStyleAuthDo(ctx context.Context, baseURL, method, path, token, action string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := HTTPClient(ctx)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return nil, statusError(resp, "failed to %s: %d", action, resp.StatusCode)
+	}
+	return body, nil
+}
+
+// mailTmAuthDo 携带 Bearer Token 请求 mail.tm
+func mailTmAuthDo(ctx context.Context, method, path, token, action string) ([]byte, error) {
+	return mailTmStyleAuthDo(ctx, mailTmBaseURL, method, path, token, action)
+}
+
+// MailTmGetSource 获取邮件原始 MIME 源码
+// API: GET /sources/{id}，响应 {id, downloadUrl, data}
+func MailTmGetSource(ctx context.Context, token, id string) ([]byte, error) {
+	return mailTmStyleSource(mailTmAuthDo(ctx, "GET", "/sources/"+url.PathEscape(id), token, "get source"))
+}
+
+// mailTmStyleSource 解析 mail.tm 协议（mail.tm / duckmail）的 /sources 响应
+func mailTmStyleSource(body []byte, err error) ([]byte, error) {
+	if err != nil {
+		var se *HTTPStatusError
+		if errors.As(err, &se) && se.StatusCode == 404 {
+			return nil, markError(ErrEmailNotFound, "%s", err.Error())
+		}
+		return nil, err
+	}
+	var src struct {
+		Data string `json:"data"`
+	}
+	if err := json.Unmarshal(body, &src); err != nil {
+		return nil, err
+	}
+	return []byte(src.Data), nil
+}
+
+// MailTmDownloadAttachment 携带 Bearer Token 下载附件（downloadUrl 需要鉴权）
+func MailTmDownloadAttachment(ctx context.Context, token, rawURL string) (io.ReadCloser, error) {
+	return DownloadAttachmentURL(ctx, rawURL, map[string]string{"Authorization": "Bearer " + token})
+}
+
+// MailTmDeleteMessage 删除单封邮件
+// API: DELETE /messages/{id}
+func MailTmDeleteMessage(ctx context.Context, token, id string) error {
+	_, err := mailTmAuthDo(ctx, "DELETE", "/messages/"+url.PathEscape(id), token, "delete message")
+	return err
+}
+
+// MailTmDeleteAccount 删除账号，邮箱及其全部邮件随之销毁
+func MailTmDeleteAccount(ctx context.Context, token string) error {
+	return mailTmStyleDeleteAccount(ctx, mailTmBaseURL, token)
+}
+
+// mailTmStyleDeleteAccount 删除 mail.tm 协议服务上的账号
+// 流程: GET /me 获取账号 ID → DELETE /accounts/{id}
+func mailTmStyleDeleteAccount(ctx context.Context, baseURL, token string) error {
+	body, err := mailTmStyleAuthDo(ctx, baseURL, "GET", "/me", token, "get account")
+	if err != nil {
+		return err
+	}
+	var account mailTmAccountResponse
+	if err := json.Unmarshal(body, &account); err != nil {
+		return err
+	}
+	if account.ID == "" {
+		return fmt.Errorf("failed to get account: missing id")
+	}
+	_, err = mailTmStyleAuthDo(ctx, baseURL, "DELETE", "/accounts/"+url.PathEscape(account.ID), token, "delete account")
+	return err
+}
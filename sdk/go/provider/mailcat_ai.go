@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -43,15 +44,15 @@ type mailcatAiInboxResponse struct {
  * API: POST /mailboxes（无 body）
  * 返回邮箱地址和 Bearer token
  */
-func MailcatAiGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func MailcatAiGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("POST", mailcatAiBaseURL+"/mailboxes", nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", mailcatAiBaseURL+"/mailboxes", nil)
 	if err != nil {
 		return nil, fmt.Errorf("mailcat-ai: 创建请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "application/json")
-	ua := getCurrentUA()
+	ua := getCurrentUA(ctx)
 	if ua != "" {
 		req.Header.Set("User-Agent", ua)
 	}
@@ -91,20 +92,20 @@ func MailcatAiGenerate() (*CreatedMailbox, error) {
  * API: GET /inbox
  * Headers: Authorization: Bearer {token}
  */
-func MailcatAiGetEmails(token, email string) ([]NormEmail, error) {
+func MailcatAiGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	if token == "" {
 		return nil, fmt.Errorf("mailcat-ai: token 为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", mailcatAiBaseURL+"/inbox", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", mailcatAiBaseURL+"/inbox", nil)
 	if err != nil {
 		return nil, fmt.Errorf("mailcat-ai: 创建获取邮件请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(token))
-	ua := getCurrentUA()
+	ua := getCurrentUA(ctx)
 	if ua != "" {
 		req.Header.Set("User-Agent", ua)
 	}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"math/rand"
@@ -40,7 +41,7 @@ func mailcatchDefaultHeaders(req *http.Request) {
 	req.Header.Set("DNT", "1")
 	req.Header.Set("Pragma", "no-cache")
 	req.Header.Set("Referer", "https://mailcatch.com/")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 }
 
 /* 邮件项正则：提取 data-email-id, data-subject, data-timestamp, data-sender */
@@ -50,8 +51,8 @@ var mailcatchItemRe = regexp.MustCompile(`data-email-id="([^"]*?)"\s+data-subjec
  * MailcatchGenerate 创建 mailcatch.com 临时邮箱
  * 无需 API 调用，直接生成随机用户名 + "@mailcatch.com"
  */
-func MailcatchGenerate(channel ...string) (*CreatedMailbox, error) {
-	user := mailcatchRandomLocal(10)
+func MailcatchGenerate(ctx context.Context, channel ...string) (*CreatedMailbox, error) {
+	user := localPartOr(ctx, mailcatchRandomLocal(10))
 	email := user + "@" + mailcatchDomain
 	ch := "mailcatch"
 	if len(channel) > 0 && channel[0] != "" {
@@ -67,7 +68,7 @@ func MailcatchGenerate(channel ...string) (*CreatedMailbox, error) {
  * 3. 对每封邮件 GET /api/data/{username}/{emailId} → HTML 正文
  * 4. 标准化为 NormEmail
  */
-func MailcatchGetEmails(email string) ([]NormEmail, error) {
+func MailcatchGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("mailcatch: empty email")
@@ -78,13 +79,13 @@ func MailcatchGetEmails(email string) ([]NormEmail, error) {
 
 	/* 获取邮件列表 */
 	listURL := fmt.Sprintf("%s/api/list/%s", mailcatchAPIBase, username)
-	req, err := http.NewRequest("GET", listURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", listURL, nil)
 	if err != nil {
 		return nil, err
 	}
 	mailcatchDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +96,7 @@ func MailcatchGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mailcatch list: %d", resp.StatusCode)
+		return nil, statusError(resp, "mailcatch list: %d", resp.StatusCode)
 	}
 
 	listHTML := string(body)
@@ -115,13 +116,13 @@ func MailcatchGetEmails(email string) ([]NormEmail, error) {
 
 		/* 获取邮件正文 */
 		dataURL := fmt.Sprintf("%s/api/data/%s/%s", mailcatchAPIBase, username, emailID)
-		dataReq, err := http.NewRequest("GET", dataURL, nil)
+		dataReq, err := http.NewRequestWithContext(ctx, "GET", dataURL, nil)
 		if err != nil {
 			continue
 		}
 		mailcatchDefaultHeaders(dataReq)
 
-		dataResp, err := HTTPClient().Do(dataReq)
+		dataResp, err := HTTPClient(ctx).Do(dataReq)
 		if err != nil {
 			continue
 		}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -32,7 +33,7 @@ func maildropDefaultHeaders(req *http.Request) {
 	req.Header.Set("sec-fetch-dest", "empty")
 	req.Header.Set("sec-fetch-mode", "cors")
 	req.Header.Set("sec-fetch-site", "same-origin")
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(req.Context()))
 	req.Header.Set("x-requested-with", "XMLHttpRequest")
 }
 
@@ -45,21 +46,21 @@ func maildropRandomLocal(n int) string {
 	return string(b)
 }
 
-func maildropFetchSuffixes() ([]string, error) {
-	req, err := http.NewRequest("GET", maildropBase+"/api/suffixes.php", nil)
+func maildropFetchSuffixes(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", maildropBase+"/api/suffixes.php", nil)
 	if err != nil {
 		return nil, err
 	}
 	maildropDefaultHeaders(req)
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("maildrop suffixes request: %w", err)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("maildrop suffixes: %d", resp.StatusCode)
+		return nil, statusError(resp, "maildrop suffixes: %d", resp.StatusCode)
 	}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -111,8 +112,8 @@ func maildropCxDateToRFC3339(s string) string {
 /*
  * MaildropGenerate 随机本地部分 + 可选指定域名（须在后缀列表中且未被排除）
  */
-func MaildropGenerate(domain *string) (*CreatedMailbox, error) {
-	suffixes, err := maildropFetchSuffixes()
+func MaildropGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
+	suffixes, err := maildropFetchSuffixes(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +121,7 @@ func MaildropGenerate(domain *string) (*CreatedMailbox, error) {
 	if err != nil {
 		return nil, err
 	}
-	local := maildropRandomLocal(10)
+	local := localPartOr(ctx, maildropRandomLocal(10))
 	email := local + "@" + dom
 	return &CreatedMailbox{Channel: "maildrop", Email: email, Token: email}, nil
 }
@@ -173,41 +174,64 @@ func maildropParseIsRead(raw json.RawMessage) bool {
  *   - content: 完整 HTML 正文
  *   - subject / from_addr / date: 邮件元数据
  *   - attachment: JSON 字符串数组 [{filename, path, size}]（可能为空）
- * 失败时返回 nil，调用方回退到列表 description
  */
-func maildropFetchDetail(id string) map[string]interface{} {
+func maildropFetchDetail(ctx context.Context, id string) (map[string]interface{}, error) {
 	id = strings.TrimSpace(id)
 	if id == "" {
-		return nil
+		return nil, fmt.Errorf("maildrop: empty email id")
 	}
 	q := url.Values{}
 	q.Set("id", id)
 	full := maildropBase + "/api/email_content.php?" + q.Encode()
 
-	req, err := http.NewRequest("GET", full, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", full, nil)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 	maildropDefaultHeaders(req)
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil
+		return nil, fmt.Errorf("maildrop email content: %w", err)
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode == 404 {
+		return nil, markError(ErrEmailNotFound, "maildrop: email %s not found", id)
+	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil
+		return nil, statusError(resp, "maildrop email content: %d", resp.StatusCode)
 	}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 	var detail map[string]interface{}
-	if err := json.Unmarshal(body, &detail); err != nil {
-		return nil
+	if err := json.Unmarshal(body, &detail); err != nil || detail == nil {
+		return nil, markError(ErrEmailNotFound, "maildrop: email %s not found", id)
+	}
+	return detail, nil
+}
+
+/* maildropApplyDetail 以详情覆盖列表字段：content 作为 HTML，text 维持列表 description 摘要 */
+func maildropApplyDetail(item *NormEmail, detail map[string]interface{}) {
+	if content, ok := detail["content"].(string); ok && strings.TrimSpace(content) != "" {
+		item.HTML = content
+	}
+	/* 详情返回的 from_addr / subject / date 优先级更高 */
+	if fromAddr, ok := detail["from_addr"].(string); ok && strings.TrimSpace(fromAddr) != "" {
+		item.From = strings.TrimSpace(fromAddr)
+	}
+	if subj, ok := detail["subject"].(string); ok && strings.TrimSpace(subj) != "" {
+		item.Subject = strings.TrimSpace(subj)
+	}
+	if dateStr, ok := detail["date"].(string); ok && strings.TrimSpace(dateStr) != "" {
+		item.Date = maildropCxDateToRFC3339(dateStr)
+	}
+	/* 解析附件 */
+	if atts := maildropParseAttachments(detail["attachment"]); len(atts) > 0 {
+		item.Attachments = atts
 	}
-	return detail
 }
 
 /*
@@ -248,7 +272,42 @@ func maildropParseAttachments(raw interface{}) []NormAttachment {
  *   2. 对每封邮件 GET /api/email_content.php?id={id} 拉取详情（含 content 完整 HTML）
  *   3. 详情失败时保留列表 description 作为回退
  */
-func MaildropGetEmails(token string, email string) ([]NormEmail, error) {
+func MaildropGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
+	out, err := MaildropListEmails(ctx, token, email)
+	if err != nil {
+		return nil, err
+	}
+	for i := range out {
+		if out[i].ID == "" {
+			continue
+		}
+		if detail, err := maildropFetchDetail(ctx, out[i].ID); err == nil {
+			maildropApplyDetail(&out[i], detail)
+		}
+	}
+	return out, nil
+}
+
+/* MaildropGetEmail 通过详情接口获取单封邮件完整内容 */
+func MaildropGetEmail(ctx context.Context, token, email, id string) (NormEmail, error) {
+	detail, err := maildropFetchDetail(ctx, id)
+	if err != nil {
+		return NormEmail{}, err
+	}
+	addr := strings.TrimSpace(email)
+	if addr == "" {
+		addr = strings.TrimSpace(token)
+	}
+	item := NormEmail{ID: strings.TrimSpace(id), To: addr, Attachments: []NormAttachment{}}
+	maildropApplyDetail(&item, detail)
+	return item, nil
+}
+
+/*
+ * MaildropListEmails 仅拉取邮件列表（description 摘要作为 Text），不请求详情
+ * GET /api/emails.php?addr={email}
+ */
+func MaildropListEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	addr := strings.TrimSpace(email)
 	if addr == "" {
 		addr = strings.TrimSpace(token)
@@ -262,20 +321,20 @@ func MaildropGetEmails(token string, email string) ([]NormEmail, error) {
 	q.Set("limit", "20")
 	full := maildropBase + "/api/emails.php?" + q.Encode()
 
-	req, err := http.NewRequest("GET", full, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", full, nil)
 	if err != nil {
 		return nil, err
 	}
 	maildropDefaultHeaders(req)
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("maildrop emails: %w", err)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("maildrop emails: %d", resp.StatusCode)
+		return nil, statusError(resp, "maildrop emails: %d", resp.StatusCode)
 	}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -305,30 +364,6 @@ func MaildropGetEmails(token string, email string) ([]NormEmail, error) {
 			Attachments: []NormAttachment{},
 		}
 
-		/* 拉取详情覆盖 text/html/attachments */
-		if id != "" {
-			if detail := maildropFetchDetail(id); detail != nil {
-				if content, ok := detail["content"].(string); ok && strings.TrimSpace(content) != "" {
-					item.HTML = content
-					/* text 字段维持列表 description（作为纯文本摘要） */
-				}
-				/* 详情返回的 from_addr / subject / date 优先级更高 */
-				if fromAddr, ok := detail["from_addr"].(string); ok && strings.TrimSpace(fromAddr) != "" {
-					item.From = strings.TrimSpace(fromAddr)
-				}
-				if subj, ok := detail["subject"].(string); ok && strings.TrimSpace(subj) != "" {
-					item.Subject = strings.TrimSpace(subj)
-				}
-				if dateStr, ok := detail["date"].(string); ok && strings.TrimSpace(dateStr) != "" {
-					item.Date = maildropCxDateToRFC3339(dateStr)
-				}
-				/* 解析附件 */
-				if atts := maildropParseAttachments(detail["attachment"]); len(atts) > 0 {
-					item.Attachments = atts
-				}
-			}
-		}
-
 		out = append(out, item)
 	}
 	return out, nil
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -49,7 +50,7 @@ func maildropCcDefaultHeaders(req *http.Request) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Origin", "https://maildrop.cc")
 	req.Header.Set("Referer", "https://maildrop.cc/")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 }
 
 /* maildropCcInboxItem inbox 查询返回的单条邮件元信息 */
@@ -87,19 +88,19 @@ type maildropCcMessageResponse struct {
  * maildropCcDoGraphQL 发送 GraphQL 请求并解析到 out
  * query 通过 JSON 序列化构造 body，避免内联变量时的转义/注入问题
  */
-func maildropCcDoGraphQL(query string, out interface{}) error {
+func maildropCcDoGraphQL(ctx context.Context, query string, out interface{}) error {
 	payload, err := json.Marshal(map[string]string{"query": query})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", maildropCcGraphQLURL, bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, "POST", maildropCcGraphQLURL, bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 	maildropCcDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -110,7 +111,7 @@ func maildropCcDoGraphQL(query string, out interface{}) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("maildrop-cc graphql: %d", resp.StatusCode)
+		return statusError(resp, "maildrop-cc graphql: %d", resp.StatusCode)
 	}
 
 	return json.Unmarshal(body, out)
@@ -133,8 +134,8 @@ func maildropCcMessageQuery(mailbox, id string) string {
  * MaildropCcGenerate 创建 maildrop.cc 临时邮箱
  * 无需 API 调用，直接生成随机用户名 + "@maildrop.cc"
  */
-func MaildropCcGenerate() (*CreatedMailbox, error) {
-	user := maildropCcRandomLocal(10)
+func MaildropCcGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	user := localPartOr(ctx, maildropCcRandomLocal(10))
 	email := user + "@" + maildropCcDomain
 	return &CreatedMailbox{Channel: "maildrop-cc", Email: email, Token: ""}, nil
 }
@@ -144,7 +145,7 @@ func MaildropCcGenerate() (*CreatedMailbox, error) {
  * 先用 inbox 查询拿到 id 列表，再并发用 message 查询逐封补全 html 正文
  * maildrop.cc 为公共邮箱服务，无需 token（token 参数保留以对齐接口，忽略）
  */
-func MaildropCcGetEmails(email, token string) ([]NormEmail, error) {
+func MaildropCcGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	_ = token
 	mailbox := maildropCcMailbox(email)
 	if mailbox == "" {
@@ -153,7 +154,7 @@ func MaildropCcGetEmails(email, token string) ([]NormEmail, error) {
 
 	/* 1. 查询邮件列表 */
 	var inboxResp maildropCcInboxResponse
-	if err := maildropCcDoGraphQL(maildropCcInboxQuery(mailbox), &inboxResp); err != nil {
+	if err := maildropCcDoGraphQL(ctx, maildropCcInboxQuery(mailbox), &inboxResp); err != nil {
 		return nil, err
 	}
 	items := inboxResp.Data.Inbox
@@ -173,7 +174,7 @@ func MaildropCcGetEmails(email, token string) ([]NormEmail, error) {
 		go func(idx int, id string) {
 			defer wg.Done()
 			var msgResp maildropCcMessageResponse
-			if err := maildropCcDoGraphQL(maildropCcMessageQuery(mailbox, id), &msgResp); err != nil {
+			if err := maildropCcDoGraphQL(ctx, maildropCcMessageQuery(mailbox, id), &msgResp); err != nil {
 				return
 			}
 			results[idx] = detailResult{msg: msgResp.Data.Message, ok: true}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -70,13 +71,13 @@ type mailforspamListResponse struct {
 	Emails []map[string]any `json:"emails"`
 }
 
-func mailforspamGetJSON(u string) ([]byte, error) {
-	req, err := http.NewRequest("GET", u, nil)
+func mailforspamGetJSON(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	mailforspamHeaders(req)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -86,31 +87,31 @@ func mailforspamGetJSON(u string) ([]byte, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mailforspam: http %d", resp.StatusCode)
+		return nil, statusError(resp, "mailforspam: http %d", resp.StatusCode)
 	}
 	return body, nil
 }
 
-func MailforspamGenerate(domain *string, channel ...string) (*CreatedMailbox, error) {
+func MailforspamGenerate(ctx context.Context, domain *string, channel ...string) (*CreatedMailbox, error) {
 	ch := "mailforspam"
 	if len(channel) > 0 && channel[0] != "" {
 		ch = channel[0]
 	}
 	email := fmt.Sprintf("%s@%s", mailforspamRandomLocal(), mailforspamPickDomain(domain))
-	if _, err := mailforspamGetJSON(mailforspamEmailsURL(email, 1)); err != nil {
+	if _, err := mailforspamGetJSON(ctx, mailforspamEmailsURL(email, 1)); err != nil {
 		return nil, fmt.Errorf("mailforspam validate mailbox: %w", err)
 	}
 	return &CreatedMailbox{Channel: ch, Email: email}, nil
 }
 
-func mailforspamFetchMessage(id, email string) (map[string]any, error) {
+func mailforspamFetchMessage(ctx context.Context, id, email string) (map[string]any, error) {
 	u := fmt.Sprintf(
 		"%s/mailboxes/%s/emails/%s",
 		mailforspamBase,
 		url.PathEscape(email),
 		url.PathEscape(id),
 	)
-	body, err := mailforspamGetJSON(u)
+	body, err := mailforspamGetJSON(ctx, u)
 	if err != nil {
 		return nil, err
 	}
@@ -139,12 +140,12 @@ func mailforspamFlatten(raw map[string]any, recipient string) map[string]any {
 	}
 }
 
-func MailforspamGetEmails(email string) ([]NormEmail, error) {
+func MailforspamGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("mailforspam: empty email")
 	}
-	body, err := mailforspamGetJSON(mailforspamEmailsURL(email, 100))
+	body, err := mailforspamGetJSON(ctx, mailforspamEmailsURL(email, 100))
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +159,7 @@ func MailforspamGetEmails(email string) ([]NormEmail, error) {
 		if id == "" {
 			continue
 		}
-		detail, err := mailforspamFetchMessage(id, email)
+		detail, err := mailforspamFetchMessage(ctx, id, email)
 		if err != nil {
 			out = append(out, NormalizeMap(mailforspamFlatten(item, email), email))
 			continue
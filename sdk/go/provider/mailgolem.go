@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,7 +25,7 @@ var mailgolemCSRFRe = regexp.MustCompile(`<input[^>]+name="_token"[^>]+id="token
 
 /* mailgolemBrowserHeaders 设置浏览器模拟请求头 */
 func mailgolemBrowserHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 }
@@ -32,10 +33,10 @@ func mailgolemBrowserHeaders(req *http.Request) {
 /* mailgolemFetchCSRF 访问首页建立 session 并从 HTML 中提取 CSRF token
  * 返回提取到的 CSRF token 值
  */
-func mailgolemFetchCSRF(client interface {
+func mailgolemFetchCSRF(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }) (string, error) {
-	req, err := http.NewRequest("GET", mailgolemBaseURL+"/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", mailgolemBaseURL+"/", nil)
 	if err != nil {
 		return "", fmt.Errorf("mailgolem: 创建首页请求失败: %w", err)
 	}
@@ -71,17 +72,17 @@ func mailgolemFetchCSRF(client interface {
  *   2. GET /random-email-address 获取随机邮箱地址（纯文本响应）
  * token: CSRF token 值
  */
-func MailgolemGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func MailgolemGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	/* 步骤 1：GET / 获取 session + CSRF token */
-	csrfToken, err := mailgolemFetchCSRF(client)
+	csrfToken, err := mailgolemFetchCSRF(ctx, client)
 	if err != nil {
 		return nil, err
 	}
 
 	/* 步骤 2：GET /random-email-address 获取邮箱地址 */
-	req, err := http.NewRequest("GET", mailgolemBaseURL+"/random-email-address", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", mailgolemBaseURL+"/random-email-address", nil)
 	if err != nil {
 		return nil, fmt.Errorf("mailgolem: 创建随机邮箱请求失败: %w", err)
 	}
@@ -121,16 +122,16 @@ func MailgolemGenerate() (*CreatedMailbox, error) {
  *   2. POST /fetch-emails/{email} 获取邮件列表
  * 返回 JSON 数组 [{id, from, subject}]
  */
-func MailgolemGetEmails(email, token string) ([]NormEmail, error) {
+func MailgolemGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("mailgolem: 邮箱地址为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	/* 步骤 1：GET / 重新建立 session + 获取新 CSRF token */
-	csrfToken, err := mailgolemFetchCSRF(client)
+	csrfToken, err := mailgolemFetchCSRF(ctx, client)
 	if err != nil {
 		return nil, err
 	}
@@ -140,11 +141,11 @@ func MailgolemGetEmails(email, token string) ([]NormEmail, error) {
 	form.Set("_token", csrfToken)
 
 	fetchURL := fmt.Sprintf("%s/fetch-emails/%s", mailgolemBaseURL, url.PathEscape(email))
-	req, err := http.NewRequest("POST", fetchURL, strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", fetchURL, strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("mailgolem: 创建获取邮件请求失败: %w", err)
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
 	req.Header.Set("Accept", "application/json, text/plain, */*")
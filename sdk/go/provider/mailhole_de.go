@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,15 +26,15 @@ var mailholeDeEmailRegex = regexp.MustCompile(`([a-z0-9.]+@mailhole\.de)`)
 /**
  * MailholeDeGenerate — 创建 mailhole.de 临时邮箱
  */
-func MailholeDeGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func MailholeDeGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", mailholeDeBase+"/api/random", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", mailholeDeBase+"/api/random", nil)
 	if err != nil {
 		return nil, fmt.Errorf("mailhole-de: 创建请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "text/html")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -47,7 +48,7 @@ func MailholeDeGenerate() (*CreatedMailbox, error) {
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("mailhole-de: HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "mailhole-de: HTTP %d", resp.StatusCode)
 	}
 
 	matches := mailholeDeEmailRegex.FindSubmatch(body)
@@ -66,7 +67,7 @@ func MailholeDeGenerate() (*CreatedMailbox, error) {
 /**
  * MailholeDeGetEmails — 获取 mailhole.de 邮件列表
  */
-func MailholeDeGetEmails(token, email string) ([]NormEmail, error) {
+func MailholeDeGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	addr := token
 	if addr == "" {
 		addr = email
@@ -75,14 +76,14 @@ func MailholeDeGetEmails(token, email string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("mailhole-de: 缺少邮箱地址")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	u := fmt.Sprintf("%s/json/%s", mailholeDeBase, addr)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, fmt.Errorf("mailhole-de: 创建邮件请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -91,7 +92,7 @@ func MailholeDeGetEmails(token, email string) ([]NormEmail, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mailhole-de: 邮件请求 HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "mailhole-de: 邮件请求 HTTP %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -92,8 +93,8 @@ func mailinatorAttachmentURL(value any) string {
 	return ""
 }
 
-func mailinatorRequestJSON(path string) (map[string]any, error) {
-	req, err := http.NewRequest(http.MethodGet, path, nil)
+func mailinatorRequestJSON(ctx context.Context, path string) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -101,9 +102,9 @@ func mailinatorRequestJSON(path string) (map[string]any, error) {
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8,en-GB;q=0.7,en-US;q=0.6")
 	req.Header.Set("Cache-Control", "no-cache")
 	req.Header.Set("Pragma", "no-cache")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +115,7 @@ func mailinatorRequestJSON(path string) (map[string]any, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mailinator http %d", resp.StatusCode)
+		return nil, statusError(resp, "mailinator http %d", resp.StatusCode)
 	}
 	var data any
 	if err := json.Unmarshal(body, &data); err != nil {
@@ -126,9 +127,9 @@ func mailinatorRequestJSON(path string) (map[string]any, error) {
 	return map[string]any{"data": data}, nil
 }
 
-func mailinatorFetchDetail(messageID string, suffix string) (map[string]any, error) {
+func mailinatorFetchDetail(ctx context.Context, messageID string, suffix string) (map[string]any, error) {
 	u := fmt.Sprintf("%s/api/v2/domains/public/messages/%s/%s", mailinatorBase, messageID, suffix)
-	return mailinatorRequestJSON(u)
+	return mailinatorRequestJSON(ctx, u)
 }
 
 func mailinatorFlattenMessage(summary map[string]any, recipient string, textPayload map[string]any, htmlPayload map[string]any, attachmentsPayload map[string]any) map[string]any {
@@ -177,57 +178,99 @@ func mailinatorFlattenMessage(summary map[string]any, recipient string, textPayl
 	}
 }
 
-func MailinatorGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func MailinatorGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "mailinator",
 		Email:   local + "@mailinator.com",
 	}, nil
 }
 
-func MailinatorGetEmails(email string) ([]NormEmail, error) {
+func MailinatorGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	messages, err := mailinatorListInbox(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]NormEmail, 0, len(messages))
+	for _, msg := range messages {
+		out = append(out, mailinatorLoadMessage(ctx, msg, email))
+	}
+	return out, nil
+}
+
+/* MailinatorListEmails 仅获取收件箱摘要（发件人、主题、时间），不请求正文与附件 */
+func MailinatorListEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	messages, err := mailinatorListInbox(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]NormEmail, 0, len(messages))
+	for _, msg := range messages {
+		flat := mailinatorFlattenMessage(msg, email, map[string]any{}, map[string]any{}, map[string]any{})
+		out = append(out, NormalizeMap(flat, email))
+	}
+	return out, nil
+}
+
+/* MailinatorGetEmail 获取单封邮件：从收件箱摘要定位后只拉取该邮件的正文与附件 */
+func MailinatorGetEmail(ctx context.Context, email, id string) (NormEmail, error) {
+	messages, err := mailinatorListInbox(ctx, email)
+	if err != nil {
+		return NormEmail{}, err
+	}
+	for _, msg := range messages {
+		if mailinatorMessageID(msg) == id {
+			return mailinatorLoadMessage(ctx, msg, email), nil
+		}
+	}
+	return NormEmail{}, markError(ErrEmailNotFound, "mailinator: email %s not found", id)
+}
+
+/* mailinatorListInbox 拉取公共收件箱的邮件摘要列表 */
+func mailinatorListInbox(ctx context.Context, email string) ([]map[string]any, error) {
 	inbox := strings.TrimSpace(email)
 	if at := strings.Index(inbox, "@"); at >= 0 {
 		inbox = inbox[:at]
 	}
 	if inbox == "" {
-		return []NormEmail{}, nil
+		return nil, nil
 	}
 
-	data, err := mailinatorRequestJSON(fmt.Sprintf("%s/api/v2/domains/public/inboxes/%s", mailinatorBase, inbox))
+	data, err := mailinatorRequestJSON(ctx, fmt.Sprintf("%s/api/v2/domains/public/inboxes/%s", mailinatorBase, inbox))
 	if err != nil {
 		return nil, err
 	}
-	messages := mailinatorParseMessages(data)
-	if len(messages) == 0 {
-		return []NormEmail{}, nil
+	return mailinatorParseMessages(data), nil
+}
+
+func mailinatorMessageID(msg map[string]any) string {
+	messageID := strings.TrimSpace(mailinatorToString(msg["id"]))
+	if messageID == "" {
+		messageID = strings.TrimSpace(mailinatorToString(msg["messageId"]))
 	}
+	return messageID
+}
 
-	out := make([]NormEmail, 0, len(messages))
-	for _, msg := range messages {
-		messageID := strings.TrimSpace(mailinatorToString(msg["id"]))
-		if messageID == "" {
-			messageID = strings.TrimSpace(mailinatorToString(msg["messageId"]))
-		}
+/* mailinatorLoadMessage 拉取单封邮件的 text / texthtml / attachments 详情并归一化，失败的部分留空 */
+func mailinatorLoadMessage(ctx context.Context, msg map[string]any, email string) NormEmail {
+	messageID := mailinatorMessageID(msg)
 
-		textPayload := map[string]any{}
-		htmlPayload := map[string]any{}
-		attachmentsPayload := map[string]any{}
+	textPayload := map[string]any{}
+	htmlPayload := map[string]any{}
+	attachmentsPayload := map[string]any{}
 
-		if messageID != "" {
-			if payload, err := mailinatorFetchDetail(messageID, "text"); err == nil {
-				textPayload = payload
-			}
-			if payload, err := mailinatorFetchDetail(messageID, "texthtml"); err == nil {
-				htmlPayload = payload
-			}
-			if payload, err := mailinatorFetchDetail(messageID, "attachments"); err == nil {
-				attachmentsPayload = payload
-			}
+	if messageID != "" {
+		if payload, err := mailinatorFetchDetail(ctx, messageID, "text"); err == nil {
+			textPayload = payload
+		}
+		if payload, err := mailinatorFetchDetail(ctx, messageID, "texthtml"); err == nil {
+			htmlPayload = payload
+		}
+		if payload, err := mailinatorFetchDetail(ctx, messageID, "attachments"); err == nil {
+			attachmentsPayload = payload
 		}
-
-		flat := mailinatorFlattenMessage(msg, email, textPayload, htmlPayload, attachmentsPayload)
-		out = append(out, NormalizeMap(flat, email))
 	}
-	return out, nil
+
+	flat := mailinatorFlattenMessage(msg, email, textPayload, htmlPayload, attachmentsPayload)
+	return NormalizeMap(flat, email)
 }
@@ -1,8 +1,10 @@
 package provider
 
-func MailinatorzzmoooComGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "mailinatorzz-mooo-com", Email: randomStr(12) + "@mailinatorzz.mooo.com"}, nil
+import "context"
+
+func MailinatorzzmoooComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "mailinatorzz-mooo-com", Email: localPartOr(ctx, randomStr(12)) + "@mailinatorzz.mooo.com"}, nil
 }
-func MailinatorzzmoooComGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func MailinatorzzmoooComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
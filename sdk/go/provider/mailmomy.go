@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -30,10 +31,10 @@ func mailmomyRandomLocal(length int) string {
 
 // mailmomyPickDomain 拉取当前可用域名池并随机选取。
 // GET /api/domains/active → JSON 字符串数组；请求失败或列表为空时回退 mailmomy.com。
-func mailmomyPickDomain() string {
+func mailmomyPickDomain(ctx context.Context) string {
 	const fallback = "mailmomy.com"
-	client := HTTPClient()
-	req, err := http.NewRequest("GET", mailmomyBaseURL+"/api/domains/active", nil)
+	client := HTTPClient(ctx)
+	req, err := http.NewRequestWithContext(ctx, "GET", mailmomyBaseURL+"/api/domains/active", nil)
 	if err != nil {
 		return fallback
 	}
@@ -71,8 +72,8 @@ func mailmomyPickDomain() string {
 }
 
 // MailmomyGenerate 创建 mailmomy.com 临时邮箱
-func MailmomyGenerate() (*CreatedMailbox, error) {
-	domain := mailmomyPickDomain()
+func MailmomyGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	domain := mailmomyPickDomain(ctx)
 	email := fmt.Sprintf("%s@%s", mailmomyRandomLocal(10), domain)
 	return &CreatedMailbox{
 		Channel: "mailmomy",
@@ -95,14 +96,14 @@ type mailmomyMessage struct {
 // MailmomyGetEmails 获取 mailmomy.com 邮件列表
 // GET /api/mail/messages?to=<email>&page=1&limit=20
 // 返回 {emails:[{id,recipient,from,subject,message,bodyText,receivedAt}], total, page, limit, pages}
-func MailmomyGetEmails(email string) ([]NormEmail, error) {
+func MailmomyGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	if email == "" {
 		return nil, fmt.Errorf("mailmomy: 缺少邮箱地址")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	u := fmt.Sprintf("%s/api/mail/messages?to=%s&page=1&limit=20", mailmomyBaseURL, url.QueryEscape(email))
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"html"
 	"io"
@@ -48,13 +49,13 @@ func mailnesiaDetailURL(local, id string) string {
 	return fmt.Sprintf("%s/%s", mailnesiaMailboxURL(local), url.PathEscape(id))
 }
 
-func mailnesiaGetText(u string) (string, error) {
-	req, err := http.NewRequest("GET", u, nil)
+func mailnesiaGetText(ctx context.Context, u string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return "", err
 	}
 	req.Header.Set("Accept", "text/html,*/*")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return "", err
 	}
@@ -64,7 +65,7 @@ func mailnesiaGetText(u string) (string, error) {
 		return "", err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return "", fmt.Errorf("mailnesia: http %d", resp.StatusCode)
+		return "", statusError(resp, "mailnesia: http %d", resp.StatusCode)
 	}
 	return string(body), nil
 }
@@ -139,12 +140,12 @@ func mailnesiaExtractPlain(page, id string) string {
 	return strings.TrimSpace(html.UnescapeString(m[1]))
 }
 
-func mailnesiaFetchDetail(local string, row map[string]any) map[string]any {
+func mailnesiaFetchDetail(ctx context.Context, local string, row map[string]any) map[string]any {
 	id := strings.TrimSpace(fmt.Sprint(row["id"]))
 	if id == "" {
 		return row
 	}
-	page, err := mailnesiaGetText(mailnesiaDetailURL(local, id))
+	page, err := mailnesiaGetText(ctx, mailnesiaDetailURL(local, id))
 	if err != nil {
 		return row
 	}
@@ -157,27 +158,27 @@ func mailnesiaFetchDetail(local string, row map[string]any) map[string]any {
 	return flat
 }
 
-func MailnesiaGenerate() (*CreatedMailbox, error) {
-	local := mailnesiaRandomLocal()
-	if _, err := mailnesiaGetText(mailnesiaMailboxURL(local)); err != nil {
+func MailnesiaGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, mailnesiaRandomLocal())
+	if _, err := mailnesiaGetText(ctx, mailnesiaMailboxURL(local)); err != nil {
 		return nil, err
 	}
 	return &CreatedMailbox{Channel: "mailnesia", Email: local + "@" + mailnesiaDomain}, nil
 }
 
-func MailnesiaGetEmails(email string) ([]NormEmail, error) {
+func MailnesiaGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	local := mailnesiaLocalPart(email)
 	if local == "" {
 		return nil, fmt.Errorf("mailnesia: empty email")
 	}
-	page, err := mailnesiaGetText(mailnesiaMailboxURL(local))
+	page, err := mailnesiaGetText(ctx, mailnesiaMailboxURL(local))
 	if err != nil {
 		return nil, err
 	}
 	rows := mailnesiaParseRows(page)
 	out := make([]NormEmail, 0, len(rows))
 	for _, row := range rows {
-		out = append(out, NormalizeMap(mailnesiaFetchDetail(local, row), email))
+		out = append(out, NormalizeMap(mailnesiaFetchDetail(ctx, local, row), email))
 	}
 	return out, nil
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -28,7 +29,7 @@ func mailtempCcHeaders(req *http.Request) {
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("Referer", "https://mailtemp.cc/")
 	req.Header.Set("Origin", "https://mailtemp.cc")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 }
 
 /*
@@ -39,17 +40,17 @@ func mailtempCcHeaders(req *http.Request) {
  *   3. 完整邮箱地址: {username}@neocea.com
  * token: 存储 username
  */
-func MailtempCcGenerate(channel ...string) (*CreatedMailbox, error) {
+func MailtempCcGenerate(ctx context.Context, channel ...string) (*CreatedMailbox, error) {
 	form := url.Values{}
 	form.Set("action", "inbox")
 
-	req, err := http.NewRequest("POST", mailtempCcAPIURL, strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", mailtempCcAPIURL, strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("mailtemp-cc: 创建请求失败: %w", err)
 	}
 	mailtempCcHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("mailtemp-cc: 请求创建邮箱失败: %w", err)
 	}
@@ -96,7 +97,7 @@ func MailtempCcGenerate(channel ...string) (*CreatedMailbox, error) {
  *   2. 对每封邮件 POST api.php body: action=view&id={id}&inbox={token} 获取 body_html
  *   3. 将详情中的 body_html 合入邮件数据后归一化
  */
-func MailtempCcGetEmails(email, token string) ([]NormEmail, error) {
+func MailtempCcGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("mailtemp-cc: 邮箱地址为空")
@@ -112,13 +113,13 @@ func MailtempCcGetEmails(email, token string) ([]NormEmail, error) {
 	form.Set("inbox", token)
 	form.Set("last_id", "0")
 
-	req, err := http.NewRequest("POST", mailtempCcAPIURL, strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", mailtempCcAPIURL, strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("mailtemp-cc: 创建获取邮件请求失败: %w", err)
 	}
 	mailtempCcHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("mailtemp-cc: 请求获取邮件失败: %w", err)
 	}
@@ -158,7 +159,7 @@ func MailtempCcGetEmails(email, token string) ([]NormEmail, error) {
 
 		/* 获取邮件详情 */
 		if mailID != "" {
-			detail, detailErr := mailtempCcViewEmail(token, mailID)
+			detail, detailErr := mailtempCcViewEmail(ctx, token, mailID)
 			if detailErr == nil && detail != nil {
 				/* 将详情中的 body_html 合入 */
 				if htmlBody, ok := detail["body_html"]; ok {
@@ -195,19 +196,19 @@ func MailtempCcGetEmails(email, token string) ([]NormEmail, error) {
  * POST api.php body: action=view&id={id}&inbox={username}
  * 返回 JSON 对象 {id, subject, sender, sender_email, received_at, body_html, advertisement}
  */
-func mailtempCcViewEmail(inbox, mailID string) (map[string]interface{}, error) {
+func mailtempCcViewEmail(ctx context.Context, inbox, mailID string) (map[string]interface{}, error) {
 	form := url.Values{}
 	form.Set("action", "view")
 	form.Set("id", mailID)
 	form.Set("inbox", inbox)
 
-	req, err := http.NewRequest("POST", mailtempCcAPIURL, strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", mailtempCcAPIURL, strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("mailtemp-cc: 创建查看邮件请求失败: %w", err)
 	}
 	mailtempCcHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("mailtemp-cc: 请求查看邮件失败: %w", err)
 	}
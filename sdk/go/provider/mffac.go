@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -29,14 +30,14 @@ func mffacDefaultHeaders(req *http.Request) {
 	req.Header.Set("sec-fetch-site", "same-origin")
 }
 
-func MffacGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest("POST", mffacAPIBase+"/mailboxes", bytes.NewReader([]byte(`{"expiresInHours":24}`)))
+func MffacGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", mffacAPIBase+"/mailboxes", bytes.NewReader([]byte(`{"expiresInHours":24}`)))
 	if err != nil {
 		return nil, err
 	}
 	mffacDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("mffac generate: %w", err)
 	}
@@ -47,7 +48,7 @@ func MffacGenerate() (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mffac generate: %d", resp.StatusCode)
+		return nil, statusError(resp, "mffac generate: %d", resp.StatusCode)
 	}
 
 	var parsed struct {
@@ -121,16 +122,16 @@ func mffacFlattenEmail(raw map[string]any, recipient string) map[string]any {
 	return flat
 }
 
-func mffacFetchEmailDetail(id string) (map[string]any, error) {
+func mffacFetchEmailDetail(ctx context.Context, id string) (map[string]any, error) {
 	u := fmt.Sprintf("%s/emails/%s", mffacAPIBase, url.PathEscape(id))
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	mffacDefaultHeaders(req)
 	req.Header.Del("Content-Type")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +142,7 @@ func mffacFetchEmailDetail(id string) (map[string]any, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mffac detail: %d", resp.StatusCode)
+		return nil, statusError(resp, "mffac detail: %d", resp.StatusCode)
 	}
 
 	var parsed struct {
@@ -157,20 +158,20 @@ func mffacFetchEmailDetail(id string) (map[string]any, error) {
 	return parsed.Email, nil
 }
 
-func MffacGetEmails(email string, _token string) ([]NormEmail, error) {
+func MffacGetEmails(ctx context.Context, email string, _token string) ([]NormEmail, error) {
 	local := email
 	if i := strings.LastIndex(email, "@"); i > 0 {
 		local = email[:i]
 	}
 	u := fmt.Sprintf("%s/mailboxes/%s/emails", mffacAPIBase, local)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	mffacDefaultHeaders(req)
 	req.Header.Del("Content-Type")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("mffac emails: %w", err)
 	}
@@ -181,7 +182,7 @@ func MffacGetEmails(email string, _token string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mffac emails: %d", resp.StatusCode)
+		return nil, statusError(resp, "mffac emails: %d", resp.StatusCode)
 	}
 
 	var parsed struct {
@@ -206,7 +207,7 @@ func MffacGetEmails(email string, _token string) ([]NormEmail, error) {
 		detail := raw
 		id := strings.TrimSpace(fmt.Sprint(raw["id"]))
 		if id != "" {
-			if fetched, err := mffacFetchEmailDetail(id); err == nil {
+			if fetched, err := mffacFetchEmailDetail(ctx, id); err == nil {
 				detail = fetched
 			}
 		}
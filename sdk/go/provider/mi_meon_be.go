@@ -1,15 +1,17 @@
 package provider
 
+import "context"
+
 // mi.meon.be：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
-func MiMeonBeGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func MiMeonBeGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "mi-meon-be",
 		Email:   local + "@mi.meon.be",
 	}, nil
 }
 
-func MiMeonBeGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func MiMeonBeGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
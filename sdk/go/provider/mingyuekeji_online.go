@@ -1,8 +1,12 @@
 package provider
 
-func MingyuekejiOnlineGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func MingyuekejiOnlineGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@mingyuekeji.online"
 	return &CreatedMailbox{Channel: "mingyuekeji-online", Email: email, Token: email}, nil
 }
-func MingyuekejiOnlineGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func MingyuekejiOnlineGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
@@ -1,8 +1,12 @@
 package provider
 
-func MingyuemingClickGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func MingyuemingClickGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@mingyueming.click"
 	return &CreatedMailbox{Channel: "mingyueming-click", Email: email, Token: email}, nil
 }
-func MingyuemingClickGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func MingyuemingClickGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
@@ -1,8 +1,12 @@
 package provider
 
-func MingyuemingShopGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func MingyuemingShopGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@mingyueming.shop"
 	return &CreatedMailbox{Channel: "mingyueming-shop", Email: email, Token: email}, nil
 }
-func MingyuemingShopGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func MingyuemingShopGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
@@ -1,8 +1,12 @@
 package provider
 
-func MingyukejiLolGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func MingyukejiLolGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@mingyukeji.lol"
 	return &CreatedMailbox{Channel: "mingyukeji-lol", Email: email, Token: email}, nil
 }
-func MingyukejiLolGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func MingyukejiLolGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
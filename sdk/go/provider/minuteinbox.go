@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -61,15 +62,15 @@ func minuteinboxDecodeSession(token string) (minuteinboxSession, error) {
  * token: JSON {"phpsessid":"...","csrf":"..."}
  * duration/domain 参数保留用于接口一致性，当前未使用
  */
-func MinuteinboxGenerate(duration int, domain string) (*CreatedMailbox, error) {
-	client := HTTPClient()
+func MinuteinboxGenerate(ctx context.Context, duration int, domain string) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	// 步骤 1：GET / 获取 PHPSESSID 和 CSRF token
-	req, err := http.NewRequest("GET", minuteinboxBaseURL+"/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", minuteinboxBaseURL+"/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("minuteinbox: 创建首页请求失败: %w", err)
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 
 	resp, err := client.Do(req)
@@ -107,11 +108,11 @@ func MinuteinboxGenerate(duration int, domain string) (*CreatedMailbox, error) {
 
 	// 步骤 2：GET /index/index?csrf_token={csrf} 创建邮箱
 	createURL := fmt.Sprintf("%s/index/index?csrf_token=%s", minuteinboxBaseURL, url.QueryEscape(csrf))
-	req2, err := http.NewRequest("GET", createURL, nil)
+	req2, err := http.NewRequestWithContext(ctx, "GET", createURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("minuteinbox: 创建邮箱请求失败: %w", err)
 	}
-	req2.Header.Set("User-Agent", getCurrentUA())
+	req2.Header.Set("User-Agent", getCurrentUA(ctx))
 	req2.Header.Set("X-Requested-With", "XMLHttpRequest")
 	req2.Header.Set("Cookie", "PHPSESSID="+phpsessid)
 
@@ -158,7 +159,7 @@ func MinuteinboxGenerate(duration int, domain string) (*CreatedMailbox, error) {
  *   2. 对每封邮件 POST /index/email (body: id=X) 获取详情
  * 字段说明（捷克语）: predmet=subject, od=from, id=邮件ID, kdy=when, precteno=read status
  */
-func MinuteinboxGetEmails(token, email string) ([]NormEmail, error) {
+func MinuteinboxGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	sess, err := minuteinboxDecodeSession(token)
 	if err != nil {
 		return nil, err
@@ -169,15 +170,15 @@ func MinuteinboxGetEmails(token, email string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("minuteinbox: 邮箱地址为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	cookieHeader := "PHPSESSID=" + sess.PHPSESSID
 
 	// 步骤 1：GET /index/refresh 获取邮件列表
-	req, err := http.NewRequest("GET", minuteinboxBaseURL+"/index/refresh", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", minuteinboxBaseURL+"/index/refresh", nil)
 	if err != nil {
 		return nil, fmt.Errorf("minuteinbox: 创建刷新请求失败: %w", err)
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
 	req.Header.Set("Cookie", cookieHeader)
 
@@ -222,7 +223,7 @@ func MinuteinboxGetEmails(token, email string) ([]NormEmail, error) {
 	emails := make([]NormEmail, 0, len(mailList))
 	for _, item := range mailList {
 		mailID := item.ID.String()
-		detail := minuteinboxFetchDetail(client, cookieHeader, mailID)
+		detail := minuteinboxFetchDetail(ctx, client, cookieHeader, mailID)
 
 		isRead := item.Precteno != "new"
 
@@ -268,7 +269,7 @@ type minuteinboxDetail struct {
 }
 
 // minuteinboxFetchDetail 通过 POST /index/email 获取单封邮件详情
-func minuteinboxFetchDetail(client interface {
+func minuteinboxFetchDetail(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }, cookieHeader, mailID string) *minuteinboxDetail {
 	if mailID == "" {
@@ -276,11 +277,11 @@ func minuteinboxFetchDetail(client interface {
 	}
 
 	formData := "id=" + url.QueryEscape(mailID)
-	req, err := http.NewRequest("POST", minuteinboxBaseURL+"/index/email", strings.NewReader(formData))
+	req, err := http.NewRequestWithContext(ctx, "POST", minuteinboxBaseURL+"/index/email", strings.NewReader(formData))
 	if err != nil {
 		return nil
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Cookie", cookieHeader)
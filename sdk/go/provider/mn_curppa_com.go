@@ -1,6 +1,10 @@
 package provider
 
-func MnCurppaComGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "mn-curppa-com", Email: randomStr(12) + "@mn.curppa.com"}, nil
+import "context"
+
+func MnCurppaComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "mn-curppa-com", Email: localPartOr(ctx, randomStr(12)) + "@mn.curppa.com"}, nil
+}
+func MnCurppaComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func MnCurppaComGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
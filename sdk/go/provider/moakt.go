@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
@@ -179,31 +180,31 @@ func moaktEncodeSess(s *moaktSess) (string, error) {
 
 func moaktDecodeSess(tok string) (*moaktSess, error) {
 	if !strings.HasPrefix(tok, moaktTokPrefix) {
-		return nil, fmt.Errorf("moakt: invalid session token")
+		return nil, markError(ErrInvalidToken, "moakt: invalid session token")
 	}
 	raw, err := base64.StdEncoding.DecodeString(tok[len(moaktTokPrefix):])
 	if err != nil {
-		return nil, fmt.Errorf("moakt: invalid session token")
+		return nil, markError(ErrInvalidToken, "moakt: invalid session token")
 	}
 	var s moaktSess
 	if err := json.Unmarshal(raw, &s); err != nil {
-		return nil, fmt.Errorf("moakt: invalid session token")
+		return nil, markError(ErrInvalidToken, "moakt: invalid session token")
 	}
 	if s.CookieHdr == "" || s.Locale == "" {
-		return nil, fmt.Errorf("moakt: invalid session token")
+		return nil, markError(ErrInvalidToken, "moakt: invalid session token")
 	}
 	return &s, nil
 }
 
-func moaktHTTPClient() tls_client.HttpClient {
+func moaktHTTPClient(ctx context.Context) tls_client.HttpClient {
 	if HTTPClientNoCookieJar != nil {
-		return HTTPClientNoCookieJar()
+		return HTTPClientNoCookieJar(ctx)
 	}
-	return HTTPClient()
+	return HTTPClient(ctx)
 }
 
 func moaktSetPageHeaders(req *http.Request, referer string) {
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(req.Context()))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8,en-GB;q=0.7,en-US;q=0.6")
 	req.Header.Set("Cache-Control", "no-cache")
@@ -276,13 +277,13 @@ func moaktParseMessageHTML(page string, id string, recipient string) map[string]
 // MoaktGenerate GET /{locale} 再 POST /{locale}/inbox（random=1，不跟重定向取 tm_session）
 // 再 GET /{locale}/inbox 解析 #email-address；token 内为 Cookie 快照。
 // opts.Domain 为语言路径（如 zh、en），默认 zh。
-func MoaktGenerate(domain *string) (*CreatedMailbox, error) {
+func MoaktGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
 	loc, mailDomain := moaktRequestParts(domain)
 	base := moaktOrigin + "/" + url.PathEscape(loc)
 	inbox := base + "/inbox"
-	client := moaktHTTPClient()
+	client := moaktHTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", base, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", base, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -320,8 +321,8 @@ func MoaktGenerate(domain *string) (*CreatedMailbox, error) {
 	}
 
 	// POST /{locale}/inbox with random=1，不跟随重定向以获取 tm_session cookie
-	noRedirectClient := HTTPClientNoRedirect()
-	req2, err := http.NewRequest("POST", inbox, strings.NewReader(form.Encode()))
+	noRedirectClient := HTTPClientNoRedirect(ctx)
+	req2, err := http.NewRequestWithContext(ctx, "POST", inbox, strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, err
 	}
@@ -341,7 +342,7 @@ func MoaktGenerate(domain *string) (*CreatedMailbox, error) {
 	}
 
 	// GET /{locale}/inbox 解析邮箱地址
-	req3, err := http.NewRequest("GET", inbox, nil)
+	req3, err := http.NewRequestWithContext(ctx, "GET", inbox, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -381,16 +382,16 @@ func MoaktGenerate(domain *string) (*CreatedMailbox, error) {
 }
 
 // MoaktGetEmails 拉取收件箱链接后逐封 GET .../email/{id}/html 解析正文。
-func MoaktGetEmails(email, token string) ([]NormEmail, error) {
+func MoaktGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	sess, err := moaktDecodeSess(token)
 	if err != nil {
 		return nil, err
 	}
 	loc := sess.Locale
 	inbox := moaktOrigin + "/" + url.PathEscape(loc) + "/inbox"
-	client := moaktHTTPClient()
+	client := moaktHTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", inbox, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", inbox, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -412,7 +413,7 @@ func MoaktGetEmails(email, token string) ([]NormEmail, error) {
 	out := make([]NormEmail, 0, len(ids))
 	for _, id := range ids {
 		detailURL := moaktOrigin + "/" + url.PathEscape(loc) + "/email/" + url.PathEscape(id) + "/html"
-		reqd, err := http.NewRequest("GET", detailURL, nil)
+		reqd, err := http.NewRequestWithContext(ctx, "GET", detailURL, nil)
 		if err != nil {
 			continue
 		}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"html"
 	"io"
@@ -86,7 +87,7 @@ func mohmalExtractBodyHTML(page, className string) string {
 
 /* mohmalBrowserHeaders 设置浏览器模拟请求头 */
 func mohmalBrowserHeaders(req *http.Request, referer string) {
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(req.Context()))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 	req.Header.Set("Cache-Control", "no-cache")
@@ -137,12 +138,12 @@ func mohmalMergeCookies(existing string, cookies []*http.Cookie) string {
  *   2. 从 /en/inbox 页面 HTML 中提取 data-email 属性获得邮箱地址
  * token: connect.sid cookie 字符串
  */
-func MohmalGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func MohmalGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	/* 第一步: GET /en/create/random，跟随重定向到 /en/inbox */
 	createURL := mohmalBase + "/en/create/random"
-	req, err := http.NewRequest("GET", createURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", createURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +174,7 @@ func MohmalGenerate() (*CreatedMailbox, error) {
 	/* 若重定向后的页面未包含邮箱，尝试单独请求 /en/inbox */
 	if emailAddr == "" {
 		inboxURL := mohmalBase + "/en/inbox"
-		req2, err := http.NewRequest("GET", inboxURL, nil)
+		req2, err := http.NewRequestWithContext(ctx, "GET", inboxURL, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -226,7 +227,7 @@ func mohmalExtractEmail(htmlStr string) string {
  *   2. 对每封邮件 GET /en/message/{id} 获取详情
  * token: connect.sid cookie 字符串
  */
-func MohmalGetEmails(email string, token string) ([]NormEmail, error) {
+func MohmalGetEmails(ctx context.Context, email string, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("mohmal: 邮箱地址为空")
@@ -235,11 +236,11 @@ func MohmalGetEmails(email string, token string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("mohmal: session cookie 为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	inboxURL := mohmalBase + "/en/inbox"
 
 	/* 请求收件箱页面 */
-	req, err := http.NewRequest("GET", inboxURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", inboxURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +327,7 @@ func MohmalGetEmails(email string, token string) ([]NormEmail, error) {
 	/* 逐封获取邮件详情 */
 	emails := make([]NormEmail, 0, len(msgs))
 	for _, msg := range msgs {
-		raw := mohmalFetchDetail(client, token, msg.id, email)
+		raw := mohmalFetchDetail(ctx, client, token, msg.id, email)
 
 		/* 使用收件箱行数据补充缺失字段 */
 		if rd, ok := rowData[msg.id]; ok {
@@ -348,13 +349,13 @@ func MohmalGetEmails(email string, token string) ([]NormEmail, error) {
 }
 
 /* mohmalFetchDetail 获取单封邮件详情页 */
-func mohmalFetchDetail(client interface {
+func mohmalFetchDetail(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }, cookie string, id string, recipient string) map[string]interface{} {
 	raw := map[string]interface{}{"id": id, "to": recipient}
 
 	detailURL := mohmalBase + "/en/message/" + id
-	req, err := http.NewRequest("GET", detailURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", detailURL, nil)
 	if err != nil {
 		return raw
 	}
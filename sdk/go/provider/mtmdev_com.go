@@ -1,15 +1,17 @@
 package provider
 
+import "context"
+
 // mtmdev.com：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
-func MtmdevComGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func MtmdevComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "mtmdev-com",
 		Email:   local + "@mtmdev.com",
 	}, nil
 }
 
-func MtmdevComGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func MtmdevComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -71,7 +72,7 @@ func mytempmailCcRandomName() string {
  * MytempmailCcGenerate — 创建临时邮箱
  * POST /api/address，生成随机用户名，域名固定为 nilvaro.com，有效期 600 秒
  */
-func MytempmailCcGenerate() (*CreatedMailbox, error) {
+func MytempmailCcGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	reqBody := mytempmailCcCreateRequest{
 		Domain: "nilvaro.com",
 		Name:   mytempmailCcRandomName(),
@@ -82,14 +83,14 @@ func MytempmailCcGenerate() (*CreatedMailbox, error) {
 		return nil, fmt.Errorf("mytempmail-cc 序列化请求体失败: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", mytempmailCcBase+"/api/address", strings.NewReader(string(bodyBytes)))
+	req, err := http.NewRequestWithContext(ctx, "POST", mytempmailCcBase+"/api/address", strings.NewReader(string(bodyBytes)))
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("mytempmail-cc 创建请求失败: %w", err)
 	}
@@ -101,7 +102,7 @@ func MytempmailCcGenerate() (*CreatedMailbox, error) {
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mytempmail-cc 创建: HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "mytempmail-cc 创建: HTTP %d", resp.StatusCode)
 	}
 
 	var data mytempmailCcCreateResponse
@@ -127,27 +128,27 @@ func MytempmailCcGenerate() (*CreatedMailbox, error) {
  * GET /api/mails/<token>，解析 results 数组
  * 每条消息构造为 map[string]any 后调用 NormalizeMap 归一化
  */
-func MytempmailCcGetEmails(token string, email string) ([]NormEmail, error) {
+func MytempmailCcGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	token = strings.TrimSpace(token)
 	if token == "" {
 		return nil, fmt.Errorf("mytempmail-cc: token 为空")
 	}
 
 	u := fmt.Sprintf("%s/api/mails/%s", mytempmailCcBase, token)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("mytempmail-cc 获取邮件请求失败: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("mytempmail-cc 获取邮件: HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "mytempmail-cc 获取邮件: HTTP %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
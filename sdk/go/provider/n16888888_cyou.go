@@ -1,8 +1,12 @@
 package provider
 
-func N16888888CyouGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func N16888888CyouGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@16888888.cyou"
 	return &CreatedMailbox{Channel: "16888888-cyou", Email: email, Token: email}, nil
 }
-func N16888888CyouGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func N16888888CyouGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
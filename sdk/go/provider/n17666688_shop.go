@@ -1,8 +1,12 @@
 package provider
 
-func N17666688ShopGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func N17666688ShopGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@17666688.shop"
 	return &CreatedMailbox{Channel: "17666688-shop", Email: email, Token: email}, nil
 }
-func N17666688ShopGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func N17666688ShopGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
@@ -1,8 +1,12 @@
 package provider
 
-func N282mailComGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func N282mailComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@282mail.com"
 	return &CreatedMailbox{Channel: "282mail-com", Email: email, Token: email}, nil
 }
-func N282mailComGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func N282mailComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
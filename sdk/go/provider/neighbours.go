@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
@@ -29,8 +30,8 @@ type neighboursInboxResponse struct {
 	Data []map[string]any `json:"data"`
 }
 
-func neighboursRequest(path string, allowNotFound bool) ([]byte, error) {
-	req, err := http.NewRequest(http.MethodGet, neighboursBaseURL+path, nil)
+func neighboursRequest(ctx context.Context, path string, allowNotFound bool) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, neighboursBaseURL+path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +39,7 @@ func neighboursRequest(path string, allowNotFound bool) ([]byte, error) {
 		req.Header.Set(k, v)
 	}
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -52,13 +53,13 @@ func neighboursRequest(path string, allowNotFound bool) ([]byte, error) {
 		return nil, nil
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("neighbours http %d", resp.StatusCode)
+		return nil, statusError(resp, "neighbours http %d", resp.StatusCode)
 	}
 	return raw, nil
 }
 
-func neighboursJSON(path string, out any, allowNotFound bool) error {
-	raw, err := neighboursRequest(path, allowNotFound)
+func neighboursJSON(ctx context.Context, path string, out any, allowNotFound bool) error {
+	raw, err := neighboursRequest(ctx, path, allowNotFound)
 	if err != nil {
 		return err
 	}
@@ -150,8 +151,8 @@ func neighboursFlattenMessage(raw map[string]any, recipient string) map[string]a
 	}
 }
 
-func neighboursDetail(address, uid string) map[string]any {
-	raw, err := neighboursRequest("/inbox/"+url.PathEscape(address)+"/"+url.PathEscape(uid), true)
+func neighboursDetail(ctx context.Context, address, uid string) map[string]any {
+	raw, err := neighboursRequest(ctx, "/inbox/"+url.PathEscape(address)+"/"+url.PathEscape(uid), true)
 	if err != nil || raw == nil {
 		return nil
 	}
@@ -167,9 +168,9 @@ func neighboursDetail(address, uid string) map[string]any {
 	return data.Data
 }
 
-func NeighboursGenerate(domain *string) (*CreatedMailbox, error) {
+func NeighboursGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
 	var data neighboursDomainListResponse
-	if err := neighboursJSON("/config/domains", &data, false); err != nil {
+	if err := neighboursJSON(ctx, "/config/domains", &data, false); err != nil {
 		return nil, err
 	}
 	domains := make([]string, 0, len(data.Data.Domains))
@@ -205,14 +206,14 @@ func NeighboursGenerate(domain *string) (*CreatedMailbox, error) {
 	}, nil
 }
 
-func NeighboursGetEmails(email string) ([]NormEmail, error) {
+func NeighboursGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("neighbours: empty email")
 	}
 
 	var data neighboursInboxResponse
-	if err := neighboursJSON("/inbox/"+url.PathEscape(address), &data, true); err != nil {
+	if err := neighboursJSON(ctx, "/inbox/"+url.PathEscape(address), &data, true); err != nil {
 		return nil, err
 	}
 	if len(data.Data) == 0 {
@@ -223,7 +224,7 @@ func NeighboursGetEmails(email string) ([]NormEmail, error) {
 	for _, row := range data.Data {
 		raw := row
 		if uid := neighboursAnyString(row["uid"]); uid != "" {
-			if detail := neighboursDetail(address, uid); detail != nil {
+			if detail := neighboursDetail(ctx, address, uid); detail != nil {
 				raw = detail
 			}
 		}
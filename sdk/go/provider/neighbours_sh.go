@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -65,13 +66,13 @@ func neighboursShRandomUsername() string {
 /*
  * neighboursShGetJSON 使用 SDK 共享客户端发起 GET 请求并读取响应体
  */
-func neighboursShGetJSON(u string) ([]byte, int, error) {
-	req, err := http.NewRequest(http.MethodGet, u, nil)
+func neighboursShGetJSON(ctx context.Context, u string) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return nil, 0, err
 	}
 	req.Header.Set("Accept", "application/json")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -87,8 +88,8 @@ func neighboursShGetJSON(u string) ([]byte, int, error) {
  * NeighboursShGenerate 创建 neighbours.sh 临时邮箱
  * 公共收件箱模式，任意用户名即可收信，无需 API 调用，Token 存邮箱地址本身
  */
-func NeighboursShGenerate() (*CreatedMailbox, error) {
-	email := neighboursShRandomUsername() + "@" + neighboursShDomain
+func NeighboursShGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	email := localPartOr(ctx, neighboursShRandomUsername()) + "@" + neighboursShDomain
 	return &CreatedMailbox{
 		Channel: "neighbours-sh",
 		Email:   email,
@@ -134,7 +135,7 @@ func neighboursShFlatten(detail *neighboursShDetailResponse, recipient string) m
  * @param token - 邮箱地址
  * @param email - 邮箱地址
  */
-func NeighboursShGetEmails(token, email string) ([]NormEmail, error) {
+func NeighboursShGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(token)
 	if address == "" {
 		address = strings.TrimSpace(email)
@@ -144,12 +145,12 @@ func NeighboursShGetEmails(token, email string) ([]NormEmail, error) {
 	}
 
 	listURL := fmt.Sprintf("%s/inbox/%s", neighboursShBase, url.PathEscape(address))
-	body, status, err := neighboursShGetJSON(listURL)
+	body, status, err := neighboursShGetJSON(ctx, listURL)
 	if err != nil {
 		return nil, err
 	}
 	if status < 200 || status >= 300 {
-		return nil, fmt.Errorf("neighbours-sh: 获取邮件列表失败 http %d", status)
+		return nil, statusCodeError(status, "neighbours-sh: 获取邮件列表失败 http %d", status)
 	}
 	var list neighboursShListResponse
 	if err := json.Unmarshal(body, &list); err != nil {
@@ -163,7 +164,7 @@ func NeighboursShGetEmails(token, email string) ([]NormEmail, error) {
 		}
 		/* 详情接口返回完整正文 */
 		detailURL := fmt.Sprintf("%s/inbox/%s/%d", neighboursShBase, url.PathEscape(address), *row.UID)
-		detailBody, detailStatus, err := neighboursShGetJSON(detailURL)
+		detailBody, detailStatus, err := neighboursShGetJSON(ctx, detailURL)
 		if err != nil || detailStatus < 200 || detailStatus >= 300 {
 			continue
 		}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,17 +26,17 @@ func nimailRandomLocal(length int) string {
 }
 
 // NimailGenerate 创建 nimail.cn 临时邮箱
-func NimailGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func NimailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 	name := nimailRandomLocal(10)
 	email := fmt.Sprintf("%s@nimail.cn", name)
 
 	body := fmt.Sprintf("mail=%s", url.QueryEscape(email))
-	req, err := http.NewRequest("POST", nimailBaseURL+"/api/applymail", strings.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", nimailBaseURL+"/api/applymail", strings.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Origin", nimailBaseURL)
 	req.Header.Set("Referer", nimailBaseURL+"/")
@@ -73,15 +74,15 @@ func NimailGenerate() (*CreatedMailbox, error) {
 }
 
 // NimailGetEmails 获取 nimail.cn 邮件列表
-func NimailGetEmails(email string) ([]NormEmail, error) {
-	client := HTTPClient()
+func NimailGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	client := HTTPClient(ctx)
 
 	body := fmt.Sprintf("mail=%s&time=0", url.QueryEscape(email))
-	req, err := http.NewRequest("POST", nimailBaseURL+"/api/getmails", strings.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", nimailBaseURL+"/api/getmails", strings.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Origin", nimailBaseURL)
 	req.Header.Set("Referer", nimailBaseURL+"/")
@@ -1,6 +1,10 @@
 package provider
 
-func Notfond404MnGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "notfond-404-mn", Email: randomStr(12) + "@notfond.404.mn"}, nil
+import "context"
+
+func Notfond404MnGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "notfond-404-mn", Email: localPartOr(ctx, randomStr(12)) + "@notfond.404.mn"}, nil
+}
+func Notfond404MnGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func Notfond404MnGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
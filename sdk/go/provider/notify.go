@@ -0,0 +1,47 @@
+package provider
+
+import "sync"
+
+/*
+ * mailNotifier 新邮件到达通知
+ * WebSocket / Socket.IO 等推送型渠道在读循环收到新邮件时调用 notify，
+ * 订阅方（tempemail.Watch）收到信号后立即拉取收件箱，无需等待下一个轮询周期。
+ * 通知通道容量为 1，信号合并且非阻塞，慢订阅方不会拖住读循环。
+ */
+type mailNotifier struct {
+	mu   sync.Mutex
+	subs map[chan struct{}]struct{}
+}
+
+/* subscribe 注册订阅，返回通知通道与取消函数（取消后通道关闭） */
+func (n *mailNotifier) subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	n.mu.Lock()
+	if n.subs == nil {
+		n.subs = make(map[chan struct{}]struct{})
+	}
+	n.subs[ch] = struct{}{}
+	n.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			n.mu.Lock()
+			delete(n.subs, ch)
+			n.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+/* notify 向所有订阅方发送信号，已有未消费信号时直接合并 */
+func (n *mailNotifier) notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for ch := range n.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // notmailinator.com：mailinator 官方姊妹域名，MX 指向 mail.mailinator.com。
 
 // NotmailinatorComGenerate 创建 notmailinator.com 临时邮箱
-func NotmailinatorComGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func NotmailinatorComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "notmailinator-com",
 		Email:   local + "@notmailinator.com",
@@ -12,6 +14,6 @@ func NotmailinatorComGenerate() (*CreatedMailbox, error) {
 }
 
 // NotmailinatorComGetEmails 读取邮件（复用 mailinator public API）
-func NotmailinatorComGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func NotmailinatorComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
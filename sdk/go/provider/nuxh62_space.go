@@ -1,8 +1,12 @@
 package provider
 
-func Nuxh62SpaceGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func Nuxh62SpaceGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@nuxh62.space"
 	return &CreatedMailbox{Channel: "nuxh62-space", Email: email, Token: email}, nil
 }
-func Nuxh62SpaceGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func Nuxh62SpaceGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
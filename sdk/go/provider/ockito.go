@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -26,12 +27,12 @@ func ockitoAnyString(v any) string {
 	}
 }
 
-func ockitoJSON(method, u string, headers map[string]string, body []byte) (int, map[string]any, error) {
+func ockitoJSON(ctx context.Context, method, u string, headers map[string]string, body []byte) (int, map[string]any, error) {
 	var reader io.Reader
 	if body != nil {
 		reader = bytes.NewReader(body)
 	}
-	req, err := http.NewRequest(method, u, reader)
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
 	if err != nil {
 		return 0, nil, err
 	}
@@ -43,7 +44,7 @@ func ockitoJSON(method, u string, headers map[string]string, body []byte) (int,
 		req.Header.Set(k, v)
 	}
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return 0, nil, err
 	}
@@ -56,7 +57,7 @@ func ockitoJSON(method, u string, headers map[string]string, body []byte) (int,
 	var out map[string]any
 	if len(raw) > 0 {
 		if err := json.Unmarshal(raw, &out); err != nil {
-			return resp.StatusCode, nil, fmt.Errorf("ockito invalid JSON: %s HTTP %d", u, resp.StatusCode)
+			return resp.StatusCode, nil, statusError(resp, "ockito invalid JSON: %s HTTP %d", u, resp.StatusCode)
 		}
 	} else {
 		out = map[string]any{}
@@ -75,22 +76,22 @@ func ockitoEncodeToken(accessToken, refreshToken string) string {
 func ockitoDecodeToken(token string) (string, string, error) {
 	value := strings.TrimSpace(token)
 	if value == "" || !strings.HasPrefix(value, "{") {
-		return "", "", fmt.Errorf("ockito: invalid session token")
+		return "", "", markError(ErrInvalidToken, "ockito: invalid session token")
 	}
 	var payload map[string]any
 	if err := json.Unmarshal([]byte(value), &payload); err != nil {
-		return "", "", fmt.Errorf("ockito: invalid session token")
+		return "", "", markError(ErrInvalidToken, "ockito: invalid session token")
 	}
 	accessToken := ockitoAnyString(payload["access_token"])
 	refreshToken := ockitoAnyString(payload["refresh_token"])
 	if accessToken == "" || refreshToken == "" {
-		return "", "", fmt.Errorf("ockito: invalid session token")
+		return "", "", markError(ErrInvalidToken, "ockito: invalid session token")
 	}
 	return accessToken, refreshToken, nil
 }
 
-func ockitoRefreshAccessToken(refreshToken string) (string, error) {
-	status, data, err := ockitoJSON(http.MethodPost, ockitoBase+"/grefresh", map[string]string{
+func ockitoRefreshAccessToken(ctx context.Context, refreshToken string) (string, error) {
+	status, data, err := ockitoJSON(ctx, http.MethodPost, ockitoBase+"/grefresh", map[string]string{
 		"Authorization": "Bearer " + refreshToken,
 		"X-PASSTHROUGH": "Y",
 	}, nil)
@@ -98,7 +99,7 @@ func ockitoRefreshAccessToken(refreshToken string) (string, error) {
 		return "", err
 	}
 	if status < 200 || status >= 300 {
-		return "", fmt.Errorf("ockito grefresh http %d", status)
+		return "", statusCodeError(status, "ockito grefresh http %d", status)
 	}
 	accessToken := ockitoAnyString(data["access_token"])
 	if accessToken == "" {
@@ -107,20 +108,20 @@ func ockitoRefreshAccessToken(refreshToken string) (string, error) {
 	return accessToken, nil
 }
 
-func ockitoFetchBearerJSON(u string, accessTokenState *string, refreshToken string) (map[string]any, error) {
-	status, data, err := ockitoJSON(http.MethodGet, u, map[string]string{
+func ockitoFetchBearerJSON(ctx context.Context, u string, accessTokenState *string, refreshToken string) (map[string]any, error) {
+	status, data, err := ockitoJSON(ctx, http.MethodGet, u, map[string]string{
 		"Authorization": "Bearer " + *accessTokenState,
 	}, nil)
 	if err != nil {
 		return nil, err
 	}
 	if status == 401 {
-		refreshed, err := ockitoRefreshAccessToken(refreshToken)
+		refreshed, err := ockitoRefreshAccessToken(ctx, refreshToken)
 		if err != nil {
 			return nil, err
 		}
 		*accessTokenState = refreshed
-		status, data, err = ockitoJSON(http.MethodGet, u, map[string]string{
+		status, data, err = ockitoJSON(ctx, http.MethodGet, u, map[string]string{
 			"Authorization": "Bearer " + refreshed,
 		}, nil)
 		if err != nil {
@@ -128,7 +129,7 @@ func ockitoFetchBearerJSON(u string, accessTokenState *string, refreshToken stri
 		}
 	}
 	if status < 200 || status >= 300 {
-		return nil, fmt.Errorf("ockito http %d", status)
+		return nil, statusCodeError(status, "ockito http %d", status)
 	}
 	return data, nil
 }
@@ -191,13 +192,13 @@ func ockitoFlattenMessage(raw map[string]any, recipient string) map[string]any {
 	}
 }
 
-func OckitoGenerate() (*CreatedMailbox, error) {
-	status, login, err := ockitoJSON(http.MethodPost, ockitoBase+"/gtoken", nil, []byte("{}"))
+func OckitoGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	status, login, err := ockitoJSON(ctx, http.MethodPost, ockitoBase+"/gtoken", nil, []byte("{}"))
 	if err != nil {
 		return nil, err
 	}
 	if status < 200 || status >= 300 {
-		return nil, fmt.Errorf("ockito gtoken http %d", status)
+		return nil, statusCodeError(status, "ockito gtoken http %d", status)
 	}
 
 	accessToken := ockitoAnyString(login["access_token"])
@@ -206,14 +207,14 @@ func OckitoGenerate() (*CreatedMailbox, error) {
 		return nil, fmt.Errorf("ockito: invalid token response")
 	}
 
-	status, emailResp, err := ockitoJSON(http.MethodGet, ockitoBase+"/email", map[string]string{
+	status, emailResp, err := ockitoJSON(ctx, http.MethodGet, ockitoBase+"/email", map[string]string{
 		"Authorization": "Bearer " + accessToken,
 	}, nil)
 	if err != nil {
 		return nil, err
 	}
 	if status < 200 || status >= 300 {
-		return nil, fmt.Errorf("ockito email http %d", status)
+		return nil, statusCodeError(status, "ockito email http %d", status)
 	}
 
 	email := ockitoAnyString(emailResp["email"])
@@ -233,7 +234,7 @@ func OckitoGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func OckitoGetEmails(token, email string) ([]NormEmail, error) {
+func OckitoGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	accessToken, refreshToken, err := ockitoDecodeToken(token)
 	if err != nil {
 		return nil, err
@@ -243,7 +244,7 @@ func OckitoGetEmails(token, email string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("ockito: empty email")
 	}
 
-	data, err := ockitoFetchBearerJSON(
+	data, err := ockitoFetchBearerJSON(ctx,
 		ockitoBase+"/retrieve/"+url.PathEscape(address)+"/emails",
 		&accessToken,
 		refreshToken,
@@ -264,7 +265,7 @@ func OckitoGetEmails(token, email string) ([]NormEmail, error) {
 			continue
 		}
 
-		detail, err := ockitoFetchBearerJSON(
+		detail, err := ockitoFetchBearerJSON(ctx,
 			ockitoBase+"/retrieve/"+url.PathEscape(address)+"/"+url.PathEscape(uid),
 			&accessToken,
 			refreshToken,
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -21,14 +22,14 @@ func oneSecMailCookieValue(headers http.Header) string {
 	return ""
 }
 
-func OneSecMailGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest(http.MethodGet, oneSecMailBase+"generate", nil)
+func OneSecMailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, oneSecMailBase+"generate", nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "Mozilla/5.0")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +39,7 @@ func OneSecMailGenerate() (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("1sec-mail generate: %d", resp.StatusCode)
+		return nil, statusError(resp, "1sec-mail generate: %d", resp.StatusCode)
 	}
 	cookie := oneSecMailCookieValue(resp.Header)
 	if cookie == "" {
@@ -61,19 +62,19 @@ func OneSecMailGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func OneSecMailGetEmails(token, email string) ([]NormEmail, error) {
+func OneSecMailGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("1sec-mail: 邮箱地址为空")
 	}
-	req, err := http.NewRequest(http.MethodGet, oneSecMailBase+"emails?address="+address, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, oneSecMailBase+"emails?address="+address, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Cookie", token)
 	req.Header.Set("User-Agent", "Mozilla/5.0")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +84,7 @@ func OneSecMailGetEmails(token, email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("1sec-mail emails: %d", resp.StatusCode)
+		return nil, statusError(resp, "1sec-mail emails: %d", resp.StatusCode)
 	}
 	var rows []map[string]interface{}
 	if err := json.Unmarshal(body, &rows); err != nil {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -23,8 +24,8 @@ type openinboxListResponse struct {
 	Emails []map[string]any `json:"emails"`
 }
 
-func openinboxJSON(method, u string, out any) error {
-	req, err := http.NewRequest(method, u, nil)
+func openinboxJSON(ctx context.Context, method, u string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, u, nil)
 	if err != nil {
 		return err
 	}
@@ -35,7 +36,7 @@ func openinboxJSON(method, u string, out any) error {
 	if method == http.MethodPost {
 		req.Header.Set("Content-Type", "application/json")
 	}
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -45,7 +46,7 @@ func openinboxJSON(method, u string, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("openinbox: http %d", resp.StatusCode)
+		return statusError(resp, "openinbox: http %d", resp.StatusCode)
 	}
 	return json.Unmarshal(data, out)
 }
@@ -64,9 +65,9 @@ func openinboxFlatten(raw map[string]any, recipient string) map[string]any {
 	}
 }
 
-func OpeninboxGenerate() (*CreatedMailbox, error) {
+func OpeninboxGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	var data openinboxCreateResponse
-	if err := openinboxJSON(http.MethodPost, openinboxBase+"/inbox", &data); err != nil {
+	if err := openinboxJSON(ctx, http.MethodPost, openinboxBase+"/inbox", &data); err != nil {
 		return nil, err
 	}
 	id := strings.TrimSpace(data.ID)
@@ -83,16 +84,16 @@ func OpeninboxGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func openinboxFetchDetail(messageID string) (map[string]any, error) {
+func openinboxFetchDetail(ctx context.Context, messageID string) (map[string]any, error) {
 	var data map[string]any
 	u := openinboxBase + "/emails/" + url.PathEscape(messageID)
-	if err := openinboxJSON(http.MethodGet, u, &data); err != nil {
+	if err := openinboxJSON(ctx, http.MethodGet, u, &data); err != nil {
 		return nil, err
 	}
 	return data, nil
 }
 
-func OpeninboxGetEmails(inboxID, email string) ([]NormEmail, error) {
+func OpeninboxGetEmails(ctx context.Context, inboxID, email string) ([]NormEmail, error) {
 	box := strings.TrimSpace(inboxID)
 	address := strings.TrimSpace(email)
 	if box == "" {
@@ -103,14 +104,14 @@ func OpeninboxGetEmails(inboxID, email string) ([]NormEmail, error) {
 	}
 	u := fmt.Sprintf("%s/emails/inbox/%s?page=1&limit=50", openinboxBase, url.PathEscape(box))
 	var data openinboxListResponse
-	if err := openinboxJSON(http.MethodGet, u, &data); err != nil {
+	if err := openinboxJSON(ctx, http.MethodGet, u, &data); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(data.Emails))
 	for _, row := range data.Emails {
 		raw := row
 		if id := strings.TrimSpace(fmt.Sprintf("%v", row["id"])); id != "" {
-			if detail, err := openinboxFetchDetail(id); err == nil {
+			if detail, err := openinboxFetchDetail(ctx, id); err == nil {
 				raw = detail
 			}
 		}
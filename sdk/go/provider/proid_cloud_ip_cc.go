@@ -1,8 +1,12 @@
 package provider
 
-func ProidCloudIpCcGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func ProidCloudIpCcGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@proid.cloud-ip.cc"
 	return &CreatedMailbox{Channel: "proid-cloud-ip-cc", Email: email, Token: email}, nil
 }
-func ProidCloudIpCcGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func ProidCloudIpCcGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
@@ -1,6 +1,10 @@
 package provider
 
-func RamjaneMoooComGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "ramjane-mooo-com", Email: randomStr(12) + "@ramjane.mooo.com"}, nil
+import "context"
+
+func RamjaneMoooComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "ramjane-mooo-com", Email: localPartOr(ctx, randomStr(12)) + "@ramjane.mooo.com"}, nil
+}
+func RamjaneMoooComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func RamjaneMoooComGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
@@ -1,6 +1,10 @@
 package provider
 
-func RauxaSenyCatGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "rauxa-seny-cat", Email: randomStr(12) + "@rauxa.seny.cat"}, nil
+import "context"
+
+func RauxaSenyCatGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "rauxa-seny-cat", Email: localPartOr(ctx, randomStr(12)) + "@rauxa.seny.cat"}, nil
+}
+func RauxaSenyCatGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func RauxaSenyCatGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
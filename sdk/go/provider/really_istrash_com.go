@@ -1,15 +1,17 @@
 package provider
 
+import "context"
+
 // really.istrash.com：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
-func ReallyIstrashComGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func ReallyIstrashComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "really-istrash-com",
 		Email:   local + "@really.istrash.com",
 	}, nil
 }
 
-func ReallyIstrashComGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func ReallyIstrashComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
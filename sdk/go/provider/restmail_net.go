@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -31,17 +32,17 @@ func restmailNetRandomUsername() string {
 /*
  * restmailNetGetJSON 使用 SDK 共享客户端发起 GET 请求并返回响应体字节
  */
-func restmailNetGetJSON(u string) ([]byte, int, error) {
-	req, err := http.NewRequest(http.MethodGet, u, nil)
+func restmailNetGetJSON(ctx context.Context, u string) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return nil, 0, err
 	}
-	ua := getCurrentUA()
+	ua := getCurrentUA(ctx)
 	if ua != "" {
 		req.Header.Set("User-Agent", ua)
 	}
 	req.Header.Set("Accept", "application/json")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -58,8 +59,8 @@ func restmailNetGetJSON(u string) ([]byte, int, error) {
  * 公共收件箱模式（Mozilla 开源项目），随机生成用户名即可收信，无需注册
  * Token 存空字符串
  */
-func RestmailNetGenerate() (*CreatedMailbox, error) {
-	username := restmailNetRandomUsername()
+func RestmailNetGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	username := localPartOr(ctx, restmailNetRandomUsername())
 	email := username + "@" + restmailNetDomain
 	return &CreatedMailbox{
 		Channel: "restmail-net",
@@ -74,7 +75,7 @@ func RestmailNetGenerate() (*CreatedMailbox, error) {
  * @param token - 不使用（空字符串）
  * @param email - 邮箱地址
  */
-func RestmailNetGetEmails(token, email string) ([]NormEmail, error) {
+func RestmailNetGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("restmail-net: 缺少邮箱地址")
@@ -88,12 +89,12 @@ func RestmailNetGetEmails(token, email string) ([]NormEmail, error) {
 
 	/* 获取邮件列表 */
 	u := fmt.Sprintf("%s/mail/%s", restmailNetBase, username)
-	body, status, err := restmailNetGetJSON(u)
+	body, status, err := restmailNetGetJSON(ctx, u)
 	if err != nil {
 		return nil, err
 	}
 	if status < 200 || status >= 300 {
-		return nil, fmt.Errorf("restmail-net: 获取邮件列表失败 http %d", status)
+		return nil, statusCodeError(status, "restmail-net: 获取邮件列表失败 http %d", status)
 	}
 
 	/* 解析 JSON 数组 */
@@ -161,5 +162,10 @@ func restmailNetFlatten(msg map[string]interface{}, recipient string) map[string
 		flat["date"] = receivedAt
 	}
 
+	/* 完整邮件头 */
+	if headers, ok := msg["headers"].(map[string]interface{}); ok {
+		flat["headers"] = headers
+	}
+
 	return flat
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -23,7 +24,7 @@ const rootshDefaultDomain = "bccto.cc"
 
 /* rootshSetXHRHeaders 设置 AJAX 请求所需的通用请求头 */
 func rootshSetXHRHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "application/json, text/javascript, */*; q=0.01")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
@@ -46,15 +47,15 @@ func rootshRandomLocal() string {
  * 2. POST /applymail 申请邮箱地址
  * token 存储服务端返回的 time 值，用于后续增量获取邮件
  */
-func RootshGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func RootshGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	/* 步骤 1：GET / 获取 session cookie */
-	req, err := http.NewRequest("GET", rootshBaseURL+"/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", rootshBaseURL+"/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("rootsh: 创建首页请求失败: %w", err)
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 
@@ -76,7 +77,7 @@ func RootshGenerate() (*CreatedMailbox, error) {
 	form := url.Values{}
 	form.Set("mail", emailAddr)
 
-	req2, err := http.NewRequest("POST", rootshBaseURL+"/applymail", strings.NewReader(form.Encode()))
+	req2, err := http.NewRequestWithContext(ctx, "POST", rootshBaseURL+"/applymail", strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("rootsh: 创建申请请求失败: %w", err)
 	}
@@ -135,7 +136,7 @@ func RootshGenerate() (*CreatedMailbox, error) {
  * 1. POST /getmail 获取邮件列表（增量查询，基于 lastCheckTime）
  * 2. 对每封邮件 POST /viewmail 获取 HTML 正文
  */
-func RootshGetEmails(email, token string) ([]NormEmail, error) {
+func RootshGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("rootsh: 邮箱地址为空")
@@ -146,7 +147,7 @@ func RootshGetEmails(email, token string) ([]NormEmail, error) {
 		lastCheckTime = "0"
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	/* POST /getmail 获取邮件列表 */
 	now := fmt.Sprintf("%d", time.Now().UnixMilli())
@@ -155,7 +156,7 @@ func RootshGetEmails(email, token string) ([]NormEmail, error) {
 	form.Set("time", lastCheckTime)
 	form.Set("_", now)
 
-	req, err := http.NewRequest("POST", rootshBaseURL+"/getmail", strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", rootshBaseURL+"/getmail", strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("rootsh: 创建获取邮件请求失败: %w", err)
 	}
@@ -217,7 +218,7 @@ func RootshGetEmails(email, token string) ([]NormEmail, error) {
 		}
 
 		/* POST /viewmail 获取邮件正文 */
-		htmlBody := rootshFetchMailBody(client, fid, email)
+		htmlBody := rootshFetchMailBody(ctx, client, fid, email)
 
 		flat := map[string]interface{}{
 			"id":      fid,
@@ -234,7 +235,7 @@ func RootshGetEmails(email, token string) ([]NormEmail, error) {
 }
 
 /* rootshFetchMailBody 获取单封邮件的 HTML 正文 */
-func rootshFetchMailBody(client interface {
+func rootshFetchMailBody(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }, fid, email string) string {
 	if fid == "" {
@@ -245,7 +246,7 @@ func rootshFetchMailBody(client interface {
 	form.Set("mail", fid)
 	form.Set("to", email)
 
-	req, err := http.NewRequest("POST", rootshBaseURL+"/viewmail", strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", rootshBaseURL+"/viewmail", strings.NewReader(form.Encode()))
 	if err != nil {
 		return ""
 	}
@@ -1,8 +1,12 @@
 package provider
 
-func SbookPicsGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func SbookPicsGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@sbook.pics"
 	return &CreatedMailbox{Channel: "sbook-pics", Email: email, Token: email}, nil
 }
-func SbookPicsGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func SbookPicsGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // sendfree.org：mailinator 官方姊妹域名，MX 指向 mail.mailinator.com。
 
 // SendfreeOrgGenerate 创建 sendfree.org 临时邮箱
-func SendfreeOrgGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SendfreeOrgGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "sendfree-org",
 		Email:   local + "@sendfree.org",
@@ -12,6 +14,6 @@ func SendfreeOrgGenerate() (*CreatedMailbox, error) {
 }
 
 // SendfreeOrgGetEmails 读取邮件（复用 mailinator public API）
-func SendfreeOrgGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SendfreeOrgGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
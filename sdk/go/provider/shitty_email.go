@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -19,8 +20,8 @@ type shittyEmailInboxResponse struct {
 	Emails    []map[string]interface{} `json:"emails"`
 }
 
-func shittyEmailJSON(method, u, token string, out any) error {
-	req, err := http.NewRequest(method, u, nil)
+func shittyEmailJSON(ctx context.Context, method, u, token string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, u, nil)
 	if err != nil {
 		return err
 	}
@@ -29,7 +30,7 @@ func shittyEmailJSON(method, u, token string, out any) error {
 	if token != "" {
 		req.Header.Set("X-Session-Token", token)
 	}
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -39,7 +40,7 @@ func shittyEmailJSON(method, u, token string, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("shitty-email: http %d", resp.StatusCode)
+		return statusError(resp, "shitty-email: http %d", resp.StatusCode)
 	}
 	if out == nil {
 		return nil
@@ -63,9 +64,9 @@ func shittyEmailRaw(raw map[string]interface{}, recipient string) map[string]int
 	}
 }
 
-func ShittyEmailGenerate() (*CreatedMailbox, error) {
+func ShittyEmailGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	var data shittyEmailInboxResponse
-	if err := shittyEmailJSON(http.MethodPost, shittyEmailBase+"/inbox", "", &data); err != nil {
+	if err := shittyEmailJSON(ctx, http.MethodPost, shittyEmailBase+"/inbox", "", &data); err != nil {
 		return nil, err
 	}
 	email := strings.TrimSpace(data.Email)
@@ -81,27 +82,27 @@ func ShittyEmailGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func shittyEmailFetchDetail(token, id string) (map[string]interface{}, error) {
+func shittyEmailFetchDetail(ctx context.Context, token, id string) (map[string]interface{}, error) {
 	u := fmt.Sprintf("%s/email/%s", shittyEmailBase, url.PathEscape(id))
 	var detail map[string]interface{}
-	if err := shittyEmailJSON(http.MethodGet, u, token, &detail); err != nil {
+	if err := shittyEmailJSON(ctx, http.MethodGet, u, token, &detail); err != nil {
 		return nil, err
 	}
 	return detail, nil
 }
 
-func ShittyEmailGetEmails(token, email string) ([]NormEmail, error) {
+func ShittyEmailGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	sessionToken := strings.TrimSpace(token)
 	address := strings.TrimSpace(email)
 	if sessionToken == "" {
-		return nil, fmt.Errorf("shitty-email: empty token")
+		return nil, markError(ErrInvalidToken, "shitty-email: empty token")
 	}
 	if address == "" {
 		return nil, fmt.Errorf("shitty-email: empty email")
 	}
 
 	var data shittyEmailInboxResponse
-	if err := shittyEmailJSON(http.MethodGet, shittyEmailBase+"/inbox", sessionToken, &data); err != nil {
+	if err := shittyEmailJSON(ctx, http.MethodGet, shittyEmailBase+"/inbox", sessionToken, &data); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(data.Emails))
@@ -109,7 +110,7 @@ func ShittyEmailGetEmails(token, email string) ([]NormEmail, error) {
 		raw := row
 		id := strings.TrimSpace(fmt.Sprint(row["id"]))
 		if id != "" {
-			if detail, err := shittyEmailFetchDetail(sessionToken, id); err == nil {
+			if detail, err := shittyEmailFetchDetail(ctx, sessionToken, id); err == nil {
 				raw = detail
 			}
 		}
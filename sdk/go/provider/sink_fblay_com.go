@@ -1,15 +1,17 @@
 package provider
 
+import "context"
+
 // sink.fblay.com：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
-func SinkFblayComGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SinkFblayComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "sink-fblay-com",
 		Email:   local + "@sink.fblay.com",
 	}, nil
 }
 
-func SinkFblayComGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SinkFblayComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
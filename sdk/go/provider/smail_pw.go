@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -42,7 +43,7 @@ func setSmailPwHeaders(req *http.Request) {
 	for k, v := range smailPwHeaders {
 		req.Header.Set(k, v)
 	}
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(req.Context()))
 }
 
 func smailPwExtractSession(resp *http.Response) string {
@@ -197,15 +198,15 @@ func parseInt64Safe(s string) int64 {
 }
 
 // SmailPwGenerate POST intent=generate，保存 __session，解析收件地址
-func SmailPwGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest(http.MethodPost, smailPwRootDataURL, strings.NewReader("intent=generate"))
+func SmailPwGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, smailPwRootDataURL, strings.NewReader("intent=generate"))
 	if err != nil {
 		return nil, err
 	}
 	setSmailPwHeaders(req)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=UTF-8")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -213,7 +214,7 @@ func SmailPwGenerate() (*CreatedMailbox, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("smail.pw generate failed: %d", resp.StatusCode)
+		return nil, statusError(resp, "smail.pw generate failed: %d", resp.StatusCode)
 	}
 
 	cookie := smailPwExtractSession(resp)
@@ -234,22 +235,22 @@ func SmailPwGenerate() (*CreatedMailbox, error) {
 }
 
 // SmailPwGetEmails GET _root.data + Cookie
-func SmailPwGetEmails(token, email string) ([]NormEmail, error) {
-	req, err := http.NewRequest(http.MethodGet, smailPwRootDataURL, nil)
+func SmailPwGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, smailPwRootDataURL, nil)
 	if err != nil {
 		return nil, err
 	}
 	setSmailPwHeaders(req)
 	req.Header.Set("Cookie", token)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("smail.pw get emails failed: %d", resp.StatusCode)
+		return nil, statusError(resp, "smail.pw get emails failed: %d", resp.StatusCode)
 	}
 
 	raw, err := io.ReadAll(resp.Body)
@@ -265,7 +266,7 @@ func SmailPwGetEmails(token, email string) ([]NormEmail, error) {
 	for _, m := range mails {
 		ne := NormalizeMap(m, email)
 		if id, ok := m["id"].(string); ok && id != "" {
-			if body := smailPwFetchBody(token, id); body != "" {
+			if body := smailPwFetchBody(ctx, token, id); body != "" {
 				ne.HTML = body
 			}
 		}
@@ -274,19 +275,19 @@ func SmailPwGetEmails(token, email string) ([]NormEmail, error) {
 	return out, nil
 }
 
-func smailPwFetchBody(token, id string) string {
+func smailPwFetchBody(ctx context.Context, token, id string) string {
 	if id == "" || strings.HasPrefix(id, "__smail_") {
 		return ""
 	}
 	u := "https://smail.pw/api/email/" + url.PathEscape(id)
-	req, err := http.NewRequest(http.MethodGet, u, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return ""
 	}
 	setSmailPwHeaders(req)
 	req.Header.Set("Cookie", token)
 	req.Header.Set("Accept", "application/json")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return ""
 	}
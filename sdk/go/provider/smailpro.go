@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -23,7 +24,7 @@ const smailproAPIBaseURL = "https://api.sonjj.com/v1/temp_email"
 
 /* smailproSetHeaders 设置 smailpro/sonjj 请求所需的通用请求头 */
 func smailproSetHeaders(req *http.Request, referer string) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "application/json, text/plain, */*")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	if referer != "" {
@@ -34,8 +35,8 @@ func smailproSetHeaders(req *http.Request, referer string) {
 /* smailproFetchPayload 获取访问 sonjj API 所需的 JWT
  * targetURL 为目标 sonjj 接口地址（未编码），extra 为附加查询参数（email、mid 等）。
  */
-func smailproFetchPayload(targetURL string, extra url.Values) (string, error) {
-	client := HTTPClient()
+func smailproFetchPayload(ctx context.Context, targetURL string, extra url.Values) (string, error) {
+	client := HTTPClient(ctx)
 
 	q := url.Values{}
 	q.Set("url", targetURL)
@@ -46,7 +47,7 @@ func smailproFetchPayload(targetURL string, extra url.Values) (string, error) {
 	}
 
 	reqURL := smailproBaseURL + "/app/payload?" + q.Encode()
-	req, err := http.NewRequest("GET", reqURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
 	if err != nil {
 		return "", fmt.Errorf("smailpro: 创建 payload 请求失败: %w", err)
 	}
@@ -79,19 +80,19 @@ func smailproFetchPayload(targetURL string, extra url.Values) (string, error) {
 /* smailproCallAPI 携带 JWT 调用 sonjj API 并返回响应体
  * targetURL 为目标接口地址，extra 为获取 payload 时需要的附加参数（email、mid）。
  */
-func smailproCallAPI(targetURL string, extra url.Values, label string) ([]byte, error) {
-	payload, err := smailproFetchPayload(targetURL, extra)
+func smailproCallAPI(ctx context.Context, targetURL string, extra url.Values, label string) ([]byte, error) {
+	payload, err := smailproFetchPayload(ctx, targetURL, extra)
 	if err != nil {
 		return nil, err
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	q := url.Values{}
 	q.Set("payload", payload)
 	reqURL := targetURL + "?" + q.Encode()
 
-	req, err := http.NewRequest("GET", reqURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("smailpro: 创建 %s 请求失败: %w", label, err)
 	}
@@ -117,8 +118,8 @@ func smailproCallAPI(targetURL string, extra url.Values, label string) ([]byte,
 /* SmailproGenerate 创建 smailpro 临时邮箱
  * 调用 sonjj create 接口，返回 {"email":"...","expired_at":...}
  */
-func SmailproGenerate() (*CreatedMailbox, error) {
-	body, err := smailproCallAPI(smailproAPIBaseURL+"/create", nil, "create")
+func SmailproGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	body, err := smailproCallAPI(ctx, smailproAPIBaseURL+"/create", nil, "create")
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +150,7 @@ func SmailproGenerate() (*CreatedMailbox, error) {
  * 2. 对每封邮件调用 message 接口获取正文，再统一标准化
  * token 未使用，可传空字符串。
  */
-func SmailproGetEmails(email, token string) ([]NormEmail, error) {
+func SmailproGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	_ = token
 	email = strings.TrimSpace(email)
 	if email == "" {
@@ -159,7 +160,7 @@ func SmailproGetEmails(email, token string) ([]NormEmail, error) {
 	inboxExtra := url.Values{}
 	inboxExtra.Set("email", email)
 
-	body, err := smailproCallAPI(smailproAPIBaseURL+"/inbox", inboxExtra, "inbox")
+	body, err := smailproCallAPI(ctx, smailproAPIBaseURL+"/inbox", inboxExtra, "inbox")
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +196,7 @@ func SmailproGetEmails(email, token string) ([]NormEmail, error) {
 		}
 
 		/* 拉取邮件正文（含 HTML 与纯文本），失败时保留列表元信息 */
-		if html, text := smailproFetchMessage(email, mid); html != "" || text != "" {
+		if html, text := smailproFetchMessage(ctx, email, mid); html != "" || text != "" {
 			flat["html"] = html
 			flat["text"] = text
 		}
@@ -209,7 +210,7 @@ func SmailproGetEmails(email, token string) ([]NormEmail, error) {
 /* smailproFetchMessage 获取单封邮件正文，返回 (html, text)
  * 调用 sonjj message 接口，需在 payload 参数中携带 email 与 mid。
  */
-func smailproFetchMessage(email, mid string) (string, string) {
+func smailproFetchMessage(ctx context.Context, email, mid string) (string, string) {
 	if mid == "" {
 		return "", ""
 	}
@@ -218,7 +219,7 @@ func smailproFetchMessage(email, mid string) (string, string) {
 	msgExtra.Set("email", email)
 	msgExtra.Set("mid", mid)
 
-	body, err := smailproCallAPI(smailproAPIBaseURL+"/message", msgExtra, "message")
+	body, err := smailproCallAPI(ctx, smailproAPIBaseURL+"/message", msgExtra, "message")
 	if err != nil {
 		return "", ""
 	}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -29,12 +30,13 @@ var sioHosts = map[string]string{
 }
 
 type sioBoxState struct {
-	mu      sync.Mutex
-	emails  []NormEmail
-	seenIDs map[string]bool
-	ws      *websocket.Conn
-	email   string
-	channel string
+	mu       sync.Mutex
+	emails   []NormEmail
+	seenIDs  map[string]bool
+	ws       *websocket.Conn
+	email    string
+	channel  string
+	notifier mailNotifier
 }
 
 type sioProvider struct {
@@ -95,7 +97,7 @@ func sioSendEvent(ws *websocket.Conn, event string, payload interface{}) error {
 	return ws.WriteMessage(websocket.TextMessage, []byte("42"+string(raw)))
 }
 
-func (p *sioProvider) connectSocket(host string) (*websocket.Conn, error) {
+func (p *sioProvider) connectSocket(ctx context.Context, host string) (*websocket.Conn, error) {
 	safeHost, err := p.safeHost(host)
 	if err != nil {
 		return nil, err
@@ -115,7 +117,7 @@ func (p *sioProvider) connectSocket(host string) (*websocket.Conn, error) {
 
 	for _, version := range sioVersions {
 		url := sioSocketURL(safeHost, version)
-		ws, _, err := dialer.Dial(url, headers)
+		ws, _, err := dialer.DialContext(ctx, url, headers)
 		if err != nil {
 			lastErr = err
 			continue
@@ -149,7 +151,10 @@ func (p *sioProvider) connectSocket(host string) (*websocket.Conn, error) {
 				sentConnect = true
 				ws.WriteMessage(websocket.TextMessage, []byte("40"))
 				// 等待 40 确认或超时后继续
-				time.Sleep(sioHandshakeWait)
+				if err := sleepCtx(ctx, sioHandshakeWait); err != nil {
+					ws.Close()
+					return nil, err
+				}
 				connected = true
 				break
 			}
@@ -175,8 +180,8 @@ func (p *sioProvider) connectSocket(host string) (*websocket.Conn, error) {
 	return nil, lastErr
 }
 
-func (p *sioProvider) requestShortID(host string) (string, error) {
-	ws, err := p.connectSocket(host)
+func (p *sioProvider) requestShortID(ctx context.Context, host string) (string, error) {
+	ws, err := p.connectSocket(ctx, host)
 	if err != nil {
 		return "", err
 	}
@@ -210,14 +215,14 @@ func (p *sioProvider) requestShortID(host string) (string, error) {
 	return "", fmt.Errorf("%s: 等待 shortid 超时", p.channel)
 }
 
-func (p *sioProvider) Generate() (*CreatedMailbox, error) {
+func (p *sioProvider) Generate(ctx context.Context) (*CreatedMailbox, error) {
 	host := p.defaultHost
-	shortid, err := p.requestShortID(host)
+	shortid, err := p.requestShortID(ctx, host)
 	if err != nil {
 		return nil, err
 	}
 	email := fmt.Sprintf("%s@%s", shortid, host)
-	if err := p.ensureMailbox(email); err != nil {
+	if err := p.ensureMailbox(ctx, email); err != nil {
 		return nil, err
 	}
 	return &CreatedMailbox{
@@ -308,10 +313,11 @@ func sioFlattenMail(raw map[string]interface{}, recipientEmail string) map[strin
 		"html":    htmlContent,
 		"date":    date,
 		"isRead":  false,
+		"headers": headers,
 	}
 }
 
-func (p *sioProvider) ensureMailbox(email string) error {
+func (p *sioProvider) ensureMailbox(ctx context.Context, email string) error {
 	st := p.getState(email)
 	st.mu.Lock()
 	defer st.mu.Unlock()
@@ -330,7 +336,7 @@ func (p *sioProvider) ensureMailbox(email string) error {
 		return err
 	}
 
-	ws, err := p.connectSocket(host)
+	ws, err := p.connectSocket(ctx, host)
 	if err != nil {
 		return err
 	}
@@ -374,20 +380,24 @@ func (p *sioProvider) ensureMailbox(email string) error {
 			flat := sioFlattenMail(rawMail, email)
 			normalized := NormalizeMap(flat, email)
 			st.mu.Lock()
-			if normalized.ID != "" && !st.seenIDs[normalized.ID] {
+			fresh := normalized.ID != "" && !st.seenIDs[normalized.ID]
+			if fresh {
 				st.seenIDs[normalized.ID] = true
 				st.emails = append(st.emails, normalized)
 			}
 			st.mu.Unlock()
+			if fresh {
+				st.notifier.notify()
+			}
 		}
 	}()
 
-	time.Sleep(sioInitialSyncWait)
-	return nil
+	/* 等待服务端推送已有邮件；连接已建立，取消只结束本次调用 */
+	return sleepCtx(ctx, sioInitialSyncWait)
 }
 
-func (p *sioProvider) GetEmails(email string) ([]NormEmail, error) {
-	if err := p.ensureMailbox(email); err != nil {
+func (p *sioProvider) GetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	if err := p.ensureMailbox(ctx, email); err != nil {
 		return nil, err
 	}
 	st := p.getState(email)
@@ -398,6 +408,18 @@ func (p *sioProvider) GetEmails(email string) ([]NormEmail, error) {
 	return result, nil
 }
 
+/*
+ * Subscribe 订阅邮箱的 Socket.IO "mail" 推送，连接未建立时先建立连接
+ * 连接断开后由下一次 GetEmails 重连，订阅保持有效
+ */
+func (p *sioProvider) Subscribe(ctx context.Context, email string) (<-chan struct{}, func(), error) {
+	if err := p.ensureMailbox(ctx, email); err != nil {
+		return nil, nil, err
+	}
+	ch, cancel := p.getState(email).notifier.subscribe()
+	return ch, cancel, nil
+}
+
 // ====== 三个渠道实例 ======
 
 var (
@@ -407,31 +429,46 @@ var (
 )
 
 // MjjCmGenerate 创建 mjj-cm 临时邮箱
-func MjjCmGenerate() (*CreatedMailbox, error) {
-	return mjjCmProvider.Generate()
+func MjjCmGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return mjjCmProvider.Generate(ctx)
 }
 
 // MjjCmGetEmails 获取 mjj-cm 邮件列表
-func MjjCmGetEmails(email string) ([]NormEmail, error) {
-	return mjjCmProvider.GetEmails(email)
+func MjjCmGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return mjjCmProvider.GetEmails(ctx, email)
+}
+
+// MjjCmSubscribe 订阅 mjj-cm 新邮件推送
+func MjjCmSubscribe(ctx context.Context, email string) (<-chan struct{}, func(), error) {
+	return mjjCmProvider.Subscribe(ctx, email)
 }
 
 // MailXiuviGenerate 创建 mail-xiuvi 临时邮箱
-func MailXiuviGenerate() (*CreatedMailbox, error) {
-	return mailXiuviProvider.Generate()
+func MailXiuviGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return mailXiuviProvider.Generate(ctx)
 }
 
 // MailXiuviGetEmails 获取 mail-xiuvi 邮件列表
-func MailXiuviGetEmails(email string) ([]NormEmail, error) {
-	return mailXiuviProvider.GetEmails(email)
+func MailXiuviGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return mailXiuviProvider.GetEmails(ctx, email)
+}
+
+// MailXiuviSubscribe 订阅 mail-xiuvi 新邮件推送
+func MailXiuviSubscribe(ctx context.Context, email string) (<-chan struct{}, func(), error) {
+	return mailXiuviProvider.Subscribe(ctx, email)
 }
 
 // LinshiCoGenerate 创建 linshi-co 临时邮箱
-func LinshiCoGenerate() (*CreatedMailbox, error) {
-	return linshiCoProvider.Generate()
+func LinshiCoGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return linshiCoProvider.Generate(ctx)
 }
 
 // LinshiCoGetEmails 获取 linshi-co 邮件列表
-func LinshiCoGetEmails(email string) ([]NormEmail, error) {
-	return linshiCoProvider.GetEmails(email)
+func LinshiCoGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return linshiCoProvider.GetEmails(ctx, email)
+}
+
+// LinshiCoSubscribe 订阅 linshi-co 新邮件推送
+func LinshiCoSubscribe(ctx context.Context, email string) (<-chan struct{}, func(), error) {
+	return linshiCoProvider.Subscribe(ctx, email)
 }
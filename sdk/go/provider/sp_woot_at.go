@@ -1,6 +1,10 @@
 package provider
 
-func SpWootAtGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "sp-woot-at", Email: randomStr(12) + "@sp.woot.at"}, nil
+import "context"
+
+func SpWootAtGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "sp-woot-at", Email: localPartOr(ctx, randomStr(12)) + "@sp.woot.at"}, nil
+}
+func SpWootAtGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func SpWootAtGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // spam.deluser.net：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // SpamDeluserNetGenerate 创建 spam.deluser.net 临时邮箱
-func SpamDeluserNetGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SpamDeluserNetGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "spam-deluser-net",
 		Email:   local + "@spam.deluser.net",
@@ -12,6 +14,6 @@ func SpamDeluserNetGenerate() (*CreatedMailbox, error) {
 }
 
 // SpamDeluserNetGetEmails 读取邮件（复用 mailinator public API）
-func SpamDeluserNetGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SpamDeluserNetGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // spam.dhsf.net：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // SpamDhsfNetGenerate 创建 spam.dhsf.net 临时邮箱
-func SpamDhsfNetGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SpamDhsfNetGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "spam-dhsf-net",
 		Email:   local + "@spam.dhsf.net",
@@ -12,6 +14,6 @@ func SpamDhsfNetGenerate() (*CreatedMailbox, error) {
 }
 
 // SpamDhsfNetGetEmails 读取邮件（复用 mailinator public API）
-func SpamDhsfNetGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SpamDhsfNetGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,15 +1,17 @@
 package provider
 
+import "context"
+
 // spam.hortuk.ovh：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
-func SpamHortukOvhGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SpamHortukOvhGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "spam-hortuk-ovh",
 		Email:   local + "@spam.hortuk.ovh",
 	}, nil
 }
 
-func SpamHortukOvhGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SpamHortukOvhGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // spam.netpirates.net：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // SpamNetpiratesNetGenerate 创建 spam.netpirates.net 临时邮箱
-func SpamNetpiratesNetGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SpamNetpiratesNetGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "spam-netpirates-net",
 		Email:   local + "@spam.netpirates.net",
@@ -12,6 +14,6 @@ func SpamNetpiratesNetGenerate() (*CreatedMailbox, error) {
 }
 
 // SpamNetpiratesNetGetEmails 读取邮件（复用 mailinator public API）
-func SpamNetpiratesNetGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SpamNetpiratesNetGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // spam.no-ip.net：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // SpamNoIpNetGenerate 创建 spam.no-ip.net 临时邮箱
-func SpamNoIpNetGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SpamNoIpNetGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "spam-no-ip-net",
 		Email:   local + "@spam.no-ip.net",
@@ -12,6 +14,6 @@ func SpamNoIpNetGenerate() (*CreatedMailbox, error) {
 }
 
 // SpamNoIpNetGetEmails 读取邮件（复用 mailinator public API）
-func SpamNoIpNetGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SpamNoIpNetGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // spam.ozh.org：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // SpamOzhOrgGenerate 创建 spam.ozh.org 临时邮箱
-func SpamOzhOrgGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SpamOzhOrgGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "spam-ozh-org",
 		Email:   local + "@spam.ozh.org",
@@ -12,6 +14,6 @@ func SpamOzhOrgGenerate() (*CreatedMailbox, error) {
 }
 
 // SpamOzhOrgGetEmails 读取邮件（复用 mailinator public API）
-func SpamOzhOrgGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SpamOzhOrgGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // spam.pyphus.org：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // SpamPyphusOrgGenerate 创建 spam.pyphus.org 临时邮箱
-func SpamPyphusOrgGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SpamPyphusOrgGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "spam-pyphus-org",
 		Email:   local + "@spam.pyphus.org",
@@ -12,6 +14,6 @@ func SpamPyphusOrgGenerate() (*CreatedMailbox, error) {
 }
 
 // SpamPyphusOrgGetEmails 读取邮件（复用 mailinator public API）
-func SpamPyphusOrgGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SpamPyphusOrgGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
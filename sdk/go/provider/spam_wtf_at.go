@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // spam.wtf.at：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // SpamWtfAtGenerate 创建 spam.wtf.at 临时邮箱
-func SpamWtfAtGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SpamWtfAtGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "spam-wtf-at",
 		Email:   local + "@spam.wtf.at",
@@ -12,6 +14,6 @@ func SpamWtfAtGenerate() (*CreatedMailbox, error) {
 }
 
 // SpamWtfAtGetEmails 读取邮件（复用 mailinator public API）
-func SpamWtfAtGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SpamWtfAtGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // spam.wulczer.org：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
 // SpamWulczerOrgGenerate 创建 spam.wulczer.org 临时邮箱
-func SpamWulczerOrgGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SpamWulczerOrgGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "spam-wulczer-org",
 		Email:   local + "@spam.wulczer.org",
@@ -12,6 +14,6 @@ func SpamWulczerOrgGenerate() (*CreatedMailbox, error) {
 }
 
 // SpamWulczerOrgGetEmails 读取邮件（复用 mailinator public API）
-func SpamWulczerOrgGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SpamWulczerOrgGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
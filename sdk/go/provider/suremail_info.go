@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // suremail.info：mailinator 官方姊妹域名，MX 指向 mail.mailinator.com。
 
 // SuremailInfoGenerate 创建 suremail.info 临时邮箱
-func SuremailInfoGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func SuremailInfoGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "suremail-info",
 		Email:   local + "@suremail.info",
@@ -12,6 +14,6 @@ func SuremailInfoGenerate() (*CreatedMailbox, error) {
 }
 
 // SuremailInfoGetEmails 读取 suremail.info 邮件（复用 mailinator public API）
-func SuremailInfoGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func SuremailInfoGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
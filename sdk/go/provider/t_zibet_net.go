@@ -1,6 +1,10 @@
 package provider
 
-func TZibetNetGenerate() (*CreatedMailbox, error) {
-	return &CreatedMailbox{Channel: "t-zibet-net", Email: randomStr(12) + "@t.zibet.net"}, nil
+import "context"
+
+func TZibetNetGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	return &CreatedMailbox{Channel: "t-zibet-net", Email: localPartOr(ctx, randomStr(12)) + "@t.zibet.net"}, nil
+}
+func TZibetNetGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
-func TZibetNetGetEmails(email string) ([]NormEmail, error) { return MailinatorGetEmails(email) }
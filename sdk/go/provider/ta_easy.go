@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,22 +15,22 @@ const taEasyOrigin = "https://www.ta-easy.com"
 
 func setTaEasyHeaders(req *http.Request) {
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(req.Context()))
 	req.Header.Set("Origin", taEasyOrigin)
 	req.Header.Set("Referer", taEasyOrigin+"/")
 }
 
 // TaEasyGenerate 创建临时邮箱
 // API: POST /temp-email/address/new（空 body）
-func TaEasyGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest("POST", taEasyAPIBase+"/temp-email/address/new", nil)
+func TaEasyGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", taEasyAPIBase+"/temp-email/address/new", nil)
 	if err != nil {
 		return nil, err
 	}
 	setTaEasyHeaders(req)
 	req.Header.Set("Content-Length", "0")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -68,7 +69,7 @@ func TaEasyGenerate() (*CreatedMailbox, error) {
 
 // TaEasyGetEmails 拉取收件箱
 // API: POST /temp-email/inbox/list，JSON body: { "token", "email" }
-func TaEasyGetEmails(email, token string) ([]NormEmail, error) {
+func TaEasyGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	payload, err := json.Marshal(map[string]string{
 		"token": token,
 		"email": email,
@@ -76,14 +77,14 @@ func TaEasyGetEmails(email, token string) ([]NormEmail, error) {
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", taEasyAPIBase+"/temp-email/inbox/list", bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, "POST", taEasyAPIBase+"/temp-email/inbox/list", bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
 	setTaEasyHeaders(req)
 	req.Header.Set("Content-Type", "application/json")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,7 +25,7 @@ type tempMailIoGenerateResponse struct {
 	Token string `json:"token"`
 }
 
-func tempMailIoFetchCorsHeader() string {
+func tempMailIoFetchCorsHeader(ctx context.Context) string {
 	tempMailIoHeaderMu.Lock()
 	defer tempMailIoHeaderMu.Unlock()
 
@@ -32,10 +33,10 @@ func tempMailIoFetchCorsHeader() string {
 		return tempMailIoCachedHeader
 	}
 
-	req, err := http.NewRequest("GET", tempMailIoPageURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempMailIoPageURL, nil)
 	if err == nil {
 		req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/144.0.0.0 Safari/537.36")
-		resp, reqErr := HTTPClient().Do(req)
+		resp, reqErr := HTTPClient(ctx).Do(req)
 		if reqErr == nil && resp != nil {
 			body, readErr := io.ReadAll(resp.Body)
 			resp.Body.Close()
@@ -55,36 +56,36 @@ func tempMailIoFetchCorsHeader() string {
 	return tempMailIoCachedHeader
 }
 
-func tempMailIoApplyHeaders(req *http.Request) {
+func tempMailIoApplyHeaders(ctx context.Context, req *http.Request) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Application-Name", "web")
 	req.Header.Set("Application-Version", "4.0.0")
-	req.Header.Set("X-CORS-Header", tempMailIoFetchCorsHeader())
+	req.Header.Set("X-CORS-Header", tempMailIoFetchCorsHeader(ctx))
 	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/144.0.0.0 Safari/537.36 Edg/144.0.0.0")
 	req.Header.Set("Origin", "https://temp-mail.io")
 	req.Header.Set("Referer", "https://temp-mail.io/")
 }
 
-func TempMailIoGenerate() (*CreatedMailbox, error) {
+func TempMailIoGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	reqBody, _ := json.Marshal(map[string]int{
 		"min_name_length": 10,
 		"max_name_length": 10,
 	})
 
-	req, err := http.NewRequest("POST", tempMailIoBaseURL+"/email/new", bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", tempMailIoBaseURL+"/email/new", bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
-	tempMailIoApplyHeaders(req)
+	tempMailIoApplyHeaders(ctx, req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("temp-mail-io generate: %d", resp.StatusCode)
+		return nil, statusError(resp, "temp-mail-io generate: %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -103,21 +104,21 @@ func TempMailIoGenerate() (*CreatedMailbox, error) {
 	return &CreatedMailbox{Channel: "temp-mail-io", Email: data.Email, Token: data.Token}, nil
 }
 
-func TempMailIoGetEmails(email string) ([]NormEmail, error) {
-	req, err := http.NewRequest("GET", tempMailIoBaseURL+"/email/"+email+"/messages", nil)
+func TempMailIoGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", tempMailIoBaseURL+"/email/"+email+"/messages", nil)
 	if err != nil {
 		return nil, err
 	}
-	tempMailIoApplyHeaders(req)
+	tempMailIoApplyHeaders(ctx, req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("temp-mail-io messages: %d", resp.StatusCode)
+		return nil, statusError(resp, "temp-mail-io messages: %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
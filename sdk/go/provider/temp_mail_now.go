@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -89,17 +90,17 @@ func tempMailNowMergeCookies(prev string, cookies []*http.Cookie) string {
  *   2. POST /change_email 携带 Cookie 创建新邮箱
  *   3. 将合并后的 Cookie 字符串作为 Token 存储
  */
-func TempMailNowGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func TempMailNowGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	/* 第一步：GET /en/ 获取会话 Cookie */
-	req, err := http.NewRequest("GET", tempMailNowBase+"/en/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempMailNowBase+"/en/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("temp-mail-now: 创建初始请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -109,7 +110,7 @@ func TempMailNowGenerate() (*CreatedMailbox, error) {
 	_, _ = io.Copy(io.Discard, resp.Body)
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("temp-mail-now: 初始请求 HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "temp-mail-now: 初始请求 HTTP %d", resp.StatusCode)
 	}
 
 	cookieHdr := tempMailNowMergeCookies("", resp.Cookies())
@@ -118,7 +119,7 @@ func TempMailNowGenerate() (*CreatedMailbox, error) {
 	}
 
 	/* 第二步：POST /change_email 创建新邮箱 */
-	req2, err := http.NewRequest("POST", tempMailNowBase+"/change_email", nil)
+	req2, err := http.NewRequestWithContext(ctx, "POST", tempMailNowBase+"/change_email", nil)
 	if err != nil {
 		return nil, fmt.Errorf("temp-mail-now: 创建 change_email 请求失败: %w", err)
 	}
@@ -126,7 +127,7 @@ func TempMailNowGenerate() (*CreatedMailbox, error) {
 	req2.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req2.Header.Set("Cookie", cookieHdr)
 	req2.Header.Set("Referer", tempMailNowBase+"/en/")
-	req2.Header.Set("User-Agent", getCurrentUA())
+	req2.Header.Set("User-Agent", getCurrentUA(ctx))
 
 	resp2, err := client.Do(req2)
 	if err != nil {
@@ -140,7 +141,7 @@ func TempMailNowGenerate() (*CreatedMailbox, error) {
 	}
 
 	if resp2.StatusCode < 200 || resp2.StatusCode >= 300 {
-		return nil, fmt.Errorf("temp-mail-now: change_email HTTP %d", resp2.StatusCode)
+		return nil, statusError(resp2, "temp-mail-now: change_email HTTP %d", resp2.StatusCode)
 	}
 
 	/* 合并第二次请求可能返回的新 Cookie */
@@ -168,21 +169,21 @@ func TempMailNowGenerate() (*CreatedMailbox, error) {
  * 使用存储的会话 Cookie 调用 GET /fetch_emails
  * 每条邮件经过 NormalizeMap 归一化处理
  */
-func TempMailNowGetEmails(token, email string) ([]NormEmail, error) {
+func TempMailNowGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	if token == "" {
 		return nil, fmt.Errorf("temp-mail-now: 会话 Cookie 为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", tempMailNowBase+"/fetch_emails", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempMailNowBase+"/fetch_emails", nil)
 	if err != nil {
 		return nil, fmt.Errorf("temp-mail-now: 创建 fetch_emails 请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "application/json, text/plain, */*")
 	req.Header.Set("Cookie", token)
 	req.Header.Set("Referer", tempMailNowBase+"/en/")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -191,7 +192,7 @@ func TempMailNowGetEmails(token, email string) ([]NormEmail, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("temp-mail-now: fetch_emails HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "temp-mail-now: fetch_emails HTTP %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
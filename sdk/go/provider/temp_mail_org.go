@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -64,15 +65,15 @@ func tempMailOrgHeaders(req *http.Request, token string) {
  * @param duration - 保留参数，当前未使用
  * @param domain - 保留参数，当前未使用（域名由服务端动态分配）
  */
-func TempMailOrgGenerate(duration int, domain string) (*CreatedMailbox, error) {
-	req, err := http.NewRequest(http.MethodPost, tempMailOrgBase+"/mailbox", nil)
+func TempMailOrgGenerate(ctx context.Context, duration int, domain string) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tempMailOrgBase+"/mailbox", nil)
 	if err != nil {
 		return nil, err
 	}
 	tempMailOrgHeaders(req, "")
 	req.Header.Set("Content-Length", "0")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +84,7 @@ func TempMailOrgGenerate(duration int, domain string) (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("temp-mail-org: 创建邮箱失败 http %d: %s", resp.StatusCode, string(body))
+		return nil, statusError(resp, "temp-mail-org: 创建邮箱失败 http %d: %s", resp.StatusCode, string(body))
 	}
 
 	var data tempMailOrgCreateResponse
@@ -107,15 +108,15 @@ func TempMailOrgGenerate(duration int, domain string) (*CreatedMailbox, error) {
  * @param token - JWT 认证令牌
  * @param email - 邮箱地址
  */
-func TempMailOrgGetEmails(token string, email string) ([]NormEmail, error) {
+func TempMailOrgGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	// 获取邮件列表
-	req, err := http.NewRequest(http.MethodGet, tempMailOrgBase+"/messages", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tempMailOrgBase+"/messages", nil)
 	if err != nil {
 		return nil, err
 	}
 	tempMailOrgHeaders(req, token)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +127,7 @@ func TempMailOrgGetEmails(token string, email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("temp-mail-org: 获取邮件列表失败 http %d: %s", resp.StatusCode, string(body))
+		return nil, statusError(resp, "temp-mail-org: 获取邮件列表失败 http %d: %s", resp.StatusCode, string(body))
 	}
 
 	var listResp tempMailOrgMessagesResponse
@@ -137,7 +138,7 @@ func TempMailOrgGetEmails(token string, email string) ([]NormEmail, error) {
 	out := make([]NormEmail, 0, len(listResp.Messages))
 	for _, msg := range listResp.Messages {
 		// 逐封获取详情
-		detail, err := tempMailOrgGetDetail(token, msg.ID)
+		detail, err := tempMailOrgGetDetail(ctx, token, msg.ID)
 		if err != nil {
 			// 详情获取失败时使用摘要信息
 			out = append(out, NormalizeMap(map[string]interface{}{
@@ -167,14 +168,14 @@ func TempMailOrgGetEmails(token string, email string) ([]NormEmail, error) {
 }
 
 // tempMailOrgGetDetail 获取单封邮件详情
-func tempMailOrgGetDetail(token string, msgID string) (*tempMailOrgDetail, error) {
-	req, err := http.NewRequest(http.MethodGet, tempMailOrgBase+"/messages/"+msgID, nil)
+func tempMailOrgGetDetail(ctx context.Context, token string, msgID string) (*tempMailOrgDetail, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tempMailOrgBase+"/messages/"+msgID, nil)
 	if err != nil {
 		return nil, err
 	}
 	tempMailOrgHeaders(req, token)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -185,7 +186,7 @@ func tempMailOrgGetDetail(token string, msgID string) (*tempMailOrgDetail, error
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("temp-mail-org: 获取邮件详情失败 http %d: %s", resp.StatusCode, string(body))
+		return nil, statusError(resp, "temp-mail-org: 获取邮件详情失败 http %d: %s", resp.StatusCode, string(body))
 	}
 
 	var detail tempMailOrgDetail
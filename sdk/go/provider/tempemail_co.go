@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,7 +25,7 @@ var tempemailCoMailIDRegex = regexp.MustCompile(`data-id="(\d+)"`)
 
 /* tempemailCoSetHeaders 设置通用请求头 */
 func tempemailCoSetHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "application/json, text/plain, */*")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("Referer", tempemailCoBaseURL+"/")
@@ -34,10 +35,10 @@ func tempemailCoSetHeaders(req *http.Request) {
  * GET /mail/random 获取随机邮箱地址，服务端通过 Cookie 维持会话
  * token 存储返回的 address 值，用于后续获取邮件
  */
-func TempemailCoGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func TempemailCoGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", tempemailCoBaseURL+"/mail/random", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempemailCoBaseURL+"/mail/random", nil)
 	if err != nil {
 		return nil, fmt.Errorf("tempemail-co: 创建请求失败: %w", err)
 	}
@@ -91,7 +92,7 @@ func TempemailCoGenerate() (*CreatedMailbox, error) {
  * 2. 用正则从 HTML 中提取邮件 ID（data-id 属性）
  * 3. 对每个 ID 调用 GET /mail/info 获取邮件详情
  */
-func TempemailCoGetEmails(email, token string) ([]NormEmail, error) {
+func TempemailCoGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("tempemail-co: 邮箱地址为空")
@@ -102,11 +103,11 @@ func TempemailCoGetEmails(email, token string) ([]NormEmail, error) {
 		address = email
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	/* 步骤 1：GET /get-mails 获取邮件列表 */
 	mailsURL := fmt.Sprintf("%s/get-mails?mail_id=%s&unseen=0&is_new=1", tempemailCoBaseURL, address)
-	req, err := http.NewRequest("GET", mailsURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", mailsURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("tempemail-co: 创建邮件列表请求失败: %w", err)
 	}
@@ -163,7 +164,7 @@ func TempemailCoGetEmails(email, token string) ([]NormEmail, error) {
 			continue
 		}
 		mailID := match[1]
-		detail := tempemailCoFetchMailDetail(client, mailID, email)
+		detail := tempemailCoFetchMailDetail(ctx, client, mailID, email)
 		if detail != nil {
 			emails = append(emails, *detail)
 		}
@@ -175,7 +176,7 @@ func TempemailCoGetEmails(email, token string) ([]NormEmail, error) {
 /* tempemailCoFetchMailDetail 获取单封邮件的详情
  * GET /mail/info?id={id} → {result, mail: {fromName, fromAddress, subject, textHtml, displayDate}}
  */
-func tempemailCoFetchMailDetail(client interface {
+func tempemailCoFetchMailDetail(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }, mailID, recipientEmail string) *NormEmail {
 	if mailID == "" {
@@ -183,7 +184,7 @@ func tempemailCoFetchMailDetail(client interface {
 	}
 
 	infoURL := fmt.Sprintf("%s/mail/info?id=%s", tempemailCoBaseURL, mailID)
-	req, err := http.NewRequest("GET", infoURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", infoURL, nil)
 	if err != nil {
 		return nil
 	}
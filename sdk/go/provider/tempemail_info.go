@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -26,7 +27,7 @@ var tempemailInfoEmailRe = regexp.MustCompile(`var\s+emailEncoded\s*=\s*"([^"]+)
 
 /* tempemailInfoSetHeaders 设置通用请求头 */
 func tempemailInfoSetHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("Referer", tempemailInfoBaseURL+"/")
 	req.Header.Set("Origin", tempemailInfoBaseURL)
@@ -37,15 +38,15 @@ func tempemailInfoSetHeaders(req *http.Request) {
  * 2. 从 HTML 中正则提取 emailEncoded 并 base64 解码得到邮箱地址
  * token 存储会话 Cookie 串，供后续 checker.php / view 请求使用
  */
-func TempemailInfoGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest("GET", tempemailInfoBaseURL+"/", nil)
+func TempemailInfoGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", tempemailInfoBaseURL+"/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("tempemail-info: 创建首页请求失败: %w", err)
 	}
 	tempemailInfoSetHeaders(req)
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tempemail-info: 获取首页失败: %w", err)
 	}
@@ -91,7 +92,7 @@ func TempemailInfoGenerate() (*CreatedMailbox, error) {
  * 1. POST /template/checker.php（body: last_id=0）获取邮件列表 JSON 数组
  * 2. 对每封邮件 GET /view/{date} 获取 HTML 正文
  */
-func TempemailInfoGetEmails(email, token string) ([]NormEmail, error) {
+func TempemailInfoGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	cookieHdr := strings.TrimSpace(token)
 	if cookieHdr == "" {
 		return nil, fmt.Errorf("tempemail-info: 缺少会话 Cookie")
@@ -100,7 +101,7 @@ func TempemailInfoGetEmails(email, token string) ([]NormEmail, error) {
 	form := url.Values{}
 	form.Set("last_id", "0")
 
-	req, err := http.NewRequest("POST", tempemailInfoBaseURL+"/template/checker.php", strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", tempemailInfoBaseURL+"/template/checker.php", strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("tempemail-info: 创建获取邮件请求失败: %w", err)
 	}
@@ -110,7 +111,7 @@ func TempemailInfoGetEmails(email, token string) ([]NormEmail, error) {
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Cookie", cookieHdr)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tempemail-info: 获取邮件列表失败: %w", err)
 	}
@@ -153,7 +154,7 @@ func TempemailInfoGetEmails(email, token string) ([]NormEmail, error) {
 		}
 
 		/* GET /view/{date} 获取邮件正文 */
-		htmlBody := tempemailInfoFetchBody(cookieHdr, row.Date)
+		htmlBody := tempemailInfoFetchBody(ctx, cookieHdr, row.Date)
 
 		flat := map[string]interface{}{
 			"id":      row.ID.String(),
@@ -173,12 +174,12 @@ func TempemailInfoGetEmails(email, token string) ([]NormEmail, error) {
 /* tempemailInfoFetchBody 获取单封邮件的 HTML 正文
  * date 作为路径段需进行 URL 编码
  */
-func tempemailInfoFetchBody(cookieHdr, date string) string {
+func tempemailInfoFetchBody(ctx context.Context, cookieHdr, date string) string {
 	if strings.TrimSpace(date) == "" {
 		return ""
 	}
 
-	req, err := http.NewRequest("GET", tempemailInfoBaseURL+"/view/"+url.PathEscape(date), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempemailInfoBaseURL+"/view/"+url.PathEscape(date), nil)
 	if err != nil {
 		return ""
 	}
@@ -186,7 +187,7 @@ func tempemailInfoFetchBody(cookieHdr, date string) string {
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 	req.Header.Set("Cookie", cookieHdr)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return ""
 	}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,7 +26,7 @@ var tempemailsNetCSRFRe = regexp.MustCompile(`<meta\s+name="csrf-token"\s+conten
 
 /* tempemailsNetSetXHRHeaders 设置 AJAX 请求所需的通用请求头 */
 func tempemailsNetSetXHRHeaders(req *http.Request, csrf string) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "application/json, text/plain, */*")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
@@ -38,14 +39,14 @@ func tempemailsNetSetXHRHeaders(req *http.Request, csrf string) {
  * GET / 返回 HTML 页面，从 <meta name="csrf-token" content="xxx"> 提取 CSRF token
  * 同时服务端会设置 XSRF-TOKEN 和 temp_mail_session Cookie
  */
-func tempemailsNetFetchCSRF(client interface {
+func tempemailsNetFetchCSRF(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }) (string, error) {
-	req, err := http.NewRequest("GET", tempemailsNetBaseURL+"/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempemailsNetBaseURL+"/", nil)
 	if err != nil {
 		return "", fmt.Errorf("tempemails-net: 创建首页请求失败: %w", err)
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 
@@ -79,17 +80,17 @@ func tempemailsNetFetchCSRF(client interface {
  *   2. POST /get_messages 获取服务端自动分配的邮箱地址
  * token: CSRF token 字符串
  */
-func TempemailsNetGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func TempemailsNetGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	/* 步骤 1：GET / 获取 session cookie + CSRF token */
-	csrf, err := tempemailsNetFetchCSRF(client)
+	csrf, err := tempemailsNetFetchCSRF(ctx, client)
 	if err != nil {
 		return nil, err
 	}
 
 	/* 步骤 2：POST /get_messages 获取自动分配的邮箱地址 */
-	req, err := http.NewRequest("POST", tempemailsNetBaseURL+"/get_messages", nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", tempemailsNetBaseURL+"/get_messages", nil)
 	if err != nil {
 		return nil, fmt.Errorf("tempemails-net: 创建获取消息请求失败: %w", err)
 	}
@@ -141,22 +142,22 @@ func TempemailsNetGenerate() (*CreatedMailbox, error) {
  *   2. POST /get_messages 获取邮件列表
  *   3. 对每封邮件 GET /view/{id} 获取 HTML 正文（可选）
  */
-func TempemailsNetGetEmails(email, token string) ([]NormEmail, error) {
+func TempemailsNetGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("tempemails-net: 邮箱地址为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	/* 步骤 1：GET / 重新建立 session + 获取新 CSRF token */
-	csrf, err := tempemailsNetFetchCSRF(client)
+	csrf, err := tempemailsNetFetchCSRF(ctx, client)
 	if err != nil {
 		return nil, err
 	}
 
 	/* 步骤 2：POST /get_messages 获取邮件列表 */
-	req, err := http.NewRequest("POST", tempemailsNetBaseURL+"/get_messages", nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", tempemailsNetBaseURL+"/get_messages", nil)
 	if err != nil {
 		return nil, fmt.Errorf("tempemails-net: 创建获取邮件请求失败: %w", err)
 	}
@@ -205,7 +206,7 @@ func TempemailsNetGetEmails(email, token string) ([]NormEmail, error) {
 		/* 尝试获取邮件 ID 用于请求正文 */
 		mailID := tempemailsNetExtractID(item)
 		if mailID != "" {
-			htmlBody := tempemailsNetFetchMailBody(client, mailID)
+			htmlBody := tempemailsNetFetchMailBody(ctx, client, mailID)
 			if htmlBody != "" {
 				item["html"] = htmlBody
 			}
@@ -247,7 +248,7 @@ func tempemailsNetExtractID(item map[string]interface{}) string {
 /* tempemailsNetFetchMailBody 获取单封邮件的 HTML 正文
  * GET /view/{id} 返回 HTML 内容
  */
-func tempemailsNetFetchMailBody(client interface {
+func tempemailsNetFetchMailBody(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }, mailID string) string {
 	if mailID == "" {
@@ -255,11 +256,11 @@ func tempemailsNetFetchMailBody(client interface {
 	}
 
 	viewURL := fmt.Sprintf("%s/view/%s", tempemailsNetBaseURL, mailID)
-	req, err := http.NewRequest("GET", viewURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", viewURL, nil)
 	if err != nil {
 		return ""
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("Referer", tempemailsNetBaseURL+"/")
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -17,14 +18,14 @@ type tempfastmailBox struct {
 	UUID  string `json:"uuid"`
 }
 
-func TempfastmailGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest(http.MethodPost, tempfastmailBase+"/api/email-box", nil)
+func TempfastmailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tempfastmailBase+"/api/email-box", nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "Mozilla/5.0")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +35,7 @@ func TempfastmailGenerate() (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempfastmail create: http %d", resp.StatusCode)
+		return nil, statusError(resp, "tempfastmail create: http %d", resp.StatusCode)
 	}
 	var data tempfastmailBox
 	if err := json.Unmarshal(body, &data); err != nil {
@@ -66,14 +67,14 @@ func tempfastmailFlatten(raw map[string]any, recipient string) map[string]any {
 	}
 }
 
-func tempfastmailJSON(path string, out any) error {
-	req, err := http.NewRequest(http.MethodGet, tempfastmailBase+path, nil)
+func tempfastmailJSON(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tempfastmailBase+path, nil)
 	if err != nil {
 		return err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "Mozilla/5.0")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -83,22 +84,22 @@ func tempfastmailJSON(path string, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("tempfastmail: http %d", resp.StatusCode)
+		return statusError(resp, "tempfastmail: http %d", resp.StatusCode)
 	}
 	return json.Unmarshal(body, out)
 }
 
-func TempfastmailGetEmails(token string, email string) ([]NormEmail, error) {
+func TempfastmailGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	uuid := strings.TrimSpace(token)
 	address := strings.TrimSpace(email)
 	if uuid == "" {
-		return nil, fmt.Errorf("tempfastmail: empty token")
+		return nil, markError(ErrInvalidToken, "tempfastmail: empty token")
 	}
 	if address == "" {
 		return nil, fmt.Errorf("tempfastmail: empty email")
 	}
 	var rows []map[string]any
-	if err := tempfastmailJSON(fmt.Sprintf("/api/email-box/%s/emails", url.PathEscape(uuid)), &rows); err != nil {
+	if err := tempfastmailJSON(ctx, fmt.Sprintf("/api/email-box/%s/emails", url.PathEscape(uuid)), &rows); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(rows))
@@ -106,7 +107,7 @@ func TempfastmailGetEmails(token string, email string) ([]NormEmail, error) {
 		raw := row
 		if messageID, _ := row["uuid"].(string); strings.TrimSpace(messageID) != "" {
 			var detail map[string]any
-			if err := tempfastmailJSON(fmt.Sprintf("/api/email-box/%s/email/%s", url.PathEscape(uuid), url.PathEscape(messageID)), &detail); err == nil {
+			if err := tempfastmailJSON(ctx, fmt.Sprintf("/api/email-box/%s/email/%s", url.PathEscape(uuid), url.PathEscape(messageID)), &detail); err == nil {
 				raw = detail
 			}
 		}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	cryptorand "crypto/rand"
 	"encoding/base64"
 	"encoding/hex"
@@ -79,12 +80,12 @@ func tempgboxDecodePayload(body []byte) (*tempgboxPayload, error) {
 	return &payload, nil
 }
 
-func tempgboxPost(route string, deviceID string, body any) (*tempgboxPayload, int, error) {
+func tempgboxPost(ctx context.Context, route string, deviceID string, body any) (*tempgboxPayload, int, error) {
 	reqBody, err := json.Marshal(body)
 	if err != nil {
 		return nil, 0, err
 	}
-	req, err := http.NewRequest("POST", tempgboxAPIURL+"?route="+route, bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", tempgboxAPIURL+"?route="+route, bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -94,7 +95,7 @@ func tempgboxPost(route string, deviceID string, body any) (*tempgboxPayload, in
 		}
 	}
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -116,7 +117,7 @@ func tempgboxPost(route string, deviceID string, body any) (*tempgboxPayload, in
 		if reason == "" {
 			reason = payload.Message
 		}
-		return payload, resp.StatusCode, fmt.Errorf("tempgbox %s failed: %d %s", route, resp.StatusCode, reason)
+		return payload, resp.StatusCode, statusError(resp, "tempgbox %s failed: %d %s", route, resp.StatusCode, reason)
 	}
 	return payload, resp.StatusCode, nil
 }
@@ -130,9 +131,9 @@ func stringsCut(s, sep string) (string, string, bool) {
 	return s, "", false
 }
 
-func TempgboxGenerate() (*CreatedMailbox, error) {
+func TempgboxGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	deviceID := tempgboxRandomDeviceID()
-	payload, _, err := tempgboxPost("generate", deviceID, map[string]string{"variant": "googlemail"})
+	payload, _, err := tempgboxPost(ctx, "generate", deviceID, map[string]string{"variant": "googlemail"})
 	if err != nil {
 		return nil, err
 	}
@@ -155,11 +156,11 @@ func TempgboxGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func TempgboxGetEmails(deviceID string, email string) ([]NormEmail, error) {
+func TempgboxGetEmails(ctx context.Context, deviceID string, email string) ([]NormEmail, error) {
 	if deviceID == "" {
 		return nil, fmt.Errorf("tempgbox: missing device id")
 	}
-	payload, _, err := tempgboxPost("inbox", deviceID, map[string]string{"email": email})
+	payload, _, err := tempgboxPost(ctx, "inbox", deviceID, map[string]string{"email": email})
 	if err != nil {
 		return nil, err
 	}
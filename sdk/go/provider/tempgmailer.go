@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -49,15 +50,15 @@ type tempgmailerMessageItem struct {
 }
 
 // tempgmailerInitSession 访问首页获取 CSRF token 和 session cookie
-func tempgmailerInitSession() (tempgmailerSession, error) {
-	req, err := http.NewRequest("GET", tempgmailerBase, nil)
+func tempgmailerInitSession(ctx context.Context) (tempgmailerSession, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", tempgmailerBase, nil)
 	if err != nil {
 		return tempgmailerSession{}, err
 	}
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return tempgmailerSession{}, err
 	}
@@ -110,12 +111,12 @@ func tempgmailerExtractCSRF(html string) string {
 }
 
 // tempgmailerPost 发送 POST 请求到 tempgmailer API
-func tempgmailerPost(session tempgmailerSession, path string, body any) ([]byte, error) {
+func tempgmailerPost(ctx context.Context, session tempgmailerSession, path string, body any) ([]byte, error) {
 	reqBody, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", tempgmailerBase+path, bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", tempgmailerBase+path, bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -125,9 +126,9 @@ func tempgmailerPost(session tempgmailerSession, path string, body any) ([]byte,
 	req.Header.Set("X-TempGmailer-Auth", "frontend")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
 	req.Header.Set("Cookie", session.Cookie)
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +138,7 @@ func tempgmailerPost(session tempgmailerSession, path string, body any) ([]byte,
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempgmailer %s: %d %s", path, resp.StatusCode, string(raw))
+		return nil, statusError(resp, "tempgmailer %s: %d %s", path, resp.StatusCode, string(raw))
 	}
 	return raw, nil
 }
@@ -161,13 +162,13 @@ func tempgmailerDecodeSession(token string) (tempgmailerSession, error) {
 }
 
 // TempgmailerGenerate 创建临时 Gmail 邮箱
-func TempgmailerGenerate() (*CreatedMailbox, error) {
-	session, err := tempgmailerInitSession()
+func TempgmailerGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	session, err := tempgmailerInitSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	raw, err := tempgmailerPost(session, "/get-gmail", map[string]interface{}{
+	raw, err := tempgmailerPost(ctx, session, "/get-gmail", map[string]interface{}{
 		"refresh": true,
 		"adblock": 0,
 	})
@@ -191,13 +192,13 @@ func TempgmailerGenerate() (*CreatedMailbox, error) {
 }
 
 // TempgmailerGetEmails 获取邮件列表
-func TempgmailerGetEmails(token string, email string) ([]NormEmail, error) {
+func TempgmailerGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	session, err := tempgmailerDecodeSession(token)
 	if err != nil {
 		return nil, err
 	}
 
-	raw, err := tempgmailerPost(session, "/get-inbox", map[string]interface{}{
+	raw, err := tempgmailerPost(ctx, session, "/get-inbox", map[string]interface{}{
 		"email":   email,
 		"adblock": 0,
 	})
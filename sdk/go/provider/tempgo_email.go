@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -37,15 +38,15 @@ type tempgoEmailInboxResponse struct {
  * API: POST /api/generate（无 body，不发送 Content-Type header）
  * 返回邮箱地址，token 为 mailbox_id
  */
-func TempgoEmailGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func TempgoEmailGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("POST", tempgoEmailBaseURL+"/api/generate", nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", tempgoEmailBaseURL+"/api/generate", nil)
 	if err != nil {
 		return nil, fmt.Errorf("tempgo-email: 创建请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "application/json")
-	ua := getCurrentUA()
+	ua := getCurrentUA(ctx)
 	if ua != "" {
 		req.Header.Set("User-Agent", ua)
 	}
@@ -86,7 +87,7 @@ func TempgoEmailGenerate() (*CreatedMailbox, error) {
  * API: GET /api/inbox?email={email}&mailbox_id={mailbox_id}
  * token 即 mailbox_id
  */
-func TempgoEmailGetEmails(token, email string) ([]NormEmail, error) {
+func TempgoEmailGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	if token == "" {
 		return nil, fmt.Errorf("tempgo-email: mailbox_id（token）为空")
 	}
@@ -94,7 +95,7 @@ func TempgoEmailGetEmails(token, email string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("tempgo-email: 邮箱地址为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	u := fmt.Sprintf("%s/api/inbox?email=%s&mailbox_id=%s",
 		tempgoEmailBaseURL,
@@ -102,12 +103,12 @@ func TempgoEmailGetEmails(token, email string) ([]NormEmail, error) {
 		url.QueryEscape(token),
 	)
 
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, fmt.Errorf("tempgo-email: 创建获取邮件请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "application/json")
-	ua := getCurrentUA()
+	ua := getCurrentUA(ctx)
 	if ua != "" {
 		req.Header.Set("User-Agent", ua)
 	}
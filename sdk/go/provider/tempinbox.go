@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -65,7 +66,7 @@ func tempinboxRandomUser() string {
  * domain 为 nil 时使用 /email/Random 获取随机邮箱
  * domain 非 nil 时生成随机用户名并拼接指定域名调用 /email/{user}@{domain}
  */
-func TempinboxGenerate(domain *string, channel ...string) (*CreatedMailbox, error) {
+func TempinboxGenerate(ctx context.Context, domain *string, channel ...string) (*CreatedMailbox, error) {
 	var email string
 
 	if domain != nil && strings.TrimSpace(*domain) != "" {
@@ -77,12 +78,12 @@ func TempinboxGenerate(domain *string, channel ...string) (*CreatedMailbox, erro
 		user := tempinboxRandomUser()
 		addr := user + "@" + d
 		u := fmt.Sprintf("%s/email/%s", tempinboxBase, url.PathEscape(addr))
-		req, err := http.NewRequest("GET", u, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 		if err != nil {
 			return nil, err
 		}
 		tempinboxDefaultHeaders(req)
-		resp, err := HTTPClient().Do(req)
+		resp, err := HTTPClient(ctx).Do(req)
 		if err != nil {
 			return nil, err
 		}
@@ -92,18 +93,18 @@ func TempinboxGenerate(domain *string, channel ...string) (*CreatedMailbox, erro
 			return nil, err
 		}
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			return nil, fmt.Errorf("tempinbox create email: %d %s", resp.StatusCode, string(body))
+			return nil, statusError(resp, "tempinbox create email: %d %s", resp.StatusCode, string(body))
 		}
 		/* 响应为带引号的纯字符串，如 "user@domain" */
 		email = strings.Trim(strings.TrimSpace(string(body)), `"`)
 	} else {
 		/* 未指定域名，调用 /email/Random 获取随机邮箱 */
-		req, err := http.NewRequest("GET", tempinboxBase+"/email/Random", nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", tempinboxBase+"/email/Random", nil)
 		if err != nil {
 			return nil, err
 		}
 		tempinboxDefaultHeaders(req)
-		resp, err := HTTPClient().Do(req)
+		resp, err := HTTPClient(ctx).Do(req)
 		if err != nil {
 			return nil, err
 		}
@@ -113,7 +114,7 @@ func TempinboxGenerate(domain *string, channel ...string) (*CreatedMailbox, erro
 			return nil, err
 		}
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			return nil, fmt.Errorf("tempinbox random email: %d %s", resp.StatusCode, string(body))
+			return nil, statusError(resp, "tempinbox random email: %d %s", resp.StatusCode, string(body))
 		}
 		/* 响应为带引号的纯字符串，如 "user@domain" */
 		email = strings.Trim(strings.TrimSpace(string(body)), `"`)
@@ -134,19 +135,19 @@ func TempinboxGenerate(domain *string, channel ...string) (*CreatedMailbox, erro
  * TempinboxGetEmails 获取 tempinbox 邮件列表
  * 调用 GET /messages/{email}，对返回的每封邮件通过 NormalizeMap 标准化
  */
-func TempinboxGetEmails(email string) ([]NormEmail, error) {
+func TempinboxGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("tempinbox: empty email")
 	}
 	seg := url.PathEscape(email)
 	u := fmt.Sprintf("%s/messages/%s", tempinboxBase, seg)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	tempinboxDefaultHeaders(req)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +157,7 @@ func TempinboxGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempinbox messages: %d", resp.StatusCode)
+		return nil, statusError(resp, "tempinbox messages: %d", resp.StatusCode)
 	}
 	var rawList []map[string]interface{}
 	if err := json.Unmarshal(body, &rawList); err != nil {
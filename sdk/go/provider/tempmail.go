@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -31,24 +32,24 @@ type tempmailEmailsResponse struct {
 	Success bool              `json:"success"`
 }
 
-func TempmailGenerate(duration int) (*CreatedMailbox, error) {
+func TempmailGenerate(ctx context.Context, duration int) (*CreatedMailbox, error) {
 	if duration <= 0 {
 		duration = 30
 	}
 
 	reqBody, _ := json.Marshal(TempmailGenerateRequest{Duration: duration})
 
-	req, err := http.NewRequest("POST", tempmailBaseURL+"/generate", bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", tempmailBaseURL+"/generate", bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Referer", "https://tempmail.ing/")
 	req.Header.Set("DNT", "1")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -79,19 +80,19 @@ func TempmailGenerate(duration int) (*CreatedMailbox, error) {
 	return info, nil
 }
 
-func TempmailGetEmails(email string) ([]NormEmail, error) {
+func TempmailGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	encodedEmail := url.QueryEscape(email)
 
-	req, err := http.NewRequest("GET", tempmailBaseURL+"/emails/"+encodedEmail, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempmailBaseURL+"/emails/"+encodedEmail, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 	req.Header.Set("Referer", "https://tempmail.ing/")
 	req.Header.Set("DNT", "1")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -50,14 +51,14 @@ var tempmail365SubjectRe = regexp.MustCompile(`(?:主题|Subject)\s*[:：]\s*(.+
  * Tempmail365Generate 创建 tempmail365 临时邮箱
  * 流程：获取域名列表 → 随机选域名 → 随机用户名 → create_email
  */
-func Tempmail365Generate(channel ...string) (*CreatedMailbox, error) {
+func Tempmail365Generate(ctx context.Context, channel ...string) (*CreatedMailbox, error) {
 	/* 获取域名列表 */
 	configURL := tempmail365Base + "?action=get_config"
-	req, err := http.NewRequest("GET", configURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", configURL, nil)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +68,7 @@ func Tempmail365Generate(channel ...string) (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempmail365 get_config: %d", resp.StatusCode)
+		return nil, statusError(resp, "tempmail365 get_config: %d", resp.StatusCode)
 	}
 	var cr tempmail365ConfigResp
 	if err := json.Unmarshal(body, &cr); err != nil {
@@ -88,11 +89,11 @@ func Tempmail365Generate(channel ...string) (*CreatedMailbox, error) {
 	/* 创建邮箱 */
 	createURL := fmt.Sprintf("%s?action=create_email&email=%s&domain=%s",
 		tempmail365Base, url.QueryEscape(email), url.QueryEscape(domain))
-	req2, err := http.NewRequest("GET", createURL, nil)
+	req2, err := http.NewRequestWithContext(ctx, "GET", createURL, nil)
 	if err != nil {
 		return nil, err
 	}
-	resp2, err := HTTPClient().Do(req2)
+	resp2, err := HTTPClient(ctx).Do(req2)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +103,7 @@ func Tempmail365Generate(channel ...string) (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if resp2.StatusCode < 200 || resp2.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempmail365 create_email: %d", resp2.StatusCode)
+		return nil, statusError(resp2, "tempmail365 create_email: %d", resp2.StatusCode)
 	}
 	var createResp tempmail365CreateResp
 	if err := json.Unmarshal(body2, &createResp); err != nil {
@@ -123,7 +124,7 @@ func Tempmail365Generate(channel ...string) (*CreatedMailbox, error) {
  * Tempmail365GetEmails 获取 tempmail365 邮件列表
  * 流程：fetch_mail → 判断是否有邮件 → 提取发件人/主题 → 构造邮件对象 → normalize
  */
-func Tempmail365GetEmails(email string) ([]NormEmail, error) {
+func Tempmail365GetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("tempmail365: 邮箱地址为空")
@@ -131,11 +132,11 @@ func Tempmail365GetEmails(email string) ([]NormEmail, error) {
 
 	fetchURL := fmt.Sprintf("%s?action=fetch_mail&email=%s",
 		tempmail365Base, url.QueryEscape(email))
-	req, err := http.NewRequest("GET", fetchURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fetchURL, nil)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +146,7 @@ func Tempmail365GetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempmail365 fetch_mail: %d", resp.StatusCode)
+		return nil, statusError(resp, "tempmail365 fetch_mail: %d", resp.StatusCode)
 	}
 	var fr tempmail365FetchResp
 	if err := json.Unmarshal(body, &fr); err != nil {
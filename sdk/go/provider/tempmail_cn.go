@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -115,7 +116,7 @@ func tempmailCNParseEvent(packet string) (string, interface{}, bool) {
 	return event, payload, true
 }
 
-func tempmailCNDial(host string) (*websocket.Conn, error) {
+func tempmailCNDial(ctx context.Context, host string) (*websocket.Conn, error) {
 	var lastErr error
 	for _, version := range tempmailCNVersions {
 		u := url.URL{Scheme: "wss", Host: host, Path: "/socket.io/"}
@@ -127,11 +128,11 @@ func tempmailCNDial(host string) (*websocket.Conn, error) {
 		hdr := http.Header{}
 		hdr.Set("Origin", "https://"+host)
 		hdr.Set("Referer", "https://"+host+"/")
-		hdr.Set("User-Agent", GetCurrentUA())
+		hdr.Set("User-Agent", GetCurrentUA(ctx))
 		hdr.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8,en-GB;q=0.7,en-US;q=0.6")
 
 		d := websocket.Dialer{HandshakeTimeout: 15 * time.Second}
-		conn, _, err := d.Dial(u.String(), hdr)
+		conn, _, err := d.DialContext(ctx, u.String(), hdr)
 		if err != nil {
 			lastErr = err
 			continue
@@ -234,11 +235,12 @@ func tempmailCNFlatten(raw map[string]interface{}, recipient string) map[string]
 		"date":        tempmailCNString(headers["date"]),
 		"isRead":      false,
 		"attachments": raw["attachments"],
+		"headers":     headers,
 	}
 }
 
-func tempmailCNRequestShortID(host string) (string, error) {
-	conn, err := tempmailCNDial(host)
+func tempmailCNRequestShortID(ctx context.Context, host string) (string, error) {
+	conn, err := tempmailCNDial(ctx, host)
 	if err != nil {
 		return "", err
 	}
@@ -275,9 +277,9 @@ func tempmailCNRequestShortID(host string) (string, error) {
 	}
 }
 
-func TempmailCNGenerate(domain *string) (*CreatedMailbox, error) {
+func TempmailCNGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
 	host := tempmailCNNormalizeHost(domain)
-	shortID, err := tempmailCNRequestShortID(host)
+	shortID, err := tempmailCNRequestShortID(ctx, host)
 	if err != nil {
 		return nil, err
 	}
@@ -287,14 +289,14 @@ func TempmailCNGenerate(domain *string) (*CreatedMailbox, error) {
 	}, nil
 }
 
-func tempmailCNReadLoop(email, local, host string, box *tempmailCNBox) {
+func tempmailCNReadLoop(ctx context.Context, email, local, host string, box *tempmailCNBox) {
 	defer func() {
 		box.mu.Lock()
 		box.started = false
 		box.mu.Unlock()
 	}()
 
-	conn, err := tempmailCNDial(host)
+	conn, err := tempmailCNDial(ctx, host)
 	if err != nil {
 		return
 	}
@@ -337,18 +339,18 @@ func tempmailCNReadLoop(email, local, host string, box *tempmailCNBox) {
 	}
 }
 
-func TempmailCNGetEmails(email string) ([]NormEmail, error) {
+func TempmailCNGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	_, host, err := tempmailCNParseAddress(email)
 	if err != nil {
 		return nil, err
 	}
 
 	apiURL := fmt.Sprintf("https://%s/api/mails/%s", host, url.QueryEscape(email))
-	req, err := fhttp.NewRequest("GET", apiURL, nil)
+	req, err := fhttp.NewRequestWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8,en-GB;q=0.7,en-US;q=0.6")
 	req.Header.Set("Cache-Control", "no-cache")
@@ -356,7 +358,7 @@ func TempmailCNGetEmails(email string) ([]NormEmail, error) {
 	req.Header.Set("Pragma", "no-cache")
 	req.Header.Set("Referer", "https://"+host+"/")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -64,14 +65,14 @@ func tempmailFishFlatten(raw tempmailFishMessage, recipient string) map[string]a
  * API: POST /emails/new-email → {"email":"xxx","authKey":"xxx","emails":[]}
  * Token 存储为 JSON 字符串 {"email":"...","authKey":"..."}，供 getEmails 解析
  */
-func TempmailFishGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest(http.MethodPost, tempmailFishBase+"/emails/new-email", bytes.NewReader(nil))
+func TempmailFishGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tempmailFishBase+"/emails/new-email", bytes.NewReader(nil))
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +82,7 @@ func TempmailFishGenerate() (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempmail-fish: 创建邮箱失败 http %d", resp.StatusCode)
+		return nil, statusError(resp, "tempmail-fish: 创建邮箱失败 http %d", resp.StatusCode)
 	}
 
 	var data tempmailFishNewResponse
@@ -111,7 +112,7 @@ func TempmailFishGenerate() (*CreatedMailbox, error) {
  * @param token - JSON 字符串，包含 email 和 authKey
  * @param email - 邮箱地址
  */
-func TempmailFishGetEmails(token, email string) ([]NormEmail, error) {
+func TempmailFishGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	token = strings.TrimSpace(token)
 	if token == "" {
 		return nil, fmt.Errorf("tempmail-fish: token 不能为空")
@@ -131,13 +132,13 @@ func TempmailFishGetEmails(token, email string) ([]NormEmail, error) {
 	}
 
 	u := fmt.Sprintf("%s/emails/emails?emailAddress=%s", tempmailFishBase, url.QueryEscape(address))
-	req, err := http.NewRequest(http.MethodGet, u, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Authorization", authKey)
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +148,7 @@ func TempmailFishGetEmails(token, email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempmail-fish: 获取邮件失败 http %d", resp.StatusCode)
+		return nil, statusError(resp, "tempmail-fish: 获取邮件失败 http %d", resp.StatusCode)
 	}
 
 	/* 响应通常是邮件数组，个别情况可能包裹在 {"emails":[...]} 中 */
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,14 +26,14 @@ var tempmailFyiCSRFRe = regexp.MustCompile(`csrfToken"\s*value="([^"]+)"`)
 
 /* tempmailFyiBrowserHeaders 设置浏览器模拟请求头（首页 GET） */
 func tempmailFyiBrowserHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 }
 
 /* tempmailFyiAPIHeaders 设置 API 请求头（携带 CSRF token） */
 func tempmailFyiAPIHeaders(req *http.Request, csrfToken string) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "application/json, text/javascript, */*; q=0.01")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 	req.Header.Set("Content-Type", "application/json")
@@ -47,11 +48,11 @@ func tempmailFyiAPIHeaders(req *http.Request, csrfToken string) {
  *   2. POST /api/generate_email.php 创建邮箱，返回 {"success":true,"email_address":"xxx@...","expires_at":"...","error":null}
  * token: CSRF token 值（GetEmails 时复用）
  */
-func TempMailFyiGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func TempMailFyiGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	/* 步骤 1：GET / 获取 session cookie 和 CSRF token */
-	req, err := http.NewRequest("GET", tempmailFyiBaseURL+"/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempmailFyiBaseURL+"/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("tempmail-fyi: 创建首页请求失败: %w", err)
 	}
@@ -80,7 +81,7 @@ func TempMailFyiGenerate() (*CreatedMailbox, error) {
 	csrfToken := m[1]
 
 	/* 步骤 2：POST /api/generate_email.php 创建邮箱 */
-	req2, err := http.NewRequest("POST", tempmailFyiBaseURL+"/api/generate_email.php", bytes.NewReader([]byte("{}")))
+	req2, err := http.NewRequestWithContext(ctx, "POST", tempmailFyiBaseURL+"/api/generate_email.php", bytes.NewReader([]byte("{}")))
 	if err != nil {
 		return nil, fmt.Errorf("tempmail-fyi: 创建邮箱请求失败: %w", err)
 	}
@@ -138,7 +139,7 @@ func TempMailFyiGenerate() (*CreatedMailbox, error) {
  *   返回 {"success":true,"emails":[...],"error":null}
  * token: 创建邮箱时获得的 CSRF token
  */
-func TempMailFyiGetEmails(email, token string) ([]NormEmail, error) {
+func TempMailFyiGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("tempmail-fyi: 邮箱地址为空")
@@ -147,7 +148,7 @@ func TempMailFyiGetEmails(email, token string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("tempmail-fyi: CSRF token 为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	/* 构造请求体 {"email_address":"xxx@..."} */
 	reqBody, err := json.Marshal(map[string]string{"email_address": email})
@@ -155,7 +156,7 @@ func TempMailFyiGetEmails(email, token string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("tempmail-fyi: 构造请求体失败: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", tempmailFyiBaseURL+"/api/get_emails.php", bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", tempmailFyiBaseURL+"/api/get_emails.php", bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, fmt.Errorf("tempmail-fyi: 创建获取邮件请求失败: %w", err)
 	}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -27,20 +28,20 @@ type tempmailLolEmailsResponse struct {
 	Expired bool              `json:"expired"`
 }
 
-func TempmailLolGenerate(domain *string) (*CreatedMailbox, error) {
+func TempmailLolGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
 	reqBody, _ := json.Marshal(TempmailLolGenerateRequest{Domain: domain, Captcha: nil})
 
-	req, err := http.NewRequest("POST", tempmailLolBaseURL+"/inbox/create", bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", tempmailLolBaseURL+"/inbox/create", bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Origin", "https://tempmail.lol")
 	req.Header.Set("DNT", "1")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -69,19 +70,19 @@ func TempmailLolGenerate(domain *string) (*CreatedMailbox, error) {
 	return info, nil
 }
 
-func TempmailLolGetEmails(token string, recipientEmail string) ([]NormEmail, error) {
+func TempmailLolGetEmails(ctx context.Context, token string, recipientEmail string) ([]NormEmail, error) {
 	encodedToken := url.QueryEscape(token)
 
-	req, err := http.NewRequest("GET", tempmailLolBaseURL+"/inbox?token="+encodedToken, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempmailLolBaseURL+"/inbox?token="+encodedToken, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 	req.Header.Set("Origin", "https://tempmail.lol")
 	req.Header.Set("DNT", "1")
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -101,6 +102,9 @@ func TempmailLolGetEmails(token string, recipientEmail string) ([]NormEmail, err
 	if err := json.Unmarshal(body, &result); err != nil {
 		return nil, err
 	}
+	if result.Expired {
+		return nil, markError(ErrMailboxExpired, "tempmail-lol: inbox expired")
+	}
 
 	return NormalizeRawMessages(result.Emails, recipientEmail)
 }
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -18,16 +19,16 @@ const tempmailLolV2BaseURL = "https://api.tempmail.lol"
  * TempmailLolV2Generate 创建临时邮箱
  * API: GET /generate → {"address":"...","token":"..."}
  */
-func TempmailLolV2Generate() (*CreatedMailbox, error) {
-	client := HTTPClient()
-	resp, err := client.Get(tempmailLolV2BaseURL + "/generate")
+func TempmailLolV2Generate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
+	resp, err := doGet(ctx, client, tempmailLolV2BaseURL+"/generate")
 	if err != nil {
 		return nil, fmt.Errorf("tempmail-lol-v2 generate request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("tempmail-lol-v2 generate failed: %d", resp.StatusCode)
+		return nil, statusError(resp, "tempmail-lol-v2 generate failed: %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -59,17 +60,17 @@ func TempmailLolV2Generate() (*CreatedMailbox, error) {
  * TempmailLolV2GetEmails 获取邮件列表
  * API: GET /auth/<token> → {"email":[...]}
  */
-func TempmailLolV2GetEmails(token string, email string) ([]NormEmail, error) {
+func TempmailLolV2GetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	u := tempmailLolV2BaseURL + "/auth/" + url.PathEscape(token)
-	client := HTTPClient()
-	resp, err := client.Get(u)
+	client := HTTPClient(ctx)
+	resp, err := doGet(ctx, client, u)
 	if err != nil {
 		return nil, fmt.Errorf("tempmail-lol-v2 get emails request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("tempmail-lol-v2 get emails failed: %d", resp.StatusCode)
+		return nil, statusError(resp, "tempmail-lol-v2 get emails failed: %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
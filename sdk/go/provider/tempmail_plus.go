@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -32,7 +33,7 @@ func tempmailPlusRandomLocal() string {
 }
 
 /* TempmailPlusGenerate 创建 tempmail-plus 临时邮箱 */
-func TempmailPlusGenerate(domain *string, channel ...string) (*CreatedMailbox, error) {
+func TempmailPlusGenerate(ctx context.Context, domain *string, channel ...string) (*CreatedMailbox, error) {
 	selectedDomain := tempmailPlusDomain
 	if domain != nil {
 		d := strings.TrimSpace(*domain)
@@ -50,13 +51,13 @@ func TempmailPlusGenerate(domain *string, channel ...string) (*CreatedMailbox, e
 
 	/* 调用列表接口验证地址可用 */
 	u := fmt.Sprintf("%s/?email=%s&epin=", tempmailPlusBase, email)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	tempmailPlusDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -64,14 +65,14 @@ func TempmailPlusGenerate(domain *string, channel ...string) (*CreatedMailbox, e
 	io.ReadAll(resp.Body)
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempmail-plus: 验证邮箱失败 %d", resp.StatusCode)
+		return nil, statusError(resp, "tempmail-plus: 验证邮箱失败 %d", resp.StatusCode)
 	}
 
 	return &CreatedMailbox{Channel: selectedChannel, Email: email, Token: ""}, nil
 }
 
 /* TempmailPlusGetEmails 获取 tempmail-plus 邮件列表 */
-func TempmailPlusGetEmails(email string) ([]NormEmail, error) {
+func TempmailPlusGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("tempmail-plus: 邮箱地址为空")
@@ -79,13 +80,13 @@ func TempmailPlusGetEmails(email string) ([]NormEmail, error) {
 
 	/* 获取邮件列表 */
 	u := fmt.Sprintf("%s/?email=%s&epin=", tempmailPlusBase, email)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	tempmailPlusDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +97,7 @@ func TempmailPlusGetEmails(email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempmail-plus list: %d", resp.StatusCode)
+		return nil, statusError(resp, "tempmail-plus list: %d", resp.StatusCode)
 	}
 
 	var listResp struct {
@@ -115,7 +116,7 @@ func TempmailPlusGetEmails(email string) ([]NormEmail, error) {
 	emails := make([]NormEmail, 0, len(listResp.MailList))
 	for _, item := range listResp.MailList {
 		/* 获取单封邮件详情以拿到正文 */
-		htmlBody, textBody := tempmailPlusFetchBody(item.MailID, email)
+		htmlBody, textBody := tempmailPlusFetchBody(ctx, item.MailID, email)
 
 		flat := map[string]interface{}{
 			"id":      fmt.Sprintf("%d", item.MailID),
@@ -133,18 +134,18 @@ func TempmailPlusGetEmails(email string) ([]NormEmail, error) {
 }
 
 /* tempmailPlusFetchBody 获取单封邮件正文 */
-func tempmailPlusFetchBody(mailID int, email string) (string, string) {
+func tempmailPlusFetchBody(ctx context.Context, mailID int, email string) (string, string) {
 	if mailID == 0 {
 		return "", ""
 	}
 	u := fmt.Sprintf("%s/%d?email=%s&epin=", tempmailPlusBase, mailID, email)
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return "", ""
 	}
 	tempmailPlusDefaultHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return "", ""
 	}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,13 +25,13 @@ type tempmailcInboxResponse struct {
 	Messages []map[string]any `json:"messages"`
 }
 
-func tempmailcGetJSON(u string) ([]byte, error) {
-	req, err := http.NewRequest("GET", u, nil)
+func tempmailcGetJSON(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +41,7 @@ func tempmailcGetJSON(u string) ([]byte, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("tempmailc: http %d", resp.StatusCode)
+		return nil, statusError(resp, "tempmailc: http %d", resp.StatusCode)
 	}
 	return body, nil
 }
@@ -66,8 +67,8 @@ func tempmailcFlattenListMessage(raw map[string]any, recipient string) map[strin
 	}
 }
 
-func TempmailcGenerate() (*CreatedMailbox, error) {
-	body, err := tempmailcGetJSON(tempmailcBase + "/new")
+func TempmailcGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	body, err := tempmailcGetJSON(ctx, tempmailcBase+"/new")
 	if err != nil {
 		return nil, err
 	}
@@ -82,14 +83,14 @@ func TempmailcGenerate() (*CreatedMailbox, error) {
 	return &CreatedMailbox{Channel: "tempmailc", Email: email}, nil
 }
 
-func tempmailcFetchMessage(email, messageID string) (map[string]any, error) {
+func tempmailcFetchMessage(ctx context.Context, email, messageID string) (map[string]any, error) {
 	u := fmt.Sprintf(
 		"%s/message?email=%s&msg_id=%s",
 		tempmailcBase,
 		url.QueryEscape(email),
 		url.QueryEscape(messageID),
 	)
-	body, err := tempmailcGetJSON(u)
+	body, err := tempmailcGetJSON(ctx, u)
 	if err != nil {
 		return nil, err
 	}
@@ -103,14 +104,14 @@ func tempmailcFetchMessage(email, messageID string) (map[string]any, error) {
 	return raw, nil
 }
 
-func TempmailcGetEmails(email string) ([]NormEmail, error) {
+func TempmailcGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("tempmailc: empty email")
 	}
 
 	u := fmt.Sprintf("%s/inbox?email=%s", tempmailcBase, url.QueryEscape(email))
-	body, err := tempmailcGetJSON(u)
+	body, err := tempmailcGetJSON(ctx, u)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +127,7 @@ func TempmailcGetEmails(email string) ([]NormEmail, error) {
 	for _, item := range data.Messages {
 		id := tempmailcString(item["id"])
 		if id != "" {
-			detail, err := tempmailcFetchMessage(email, id)
+			detail, err := tempmailcFetchMessage(ctx, email, id)
 			if err == nil {
 				out = append(out, NormalizeMap(detail, email))
 				continue
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -30,12 +31,12 @@ type tempmailproDetailResponse struct {
 	Data map[string]any `json:"data"`
 }
 
-func tempmailproJSON(method, u string, body []byte, out any) error {
+func tempmailproJSON(ctx context.Context, method, u string, body []byte, out any) error {
 	var reader io.Reader
 	if body != nil {
 		reader = bytes.NewReader(body)
 	}
-	req, err := http.NewRequest(method, u, reader)
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
 	if err != nil {
 		return err
 	}
@@ -45,7 +46,7 @@ func tempmailproJSON(method, u string, body []byte, out any) error {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -56,7 +57,7 @@ func tempmailproJSON(method, u string, body []byte, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("tempmailpro: http %d", resp.StatusCode)
+		return statusError(resp, "tempmailpro: http %d", resp.StatusCode)
 	}
 	if out == nil {
 		return nil
@@ -92,9 +93,9 @@ func tempmailproFlatten(raw map[string]any, recipient string) map[string]any {
 	}
 }
 
-func TempmailproGenerate() (*CreatedMailbox, error) {
+func TempmailproGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	var data tempmailproMailboxResponse
-	if err := tempmailproJSON(http.MethodPost, tempmailproBase+"/mailbox/create", []byte("{}"), &data); err != nil {
+	if err := tempmailproJSON(ctx, http.MethodPost, tempmailproBase+"/mailbox/create", []byte("{}"), &data); err != nil {
 		return nil, err
 	}
 	email := strings.TrimSpace(data.Data.Address)
@@ -111,10 +112,10 @@ func TempmailproGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func tempmailproFetchDetail(token, messageID string) (map[string]any, error) {
+func tempmailproFetchDetail(ctx context.Context, token, messageID string) (map[string]any, error) {
 	u := fmt.Sprintf("%s/mailbox/%s/emails/%s", tempmailproBase, url.PathEscape(token), url.PathEscape(messageID))
 	var data tempmailproDetailResponse
-	if err := tempmailproJSON(http.MethodGet, u, nil, &data); err != nil {
+	if err := tempmailproJSON(ctx, http.MethodGet, u, nil, &data); err != nil {
 		return nil, err
 	}
 	if data.Data == nil {
@@ -123,11 +124,11 @@ func tempmailproFetchDetail(token, messageID string) (map[string]any, error) {
 	return data.Data, nil
 }
 
-func TempmailproGetEmails(token, email string) ([]NormEmail, error) {
+func TempmailproGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	mailboxToken := strings.TrimSpace(token)
 	address := strings.TrimSpace(email)
 	if mailboxToken == "" {
-		return nil, fmt.Errorf("tempmailpro: empty token")
+		return nil, markError(ErrInvalidToken, "tempmailpro: empty token")
 	}
 	if address == "" {
 		return nil, fmt.Errorf("tempmailpro: empty email")
@@ -135,14 +136,14 @@ func TempmailproGetEmails(token, email string) ([]NormEmail, error) {
 
 	u := fmt.Sprintf("%s/mailbox/%s/emails", tempmailproBase, url.PathEscape(mailboxToken))
 	var data tempmailproListResponse
-	if err := tempmailproJSON(http.MethodGet, u, nil, &data); err != nil {
+	if err := tempmailproJSON(ctx, http.MethodGet, u, nil, &data); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(data.Data))
 	for _, row := range data.Data {
 		raw := row
 		if id := tempmailproString(row["id"]); id != "" {
-			if detail, err := tempmailproFetchDetail(mailboxToken, id); err == nil {
+			if detail, err := tempmailproFetchDetail(ctx, mailboxToken, id); err == nil {
 				raw = detail
 			}
 		}
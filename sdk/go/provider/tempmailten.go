@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -26,14 +27,14 @@ var tempmailtenCSRFRe = regexp.MustCompile(`<meta\s+name="csrf-token"\s+content=
 
 /* tempmailtenBrowserHeaders 设置浏览器模拟请求头 */
 func tempmailtenBrowserHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 }
 
 /* tempmailtenAjaxHeaders 设置 AJAX 请求头 */
 func tempmailtenAjaxHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "application/json, text/javascript, */*; q=0.01")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
@@ -46,10 +47,10 @@ func tempmailtenAjaxHeaders(req *http.Request) {
  *   2. POST /messages（body: _token={csrf}&captcha=）获取分配的邮箱地址
  * token: CSRF token 值
  */
-func TempmailtenGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func TempmailtenGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", tempmailtenBaseURL+"/en", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempmailtenBaseURL+"/en", nil)
 	if err != nil {
 		return nil, fmt.Errorf("tempmailten: 创建首页请求失败: %w", err)
 	}
@@ -80,7 +81,7 @@ func TempmailtenGenerate() (*CreatedMailbox, error) {
 	form.Set("_token", csrfToken)
 	form.Set("captcha", "")
 
-	req2, err := http.NewRequest("POST", tempmailtenBaseURL+"/messages", strings.NewReader(form.Encode()))
+	req2, err := http.NewRequestWithContext(ctx, "POST", tempmailtenBaseURL+"/messages", strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("tempmailten: 创建消息请求失败: %w", err)
 	}
@@ -121,7 +122,7 @@ func TempmailtenGenerate() (*CreatedMailbox, error) {
  *   2. 对每封邮件 GET /view/{id} 获取 HTML 正文
  * messages 数组每个元素: {id, from, from_email, subject, is_seen}
  */
-func TempmailtenGetEmails(email, token string) ([]NormEmail, error) {
+func TempmailtenGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("tempmailten: 邮箱地址为空")
@@ -131,13 +132,13 @@ func TempmailtenGetEmails(email, token string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("tempmailten: 缺少 CSRF token")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	form := url.Values{}
 	form.Set("_token", csrfToken)
 	form.Set("captcha", "")
 
-	req, err := http.NewRequest("POST", tempmailtenBaseURL+"/messages", strings.NewReader(form.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", tempmailtenBaseURL+"/messages", strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("tempmailten: 创建消息请求失败: %w", err)
 	}
@@ -160,7 +161,7 @@ func TempmailtenGetEmails(email, token string) ([]NormEmail, error) {
 		return nil, err
 	}
 
-	return tempmailtenParseMessages(body, email, csrfToken, client)
+	return tempmailtenParseMessages(ctx, body, email, csrfToken, client)
 }
 
 /* tempmailtenExtractMailbox 从 POST /messages 的 JSON 响应中提取邮箱地址 */
@@ -184,7 +185,7 @@ func tempmailtenExtractMailbox(data []byte) (string, error) {
 }
 
 /* tempmailtenParseMessages 解析邮件列表并拉取正文 */
-func tempmailtenParseMessages(data []byte, email, csrfToken string, client interface {
+func tempmailtenParseMessages(ctx context.Context, data []byte, email, csrfToken string, client interface {
 	Do(*http.Request) (*http.Response, error)
 }) ([]NormEmail, error) {
 	parsed := tempmailtenParseJSON(data)
@@ -215,7 +216,7 @@ func tempmailtenParseMessages(data []byte, email, csrfToken string, client inter
 			fromAddr = fmt.Sprintf("%s <%s>", fromName, fromAddr)
 		}
 
-		htmlBody := tempmailtenFetchView(client, id)
+		htmlBody := tempmailtenFetchView(ctx, client, id)
 
 		isSeen, _ := msg["is_seen"].(bool)
 
@@ -234,14 +235,14 @@ func tempmailtenParseMessages(data []byte, email, csrfToken string, client inter
 }
 
 /* tempmailtenFetchView 获取单封邮件的 HTML 正文（GET /view/{id}） */
-func tempmailtenFetchView(client interface {
+func tempmailtenFetchView(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }, id string) string {
 	if id == "" {
 		return ""
 	}
 
-	req, err := http.NewRequest("GET", tempmailtenBaseURL+"/view/"+url.PathEscape(id), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tempmailtenBaseURL+"/view/"+url.PathEscape(id), nil)
 	if err != nil {
 		return ""
 	}
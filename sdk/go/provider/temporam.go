@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
@@ -28,16 +29,16 @@ type temporamDetailResp struct {
 	Data map[string]any `json:"data"`
 }
 
-func temporamJSONRequest(path string, out any) error {
-	req, err := http.NewRequest("GET", temporamOrigin+path, nil)
+func temporamJSONRequest(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", temporamOrigin+path, nil)
 	if err != nil {
 		return err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Accept-Encoding", "gzip, deflate, br")
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -76,9 +77,9 @@ func temporamRandomLocal() string {
 	return b.String()
 }
 
-func temporamDomains() ([]string, error) {
+func temporamDomains(ctx context.Context) ([]string, error) {
 	var data temporamDomainsResp
-	if err := temporamJSONRequest("/api/domains", &data); err != nil {
+	if err := temporamJSONRequest(ctx, "/api/domains", &data); err != nil {
 		return nil, err
 	}
 	domains := make([]string, 0, len(data.Data))
@@ -94,8 +95,8 @@ func temporamDomains() ([]string, error) {
 	return domains, nil
 }
 
-func TemporamGenerate(domain *string) (*CreatedMailbox, error) {
-	domains, err := temporamDomains()
+func TemporamGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
+	domains, err := temporamDomains(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -137,7 +138,7 @@ func temporamNormalize(raw map[string]any, recipient string) NormEmail {
 	return NormalizeMap(raw, recipient)
 }
 
-func TemporamGetEmails(token string, email string) ([]NormEmail, error) {
+func TemporamGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	recipient := token
 	if recipient == "" {
 		recipient = email
@@ -148,7 +149,7 @@ func TemporamGetEmails(token string, email string) ([]NormEmail, error) {
 
 	var list temporamEmailsResp
 	path := "/api/emails?email=" + url.QueryEscape(recipient) + "&limit=50"
-	if err := temporamJSONRequest(path, &list); err != nil {
+	if err := temporamJSONRequest(ctx, path, &list); err != nil {
 		return nil, err
 	}
 
@@ -157,7 +158,7 @@ func TemporamGetEmails(token string, email string) ([]NormEmail, error) {
 		raw := row
 		if id, ok := row["id"].(string); ok && id != "" {
 			var detail temporamDetailResp
-			if err := temporamJSONRequest("/api/emails/"+url.PathEscape(id), &detail); err == nil && detail.Data != nil {
+			if err := temporamJSONRequest(ctx, "/api/emails/"+url.PathEscape(id), &detail); err == nil && detail.Data != nil {
 				raw = detail.Data
 			}
 		}
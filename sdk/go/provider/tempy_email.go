@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,12 +26,12 @@ type tempyEmailListResponse struct {
 	Messages []map[string]any `json:"messages"`
 }
 
-func tempyEmailJSON(method, u string, body []byte, out any) error {
+func tempyEmailJSON(ctx context.Context, method, u string, body []byte, out any) error {
 	var reader io.Reader
 	if body != nil {
 		reader = bytes.NewReader(body)
 	}
-	req, err := http.NewRequest(method, u, reader)
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
 	if err != nil {
 		return err
 	}
@@ -40,7 +41,7 @@ func tempyEmailJSON(method, u string, body []byte, out any) error {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -51,7 +52,7 @@ func tempyEmailJSON(method, u string, body []byte, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("tempy-email: http %d", resp.StatusCode)
+		return statusError(resp, "tempy-email: http %d", resp.StatusCode)
 	}
 	if out == nil {
 		return nil
@@ -117,7 +118,7 @@ func tempyEmailFlatten(raw map[string]any, recipient string) map[string]any {
 	}
 }
 
-func TempyEmailGenerate(domain *string) (*CreatedMailbox, error) {
+func TempyEmailGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
 	body := map[string]any{}
 	if domain != nil && strings.TrimSpace(*domain) != "" {
 		body["domain"] = strings.TrimSpace(*domain)
@@ -127,7 +128,7 @@ func TempyEmailGenerate(domain *string) (*CreatedMailbox, error) {
 		return nil, err
 	}
 	var data tempyEmailCreateResponse
-	if err := tempyEmailJSON(http.MethodPost, tempyEmailBase+"/mailbox", reqBody, &data); err != nil {
+	if err := tempyEmailJSON(ctx, http.MethodPost, tempyEmailBase+"/mailbox", reqBody, &data); err != nil {
 		return nil, err
 	}
 	email := strings.TrimSpace(data.Email)
@@ -141,7 +142,7 @@ func TempyEmailGenerate(domain *string) (*CreatedMailbox, error) {
 	}, nil
 }
 
-func TempyEmailGetEmails(email string) ([]NormEmail, error) {
+func TempyEmailGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("tempy-email: empty email")
@@ -149,7 +150,7 @@ func TempyEmailGetEmails(email string) ([]NormEmail, error) {
 
 	u := fmt.Sprintf("%s/mailbox/%s/messages", tempyEmailBase, url.PathEscape(address))
 	var data tempyEmailListResponse
-	if err := tempyEmailJSON(http.MethodGet, u, nil, &data); err != nil {
+	if err := tempyEmailJSON(ctx, http.MethodGet, u, nil, &data); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(data.Messages))
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -57,14 +58,14 @@ type tenMinuteMailNetDetailResponse struct {
  * API: GET /address.api.php
  * 服务端通过 session cookie 分配邮箱地址，token 存储 PHPSESSID
  */
-func TenMinuteMailNetGenerate(duration int, domain string) (*CreatedMailbox, error) {
-	client := HTTPClient()
+func TenMinuteMailNetGenerate(ctx context.Context, duration int, domain string) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", tenMinuteMailNetBaseURL+"/address.api.php", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tenMinuteMailNetBaseURL+"/address.api.php", nil)
 	if err != nil {
 		return nil, fmt.Errorf("10minutemail-net: 创建请求失败: %w", err)
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Accept", "application/json, text/plain, */*")
 
 	resp, err := client.Do(req)
@@ -122,7 +123,7 @@ func TenMinuteMailNetGenerate(duration int, domain string) (*CreatedMailbox, err
  * 1. GET /address.api.php（带 Cookie）获取 mail_list
  * 2. 逐封调用 GET /mail.api.php?mailid={id} 获取详情
  */
-func TenMinuteMailNetGetEmails(token, email string) ([]NormEmail, error) {
+func TenMinuteMailNetGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	if token == "" {
 		return nil, fmt.Errorf("10minutemail-net: token 为空")
 	}
@@ -135,14 +136,14 @@ func TenMinuteMailNetGetEmails(token, email string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("10minutemail-net: token 缺少 cookie 字段")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	/* 步骤 1：获取邮件列表 */
-	req, err := http.NewRequest("GET", tenMinuteMailNetBaseURL+"/address.api.php", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tenMinuteMailNetBaseURL+"/address.api.php", nil)
 	if err != nil {
 		return nil, fmt.Errorf("10minutemail-net: 创建列表请求失败: %w", err)
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Accept", "application/json, text/plain, */*")
 	req.Header.Set("Cookie", tkn.Cookie)
 
@@ -173,7 +174,7 @@ func TenMinuteMailNetGetEmails(token, email string) ([]NormEmail, error) {
 	/* 步骤 2：逐封获取邮件详情 */
 	emails := make([]NormEmail, 0, len(addrResp.MailList))
 	for _, item := range addrResp.MailList {
-		detail, err := tenMinuteMailNetFetchDetail(client, tkn.Cookie, item.MailID, email)
+		detail, err := tenMinuteMailNetFetchDetail(ctx, client, tkn.Cookie, item.MailID, email)
 		if err != nil {
 			continue
 		}
@@ -184,13 +185,13 @@ func TenMinuteMailNetGetEmails(token, email string) ([]NormEmail, error) {
 }
 
 /* tenMinuteMailNetFetchDetail 获取单封邮件详情并转为 NormEmail */
-func tenMinuteMailNetFetchDetail(client tls_client.HttpClient, cookie, mailID, recipientEmail string) (NormEmail, error) {
+func tenMinuteMailNetFetchDetail(ctx context.Context, client tls_client.HttpClient, cookie, mailID, recipientEmail string) (NormEmail, error) {
 	url := fmt.Sprintf("%s/mail.api.php?mailid=%s", tenMinuteMailNetBaseURL, mailID)
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return NormEmail{}, err
 	}
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Accept", "application/json, text/plain, */*")
 	req.Header.Set("Cookie", cookie)
 
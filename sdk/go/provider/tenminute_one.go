@@ -5,6 +5,7 @@
 package provider
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/hex"
@@ -250,7 +251,7 @@ func tenminuteJWTExpUnix(token string) int64 {
 	return int64(pl.Exp)
 }
 
-func tenminuteAPIHeaders(token string) http.Header {
+func tenminuteAPIHeaders(ctx context.Context, token string) http.Header {
 	rid, _ := tenminuteRandHex(16)
 	h := make(http.Header)
 	h.Set("Accept", "*/*")
@@ -262,7 +263,7 @@ func tenminuteAPIHeaders(token string) http.Header {
 	h.Set("Origin", tenminuteSiteOrigin)
 	h.Set("Pragma", "no-cache")
 	h.Set("Referer", tenminuteSiteOrigin+"/")
-	h.Set("User-Agent", GetCurrentUA())
+	h.Set("User-Agent", GetCurrentUA(ctx))
 	h.Set("X-Request-ID", rid)
 	h.Set("X-Timestamp", fmt.Sprintf("%d", time.Now().Unix()))
 	return h
@@ -287,14 +288,14 @@ func tenminuteItemNeedsDetail(m map[string]interface{}) bool {
 }
 
 // TenminuteOneGenerate 拉取 SSR 页面，解析 JWT 与域名列表，生成随机邮箱地址
-func TenminuteOneGenerate(domain *string) (*CreatedMailbox, error) {
+func TenminuteOneGenerate(ctx context.Context, domain *string) (*CreatedMailbox, error) {
 	loc := tenminutePickLocale(domain)
 	pageURL := fmt.Sprintf("%s/%s", tenminuteSiteOrigin, url.PathEscape(loc))
-	req, err := http.NewRequest("GET", pageURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(ctx))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "zh-CN,zh;q=0.9,en;q=0.8")
 	req.Header.Set("Cache-Control", "no-cache")
@@ -302,7 +303,7 @@ func TenminuteOneGenerate(domain *string) (*CreatedMailbox, error) {
 	req.Header.Set("DNT", "1")
 	req.Header.Set("Referer", pageURL)
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -372,18 +373,18 @@ func TenminuteOneGenerate(domain *string) (*CreatedMailbox, error) {
 }
 
 // TenminuteOneGetEmails GET /mailbox/{email}，必要时再拉取单封详情
-func TenminuteOneGetEmails(email, token string) ([]NormEmail, error) {
+func TenminuteOneGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	if email == "" || token == "" {
-		return nil, fmt.Errorf("10minute-one: email and token required")
+		return nil, markError(ErrInvalidToken, "10minute-one: email and token required")
 	}
 	u := fmt.Sprintf("%s/mailbox/%s", tenminuteAPIBase, tenminuteEncMailboxEmail(email))
-	req, err := http.NewRequest("GET", u, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header = tenminuteAPIHeaders(token)
+	req.Header = tenminuteAPIHeaders(ctx, token)
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -411,9 +412,9 @@ func TenminuteOneGetEmails(email, token string) ([]NormEmail, error) {
 		if tenminuteItemNeedsDetail(m) {
 			mid := url.PathEscape(fmt.Sprint(m["id"]))
 			du := fmt.Sprintf("%s/mailbox/%s/%s", tenminuteAPIBase, tenminuteEncMailboxEmail(email), mid)
-			req2, err := http.NewRequest("GET", du, nil)
+			req2, err := http.NewRequestWithContext(ctx, "GET", du, nil)
 			if err == nil {
-				req2.Header = tenminuteAPIHeaders(token)
+				req2.Header = tenminuteAPIHeaders(ctx, token)
 				if resp2, err := client.Do(req2); err == nil {
 					func() {
 						defer resp2.Body.Close()
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -39,14 +40,14 @@ var (
 
 /* tenminutemailNetBrowserHeaders 设置浏览器模拟请求头（用于首页 HTML） */
 func tenminutemailNetBrowserHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 }
 
 /* tenminutemailNetAjaxHeaders 设置 AJAX 请求头（用于 mailbox.ajax.php） */
 func tenminutemailNetAjaxHeaders(req *http.Request) {
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(req.Context()))
 	req.Header.Set("Accept", "*/*")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9,zh-CN;q=0.8,zh;q=0.7")
 	req.Header.Set("X-Requested-With", "XMLHttpRequest")
@@ -59,10 +60,10 @@ func tenminutemailNetAjaxHeaders(req *http.Request) {
  *   2. 从首页 HTML 的输入框正则提取随机分配的邮箱地址（value="xxx@xxx.com"）
  * token: 空字符串（session 由 cookie jar 维护，无需额外令牌）
  */
-func TenminutemailNetGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func TenminutemailNetGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
-	req, err := http.NewRequest("GET", tenminutemailNetBaseURL+"/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tenminutemailNetBaseURL+"/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("10minutemail-net: 创建首页请求失败: %w", err)
 	}
@@ -107,17 +108,17 @@ func TenminutemailNetGenerate() (*CreatedMailbox, error) {
  *   3. 对每封邮件 GET /readmail.html?mid={id} 获取整页并提取正文 HTML 片段
  * 表格列顺序: 寄件人 | 主题 | 收件日期；未读行的 <tr> 带 font-weight: bold 样式
  */
-func TenminutemailNetGetEmails(email, token string) ([]NormEmail, error) {
+func TenminutemailNetGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	email = strings.TrimSpace(email)
 	if email == "" {
 		return nil, fmt.Errorf("10minutemail-net: 邮箱地址为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 
 	/* GET /mailbox.ajax.php?_={毫秒时间戳} 获取邮件列表 */
 	listURL := fmt.Sprintf("%s/mailbox.ajax.php?_=%d", tenminutemailNetBaseURL, time.Now().UnixMilli())
-	req, err := http.NewRequest("GET", listURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", listURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("10minutemail-net: 创建邮件列表请求失败: %w", err)
 	}
@@ -188,7 +189,7 @@ func TenminutemailNetGetEmails(email, token string) ([]NormEmail, error) {
 		isRead := !strings.Contains(strings.ToLower(rowFull), "font-weight: bold")
 
 		/* 获取邮件正文 HTML */
-		htmlBody := tenminutemailNetFetchBody(client, mailID)
+		htmlBody := tenminutemailNetFetchBody(ctx, client, mailID)
 
 		flat := map[string]interface{}{
 			"id":      mailID,
@@ -209,7 +210,7 @@ func TenminutemailNetGetEmails(email, token string) ([]NormEmail, error) {
  * GET /readmail.html?mid={id} 返回整页 HTML，正文位于 <div class="mailinhtml">...</div>
  * 失败或未找到正文时返回空字符串（normalize 会从主题等字段兜底）
  */
-func tenminutemailNetFetchBody(client interface {
+func tenminutemailNetFetchBody(ctx context.Context, client interface {
 	Do(*http.Request) (*http.Response, error)
 }, mailID string) string {
 	if mailID == "" {
@@ -217,7 +218,7 @@ func tenminutemailNetFetchBody(client interface {
 	}
 
 	reqURL := fmt.Sprintf("%s/readmail.html?mid=%s", tenminutemailNetBaseURL, mailID)
-	req, err := http.NewRequest("GET", reqURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
 	if err != nil {
 		return ""
 	}
@@ -1,15 +1,17 @@
 package provider
 
+import "context"
+
 // test.unergie.com：mailinator 官方姊妹子域名，MX 指向 mail.mailinator.com。
 
-func TestUnergieComGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func TestUnergieComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "test-unergie-com",
 		Email:   local + "@test.unergie.com",
 	}, nil
 }
 
-func TestUnergieComGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func TestUnergieComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -40,8 +41,8 @@ type throwawaymailMessageDetail struct {
 	HTML         *string  `json:"html"`
 }
 
-func throwawaymailJSON(method, u string, body []byte, out any) error {
-	req, err := http.NewRequest(method, u, bytes.NewReader(body))
+func throwawaymailJSON(ctx context.Context, method, u string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
 	if err != nil {
 		return err
 	}
@@ -49,7 +50,7 @@ func throwawaymailJSON(method, u string, body []byte, out any) error {
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return err
 	}
@@ -59,7 +60,7 @@ func throwawaymailJSON(method, u string, body []byte, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("throwawaymail: http %d", resp.StatusCode)
+		return statusError(resp, "throwawaymail: http %d", resp.StatusCode)
 	}
 	if out == nil {
 		return nil
@@ -95,9 +96,9 @@ func throwawaymailRaw(summary throwawaymailMessageSummary, detail *throwawaymail
 	return raw
 }
 
-func ThrowawaymailGenerate() (*CreatedMailbox, error) {
+func ThrowawaymailGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	var data throwawaymailMailboxResponse
-	if err := throwawaymailJSON(http.MethodPost, throwawaymailBase+"/mailboxes", nil, &data); err != nil {
+	if err := throwawaymailJSON(ctx, http.MethodPost, throwawaymailBase+"/mailboxes", nil, &data); err != nil {
 		return nil, err
 	}
 	mailboxID := strings.TrimSpace(data.MailboxID)
@@ -114,7 +115,7 @@ func ThrowawaymailGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func throwawaymailFetchDetail(mailboxID, messageID string) (*throwawaymailMessageDetail, error) {
+func throwawaymailFetchDetail(ctx context.Context, mailboxID, messageID string) (*throwawaymailMessageDetail, error) {
 	u := fmt.Sprintf(
 		"%s/mailboxes/%s/messages/%s",
 		throwawaymailBase,
@@ -122,13 +123,13 @@ func throwawaymailFetchDetail(mailboxID, messageID string) (*throwawaymailMessag
 		url.PathEscape(messageID),
 	)
 	var detail throwawaymailMessageDetail
-	if err := throwawaymailJSON(http.MethodGet, u, nil, &detail); err != nil {
+	if err := throwawaymailJSON(ctx, http.MethodGet, u, nil, &detail); err != nil {
 		return nil, err
 	}
 	return &detail, nil
 }
 
-func ThrowawaymailGetEmails(mailboxID, email string) ([]NormEmail, error) {
+func ThrowawaymailGetEmails(ctx context.Context, mailboxID, email string) ([]NormEmail, error) {
 	mailboxID = strings.TrimSpace(mailboxID)
 	email = strings.TrimSpace(email)
 	if mailboxID == "" {
@@ -140,14 +141,14 @@ func ThrowawaymailGetEmails(mailboxID, email string) ([]NormEmail, error) {
 
 	u := fmt.Sprintf("%s/mailboxes/%s/messages", throwawaymailBase, url.PathEscape(mailboxID))
 	var rows []throwawaymailMessageSummary
-	if err := throwawaymailJSON(http.MethodGet, u, nil, &rows); err != nil {
+	if err := throwawaymailJSON(ctx, http.MethodGet, u, nil, &rows); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(rows))
 	for _, row := range rows {
 		var detail *throwawaymailMessageDetail
 		if strings.TrimSpace(row.MessageID) != "" {
-			d, err := throwawaymailFetchDetail(mailboxID, row.MessageID)
+			d, err := throwawaymailFetchDetail(ctx, mailboxID, row.MessageID)
 			if err == nil {
 				detail = d
 			}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -28,16 +29,16 @@ var tmailLinkEmailRegex = regexp.MustCompile(`([a-zA-Z0-9._%+-]+@tmail\.link)`)
 /**
  * TmailLinkGenerate — 创建 tmail.link 临时邮箱
  */
-func TmailLinkGenerate() (*CreatedMailbox, error) {
-	client := HTTPClient()
+func TmailLinkGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	client := HTTPClient(ctx)
 
 	/* 步骤1: GET / 获取随机邮箱地址 */
-	req, err := http.NewRequest("GET", tmailLinkBase+"/", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", tmailLinkBase+"/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("tmail-link: 创建请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "text/html")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -51,7 +52,7 @@ func TmailLinkGenerate() (*CreatedMailbox, error) {
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("tmail-link: HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "tmail-link: HTTP %d", resp.StatusCode)
 	}
 
 	matches := tmailLinkEmailRegex.FindSubmatch(body)
@@ -62,12 +63,12 @@ func TmailLinkGenerate() (*CreatedMailbox, error) {
 
 	/* 步骤2: GET /inbox/{email}/ 获取 csrftoken cookie */
 	inboxURL := fmt.Sprintf("%s/inbox/%s/", tmailLinkBase, url.PathEscape(email))
-	req2, err := http.NewRequest("GET", inboxURL, nil)
+	req2, err := http.NewRequestWithContext(ctx, "GET", inboxURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("tmail-link: 创建 inbox 请求失败: %w", err)
 	}
 	req2.Header.Set("Accept", "text/html")
-	req2.Header.Set("User-Agent", getCurrentUA())
+	req2.Header.Set("User-Agent", getCurrentUA(ctx))
 
 	resp2, err := client.Do(req2)
 	if err != nil {
@@ -97,7 +98,7 @@ func TmailLinkGenerate() (*CreatedMailbox, error) {
 /**
  * TmailLinkGetEmails — 获取 tmail.link 邮件列表
  */
-func TmailLinkGetEmails(token, email string) ([]NormEmail, error) {
+func TmailLinkGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	if token == "" {
 		return nil, fmt.Errorf("tmail-link: token 为空")
 	}
@@ -105,16 +106,16 @@ func TmailLinkGetEmails(token, email string) ([]NormEmail, error) {
 		return nil, fmt.Errorf("tmail-link: 邮箱地址为空")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	inboxURL := fmt.Sprintf("%s/inbox/%s/", tmailLinkBase, url.PathEscape(email))
 
 	/* 步骤1: GET inbox 页面获取最新 csrftoken */
-	req, err := http.NewRequest("GET", inboxURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", inboxURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("tmail-link: 创建 GET inbox 请求失败: %w", err)
 	}
 	req.Header.Set("Accept", "text/html")
-	req.Header.Set("User-Agent", getCurrentUA())
+	req.Header.Set("User-Agent", getCurrentUA(ctx))
 	req.Header.Set("Cookie", "csrftoken="+token)
 
 	resp, err := client.Do(req)
@@ -137,13 +138,13 @@ func TmailLinkGetEmails(token, email string) ([]NormEmail, error) {
 	formData.Set("format", "json")
 	formData.Set("csrfmiddlewaretoken", freshToken)
 
-	req2, err := http.NewRequest("POST", inboxURL, strings.NewReader(formData.Encode()))
+	req2, err := http.NewRequestWithContext(ctx, "POST", inboxURL, strings.NewReader(formData.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("tmail-link: 创建 POST inbox 请求失败: %w", err)
 	}
 	req2.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req2.Header.Set("Accept", "application/json")
-	req2.Header.Set("User-Agent", getCurrentUA())
+	req2.Header.Set("User-Agent", getCurrentUA(ctx))
 	req2.Header.Set("Cookie", "csrftoken="+freshToken)
 	req2.Header.Set("X-CSRFToken", freshToken)
 	req2.Header.Set("Referer", inboxURL)
@@ -155,7 +156,7 @@ func TmailLinkGetEmails(token, email string) ([]NormEmail, error) {
 	defer resp2.Body.Close()
 
 	if resp2.StatusCode < 200 || resp2.StatusCode >= 300 {
-		return nil, fmt.Errorf("tmail-link: 邮件请求 HTTP %d", resp2.StatusCode)
+		return nil, statusError(resp2, "tmail-link: 邮件请求 HTTP %d", resp2.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp2.Body)
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -36,18 +37,18 @@ func chacuoSetHeaders(req *http.Request) {
 }
 
 // TwentyfourmailChacuoGenerate 创建 24mail-chacuo 临时邮箱
-func TwentyfourmailChacuoGenerate() (*CreatedMailbox, error) {
+func TwentyfourmailChacuoGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	name := chacuoRandomLocal(10)
 	domain := chacuoDomains[rand.Intn(len(chacuoDomains))]
 
 	body := fmt.Sprintf("data=%s%%40%s&type=refresh&arg=", name, domain)
-	req, err := http.NewRequest("POST", chacuoBaseURL+"/", strings.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", chacuoBaseURL+"/", strings.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 	chacuoSetHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +81,7 @@ func TwentyfourmailChacuoGenerate() (*CreatedMailbox, error) {
 }
 
 // TwentyfourmailChacuoGetEmails 获取 24mail-chacuo 邮件列表
-func TwentyfourmailChacuoGetEmails(email string) ([]NormEmail, error) {
+func TwentyfourmailChacuoGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
 	atIdx := strings.Index(email, "@")
 	name := email
 	domain := chacuoDomains[0]
@@ -90,13 +91,13 @@ func TwentyfourmailChacuoGetEmails(email string) ([]NormEmail, error) {
 	}
 
 	body := fmt.Sprintf("data=%s%%40%s&type=refresh&arg=", name, domain)
-	req, err := http.NewRequest("POST", chacuoBaseURL+"/", strings.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", chacuoBaseURL+"/", strings.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 	chacuoSetHeaders(req)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -31,12 +31,12 @@ var uncorreotemporalHTTPClient = &stdhttp.Client{
 	},
 }
 
-func uncorreotemporalJSON(method, u, token string, out any) error {
+func uncorreotemporalJSON(ctx context.Context, method, u, token string, out any) error {
 	var body io.Reader
 	if method == stdhttp.MethodPost {
 		body = strings.NewReader("")
 	}
-	req, err := stdhttp.NewRequest(method, u, body)
+	req, err := stdhttp.NewRequestWithContext(ctx, method, u, body)
 	if err != nil {
 		return err
 	}
@@ -60,7 +60,7 @@ func uncorreotemporalJSON(method, u, token string, out any) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("uncorreotemporal http %d", resp.StatusCode)
+		return stdStatusError(resp, "uncorreotemporal http %d", resp.StatusCode)
 	}
 	return json.Unmarshal(data, out)
 }
@@ -86,9 +86,9 @@ func uncorreotemporalFirstNonEmpty(value any, fallback string) any {
 	return fallback
 }
 
-func UncorreotemporalGenerate() (*CreatedMailbox, error) {
+func UncorreotemporalGenerate(ctx context.Context) (*CreatedMailbox, error) {
 	var data uncorreotemporalCreateResponse
-	if err := uncorreotemporalJSON(stdhttp.MethodPost, uncorreotemporalBase+"/mailboxes", "", &data); err != nil {
+	if err := uncorreotemporalJSON(ctx, stdhttp.MethodPost, uncorreotemporalBase+"/mailboxes", "", &data); err != nil {
 		return nil, err
 	}
 	email := strings.TrimSpace(data.Address)
@@ -104,34 +104,34 @@ func UncorreotemporalGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func uncorreotemporalFetchDetail(email, token, messageID string) (map[string]any, error) {
+func uncorreotemporalFetchDetail(ctx context.Context, email, token, messageID string) (map[string]any, error) {
 	var raw map[string]any
 	u := fmt.Sprintf("%s/mailboxes/%s/messages/%s", uncorreotemporalBase, url.PathEscape(email), url.PathEscape(messageID))
-	if err := uncorreotemporalJSON(stdhttp.MethodGet, u, token, &raw); err != nil {
+	if err := uncorreotemporalJSON(ctx, stdhttp.MethodGet, u, token, &raw); err != nil {
 		return nil, err
 	}
 	return raw, nil
 }
 
-func UncorreotemporalGetEmails(token, email string) ([]NormEmail, error) {
+func UncorreotemporalGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	sessionToken := strings.TrimSpace(token)
 	address := strings.TrimSpace(email)
 	if sessionToken == "" {
-		return nil, fmt.Errorf("uncorreotemporal: empty session token")
+		return nil, markError(ErrInvalidToken, "uncorreotemporal: empty session token")
 	}
 	if address == "" {
 		return nil, fmt.Errorf("uncorreotemporal: empty email")
 	}
 	u := fmt.Sprintf("%s/mailboxes/%s/messages?limit=50", uncorreotemporalBase, url.PathEscape(address))
 	var rows []map[string]any
-	if err := uncorreotemporalJSON(stdhttp.MethodGet, u, sessionToken, &rows); err != nil {
+	if err := uncorreotemporalJSON(ctx, stdhttp.MethodGet, u, sessionToken, &rows); err != nil {
 		return nil, err
 	}
 	out := make([]NormEmail, 0, len(rows))
 	for _, row := range rows {
 		raw := row
 		if id := strings.TrimSpace(fmt.Sprint(row["id"])); id != "" {
-			if detail, err := uncorreotemporalFetchDetail(address, sessionToken, id); err == nil {
+			if detail, err := uncorreotemporalFetchDetail(ctx, address, sessionToken, id); err == nil {
 				raw = detail
 			}
 		}
@@ -1,10 +1,12 @@
 package provider
 
+import "context"
+
 // veryrealemail.com：mailinator 官方姊妹域名，MX 指向 mail.mailinator.com。
 
 // VeryrealemailComGenerate 创建 veryrealemail.com 临时邮箱
-func VeryrealemailComGenerate() (*CreatedMailbox, error) {
-	local := randomStr(12)
+func VeryrealemailComGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(12))
 	return &CreatedMailbox{
 		Channel: "veryrealemail-com",
 		Email:   local + "@veryrealemail.com",
@@ -12,6 +14,6 @@ func VeryrealemailComGenerate() (*CreatedMailbox, error) {
 }
 
 // VeryrealemailComGetEmails 读取 veryrealemail.com 邮件（复用 mailinator public API）
-func VeryrealemailComGetEmails(email string) ([]NormEmail, error) {
-	return MailinatorGetEmails(email)
+func VeryrealemailComGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailinatorGetEmails(ctx, email)
 }
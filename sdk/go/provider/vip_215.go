@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	ht "html"
@@ -25,10 +26,11 @@ var vip215RegMu sync.Mutex
 var vip215Boxes = make(map[string]*vip215Box)
 
 type vip215Box struct {
-	mu      sync.Mutex
-	emails  []NormEmail
-	seenIDs map[string]struct{}
-	started bool
+	mu       sync.Mutex
+	emails   []NormEmail
+	seenIDs  map[string]struct{}
+	started  bool
+	notifier mailNotifier
 }
 
 func getVip215Box(token string) *vip215Box {
@@ -178,8 +180,8 @@ func vip215SetAPIHeaders(h fhttp.Header) {
 	h.Set("X-Locale", "zh")
 }
 
-func vip215EstablishSession() (string, error) {
-	req, err := fhttp.NewRequest("GET", vip215HTTPBase+"/", nil)
+func vip215EstablishSession(ctx context.Context) (string, error) {
+	req, err := fhttp.NewRequestWithContext(ctx, "GET", vip215HTTPBase+"/", nil)
 	if err != nil {
 		return "", err
 	}
@@ -199,14 +201,14 @@ func vip215EstablishSession() (string, error) {
 	h.Set("Sec-Fetch-User", "?1")
 	h.Set("Upgrade-Insecure-Requests", "1")
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return "", err
 	}
 	defer resp.Body.Close()
 	_, _ = io.Copy(io.Discard, resp.Body)
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return "", fmt.Errorf("vip-215 homepage failed: %d", resp.StatusCode)
+		return "", statusError(resp, "vip-215 homepage failed: %d", resp.StatusCode)
 	}
 	cookie := vip215JoinCookies(resp.Cookies())
 	if !strings.Contains(cookie, "yyds_homepage_bridge=") || !strings.Contains(cookie, "yyds_homepage_device=") {
@@ -215,15 +217,15 @@ func vip215EstablishSession() (string, error) {
 	return cookie, nil
 }
 
-func vip215FetchWsTicket(jwt string) (string, error) {
-	req, err := fhttp.NewRequest("GET", vip215HTTPBase+"/v1/auth/ws-ticket", nil)
+func vip215FetchWsTicket(ctx context.Context, jwt string) (string, error) {
+	req, err := fhttp.NewRequestWithContext(ctx, "GET", vip215HTTPBase+"/v1/auth/ws-ticket", nil)
 	if err != nil {
 		return "", err
 	}
 	vip215SetAPIHeaders(req.Header)
 	req.Header.Set("Authorization", "Bearer "+jwt)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return "", err
 	}
@@ -233,7 +235,7 @@ func vip215FetchWsTicket(jwt string) (string, error) {
 		return "", err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return "", fmt.Errorf("vip-215 ws-ticket failed: %d %s", resp.StatusCode, string(body))
+		return "", statusError(resp, "vip-215 ws-ticket failed: %d %s", resp.StatusCode, string(body))
 	}
 	var parsed vip215WsTicketResp
 	if err := json.Unmarshal(body, &parsed); err != nil {
@@ -245,20 +247,20 @@ func vip215FetchWsTicket(jwt string) (string, error) {
 	return parsed.Data.Ticket, nil
 }
 
-func MailVip215Generate() (*CreatedMailbox, error) {
-	cookie, err := vip215EstablishSession()
+func MailVip215Generate(ctx context.Context) (*CreatedMailbox, error) {
+	cookie, err := vip215EstablishSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := fhttp.NewRequest("POST", vip215HTTPBase+"/api/temp-inbox", nil)
+	req, err := fhttp.NewRequestWithContext(ctx, "POST", vip215HTTPBase+"/api/temp-inbox", nil)
 	if err != nil {
 		return nil, err
 	}
 	vip215SetAPIHeaders(req.Header)
 	req.Header.Set("Cookie", cookie)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -269,7 +271,7 @@ func MailVip215Generate() (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("vip-215 create inbox failed: %d %s", resp.StatusCode, string(body))
+		return nil, statusError(resp, "vip-215 create inbox failed: %d %s", resp.StatusCode, string(body))
 	}
 
 	var parsed vip215CreateResp
@@ -283,12 +285,19 @@ func MailVip215Generate() (*CreatedMailbox, error) {
 	info := &CreatedMailbox{Channel: "vip-215", Email: parsed.Data.Address, Token: parsed.Data.Token}
 	info.CreatedAt = parsed.Data.CreatedAt
 	info.ExpiresAt = parsed.Data.ExpiresAt
-	vip215EnsureReader(info.Token, info.Email)
+	vip215EnsureReader(ctx, info.Token, info.Email)
 	return info, nil
 }
 
-func vip215WsLoop(jwt, recipient string, box *vip215Box) {
-	wsTicket, err := vip215FetchWsTicket(jwt)
+func vip215WsLoop(ctx context.Context, jwt, recipient string, box *vip215Box) {
+	/* 读循环退出后允许下一次 GetEmails / Subscribe 重新建立连接 */
+	defer func() {
+		box.mu.Lock()
+		box.started = false
+		box.mu.Unlock()
+	}()
+
+	wsTicket, err := vip215FetchWsTicket(ctx, jwt)
 	if err != nil {
 		return
 	}
@@ -305,7 +314,7 @@ func vip215WsLoop(jwt, recipient string, box *vip215Box) {
 	d := websocket.Dialer{
 		HandshakeTimeout: 15 * time.Second,
 	}
-	conn, _, err := d.Dial(u.String(), hdr)
+	conn, _, err := d.DialContext(ctx, u.String(), hdr)
 	if err != nil {
 		return
 	}
@@ -352,10 +361,11 @@ func vip215WsLoop(jwt, recipient string, box *vip215Box) {
 		box.seenIDs[em.ID] = struct{}{}
 		box.emails = append(box.emails, em)
 		box.mu.Unlock()
+		box.notifier.notify()
 	}
 }
 
-func vip215EnsureReader(token, email string) *vip215Box {
+func vip215EnsureReader(ctx context.Context, token, email string) *vip215Box {
 	box := getVip215Box(token)
 	box.mu.Lock()
 	needStart := !box.started
@@ -365,8 +375,10 @@ func vip215EnsureReader(token, email string) *vip215Box {
 	box.mu.Unlock()
 
 	if needStart {
-		go vip215WsLoop(token, email, box)
-		time.Sleep(80 * time.Millisecond)
+		/* WebSocket 读循环常驻后台，不随单次调用的 ctx 取消而退出 */
+		go vip215WsLoop(context.WithoutCancel(ctx), token, email, box)
+		/* 留出建立连接的时间；ctx 取消时不再等待，后续请求随之以 ctx 错误返回 */
+		_ = sleepCtx(ctx, 80*time.Millisecond)
 	}
 	return box
 }
@@ -377,15 +389,15 @@ func vip215EnsureReader(token, email string) *vip215Box {
  * 返回完整邮件列表（含 text/html 字段），供 HTTP 拉取模式使用
  * 相比 WebSocket 推送的元数据 + 合成卡片，HTTP 接口能拿到完整正文
  */
-func vip215FetchMessagesViaHTTP(jwt string) ([]map[string]interface{}, error) {
-	req, err := fhttp.NewRequest("GET", vip215HTTPBase+"/v1/messages", nil)
+func vip215FetchMessagesViaHTTP(ctx context.Context, jwt string) ([]map[string]interface{}, error) {
+	req, err := fhttp.NewRequestWithContext(ctx, "GET", vip215HTTPBase+"/v1/messages", nil)
 	if err != nil {
 		return nil, err
 	}
 	vip215SetAPIHeaders(req.Header)
 	req.Header.Set("Authorization", "Bearer "+jwt)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -396,7 +408,7 @@ func vip215FetchMessagesViaHTTP(jwt string) ([]map[string]interface{}, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("vip-215 messages: %d %s", resp.StatusCode, string(body))
+		return nil, statusError(resp, "vip-215 messages: %d %s", resp.StatusCode, string(body))
 	}
 
 	var parsed struct {
@@ -420,19 +432,19 @@ func vip215FetchMessagesViaHTTP(jwt string) ([]map[string]interface{}, error) {
  * GET https://vip.215.im/v1/messages/{id}
  * 用于列表接口只返回元数据时补拉正文
  */
-func vip215FetchMessageDetail(jwt, id string) map[string]interface{} {
+func vip215FetchMessageDetail(ctx context.Context, jwt, id string) map[string]interface{} {
 	id = strings.TrimSpace(id)
 	if id == "" {
 		return nil
 	}
-	req, err := fhttp.NewRequest("GET", vip215HTTPBase+"/v1/messages/"+url.PathEscape(id), nil)
+	req, err := fhttp.NewRequestWithContext(ctx, "GET", vip215HTTPBase+"/v1/messages/"+url.PathEscape(id), nil)
 	if err != nil {
 		return nil
 	}
 	vip215SetAPIHeaders(req.Header)
 	req.Header.Set("Authorization", "Bearer "+jwt)
 
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil
 	}
@@ -497,18 +509,18 @@ func vip215ExtractMessageID(row map[string]interface{}) string {
  *   3. HTTP 失败时回退到 WebSocket 已缓存的合成卡片
  * WebSocket 通道仍保留用于新邮件通知（去重合并）
  */
-func MailVip215GetEmails(token, email string) ([]NormEmail, error) {
-	box := vip215EnsureReader(token, email)
+func MailVip215GetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
+	box := vip215EnsureReader(ctx, token, email)
 
 	/* 优先通过 HTTP 拉取完整邮件 */
-	messages, err := vip215FetchMessagesViaHTTP(token)
+	messages, err := vip215FetchMessagesViaHTTP(ctx, token)
 	if err == nil {
 		out := make([]NormEmail, 0, len(messages))
 		for _, row := range messages {
 			id := vip215ExtractMessageID(row)
 			/* 若列表条目无真实正文，补拉详情 */
 			if !vip215HasRealBody(row) && id != "" {
-				if detail := vip215FetchMessageDetail(token, id); detail != nil {
+				if detail := vip215FetchMessageDetail(ctx, token, id); detail != nil {
 					for k, v := range detail {
 						if v != nil {
 							row[k] = v
@@ -532,3 +544,17 @@ func MailVip215GetEmails(token, email string) ([]NormEmail, error) {
 	copy(fallback, box.emails)
 	return fallback, nil
 }
+
+/*
+ * MailVip215Subscribe 订阅 vip-215 WebSocket 新邮件推送
+ * 返回的通道在 message.new 到达时收到信号（推送仅含元数据，完整正文仍经 MailVip215GetEmails 拉取）；
+ * 调用返回的取消函数结束订阅
+ */
+func MailVip215Subscribe(ctx context.Context, token, email string) (<-chan struct{}, func(), error) {
+	if token == "" {
+		return nil, nil, markError(ErrInvalidToken, "vip-215: token is required")
+	}
+	box := vip215EnsureReader(ctx, token, email)
+	ch, cancel := box.notifier.subscribe()
+	return ch, cancel, nil
+}
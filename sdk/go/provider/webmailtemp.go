@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -37,7 +38,7 @@ func webmailtempEncodeToken(data webmailtempToken) (string, error) {
 
 func webmailtempDecodeToken(token string) (webmailtempToken, error) {
 	if !strings.HasPrefix(token, "wmt1:") {
-		return webmailtempToken{}, fmt.Errorf("webmailtemp: invalid token")
+		return webmailtempToken{}, markError(ErrInvalidToken, "webmailtemp: invalid token")
 	}
 	raw, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(token, "wmt1:"))
 	if err != nil {
@@ -48,7 +49,7 @@ func webmailtempDecodeToken(token string) (webmailtempToken, error) {
 		return webmailtempToken{}, err
 	}
 	if data.Username == "" || data.Cookie == "" {
-		return webmailtempToken{}, fmt.Errorf("webmailtemp: invalid token data")
+		return webmailtempToken{}, markError(ErrInvalidToken, "webmailtemp: invalid token data")
 	}
 	return data, nil
 }
@@ -62,14 +63,14 @@ func webmailtempSessionCookie(resp *http.Response) string {
 	return ""
 }
 
-func WebmailtempGenerate() (*CreatedMailbox, error) {
-	req, err := http.NewRequest(http.MethodGet, webmailtempBase+"/api/create", nil)
+func WebmailtempGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, webmailtempBase+"/api/create", nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", "Mozilla/5.0")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +81,7 @@ func WebmailtempGenerate() (*CreatedMailbox, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("webmailtemp create: http %d", resp.StatusCode)
+		return nil, statusError(resp, "webmailtemp create: http %d", resp.StatusCode)
 	}
 	var data webmailtempCreateResponse
 	if err := json.Unmarshal(body, &data); err != nil {
@@ -118,7 +119,7 @@ func webmailtempFlatten(raw map[string]any, recipient string) map[string]any {
 	}
 }
 
-func WebmailtempGetEmails(token string, email string) ([]NormEmail, error) {
+func WebmailtempGetEmails(ctx context.Context, token string, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("webmailtemp: empty email")
@@ -127,14 +128,14 @@ func WebmailtempGetEmails(token string, email string) ([]NormEmail, error) {
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/check/%s", webmailtempBase, url.PathEscape(session.Username)), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/check/%s", webmailtempBase, url.PathEscape(session.Username)), nil)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Cookie", session.Cookie)
 	req.Header.Set("User-Agent", "Mozilla/5.0")
-	resp, err := HTTPClient().Do(req)
+	resp, err := HTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +145,7 @@ func WebmailtempGetEmails(token string, email string) ([]NormEmail, error) {
 		return nil, err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("webmailtemp check: http %d", resp.StatusCode)
+		return nil, statusError(resp, "webmailtemp check: http %d", resp.StatusCode)
 	}
 	var data struct {
 		Emails []map[string]any `json:"emails"`
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -20,13 +21,13 @@ type xkxMeSession struct {
 	cookies string
 }
 
-func xkxMeGetSession() (*xkxMeSession, error) {
-	client := HTTPClient()
-	req, err := http.NewRequest(http.MethodGet, xkxMeBase, nil)
+func xkxMeGetSession(ctx context.Context) (*xkxMeSession, error) {
+	client := HTTPClient(ctx)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, xkxMeBase, nil)
 	if err != nil {
 		return nil, err
 	}
-	ua := getCurrentUA()
+	ua := getCurrentUA(ctx)
 	if ua != "" {
 		req.Header.Set("User-Agent", ua)
 	}
@@ -61,20 +62,20 @@ func xkxMeGetSession() (*xkxMeSession, error) {
 
 var xkxMeEmailRe = regexp.MustCompile(`mailbox/([^\s/"'<>]+@xkx\.me)`)
 
-func XkxMeGenerate() (*CreatedMailbox, error) {
-	session, err := xkxMeGetSession()
+func XkxMeGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	session, err := xkxMeGetSession(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	formData := "_token=" + url.QueryEscape(session.csrf)
-	req, err := http.NewRequest(http.MethodPost, xkxMeBase+"/mailbox/create/random", strings.NewReader(formData))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, xkxMeBase+"/mailbox/create/random", strings.NewReader(formData))
 	if err != nil {
 		return nil, err
 	}
 
-	ua := getCurrentUA()
+	ua := getCurrentUA(ctx)
 	if ua != "" {
 		req.Header.Set("User-Agent", ua)
 	}
@@ -105,20 +106,20 @@ func XkxMeGenerate() (*CreatedMailbox, error) {
 	}, nil
 }
 
-func XkxMeGetEmails(token, email string) ([]NormEmail, error) {
+func XkxMeGetEmails(ctx context.Context, token, email string) ([]NormEmail, error) {
 	address := strings.TrimSpace(email)
 	if address == "" {
 		return nil, fmt.Errorf("xkx-me: 缺少邮箱地址")
 	}
 
-	client := HTTPClient()
+	client := HTTPClient(ctx)
 	u := fmt.Sprintf("%s/mailbox/%s/messages", xkxMeBase, url.PathEscape(address))
-	req, err := http.NewRequest(http.MethodGet, u, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	ua := getCurrentUA()
+	ua := getCurrentUA(ctx)
 	if ua != "" {
 		req.Header.Set("User-Agent", ua)
 	}
@@ -138,7 +139,7 @@ func XkxMeGetEmails(token, email string) ([]NormEmail, error) {
 		return []NormEmail{}, nil
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("xkx-me: 获取邮件失败 HTTP %d", resp.StatusCode)
+		return nil, statusError(resp, "xkx-me: 获取邮件失败 HTTP %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -1,8 +1,12 @@
 package provider
 
-func Xue32BuzzGenerate() (*CreatedMailbox, error) {
-	local := randomStr(10)
+import "context"
+
+func Xue32BuzzGenerate(ctx context.Context) (*CreatedMailbox, error) {
+	local := localPartOr(ctx, randomStr(10))
 	email := local + "@xue32.buzz"
 	return &CreatedMailbox{Channel: "xue32-buzz", Email: email, Token: email}, nil
 }
-func Xue32BuzzGetEmails(email string) ([]NormEmail, error) { return MailmomyGetEmails(email) }
+func Xue32BuzzGetEmails(ctx context.Context, email string) ([]NormEmail, error) {
+	return MailmomyGetEmails(ctx, email)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	crand "crypto/rand"
 	"encoding/base64"
 	"encoding/json"
@@ -28,18 +29,18 @@ type zhujumpSession struct {
 	BaseURL string `json:"b,omitempty"`
 }
 
-func zhujumpHTTPClient() tls_client.HttpClient {
+func zhujumpHTTPClient(ctx context.Context) tls_client.HttpClient {
 	if HTTPClientNoCookieJar != nil {
-		return HTTPClientNoCookieJar()
+		return HTTPClientNoCookieJar(ctx)
 	}
-	return HTTPClient()
+	return HTTPClient(ctx)
 }
 
 func zhujumpJSONHeaders(req *http.Request, baseURL string, cookie string) {
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Origin", baseURL)
 	req.Header.Set("Referer", strings.TrimRight(baseURL, "/")+"/zh-CN/login")
-	req.Header.Set("User-Agent", GetCurrentUA())
+	req.Header.Set("User-Agent", GetCurrentUA(req.Context()))
 	if cookie != "" {
 		req.Header.Set("Cookie", cookie)
 	}
@@ -115,18 +116,18 @@ func zhujumpEncodeSession(s *zhujumpSession) (string, error) {
 
 func zhujumpDecodeSession(token string) (*zhujumpSession, error) {
 	if !strings.HasPrefix(token, zhujumpTokenPrefix) {
-		return nil, fmt.Errorf("zhujump: invalid session token")
+		return nil, markError(ErrInvalidToken, "zhujump: invalid session token")
 	}
 	raw, err := base64.StdEncoding.DecodeString(token[len(zhujumpTokenPrefix):])
 	if err != nil {
-		return nil, fmt.Errorf("zhujump: invalid session token")
+		return nil, markError(ErrInvalidToken, "zhujump: invalid session token")
 	}
 	var s zhujumpSession
 	if err := json.Unmarshal(raw, &s); err != nil {
-		return nil, fmt.Errorf("zhujump: invalid session token")
+		return nil, markError(ErrInvalidToken, "zhujump: invalid session token")
 	}
 	if strings.TrimSpace(s.Cookie) == "" || strings.TrimSpace(s.EmailID) == "" {
-		return nil, fmt.Errorf("zhujump: invalid session token")
+		return nil, markError(ErrInvalidToken, "zhujump: invalid session token")
 	}
 	if strings.TrimSpace(s.BaseURL) == "" {
 		s.BaseURL = zhujumpBase
@@ -135,8 +136,8 @@ func zhujumpDecodeSession(token string) (*zhujumpSession, error) {
 	return &s, nil
 }
 
-func zhujumpLoginAndCreate(baseURL string, domain string, expiryTime *int) (*CreatedMailbox, error) {
-	client := zhujumpHTTPClient()
+func zhujumpLoginAndCreate(ctx context.Context, baseURL string, domain string, expiryTime *int) (*CreatedMailbox, error) {
+	client := zhujumpHTTPClient(ctx)
 	baseURL = strings.TrimRight(baseURL, "/")
 	loginReferer := baseURL + "/zh-CN/login"
 
@@ -156,7 +157,7 @@ func zhujumpLoginAndCreate(baseURL string, domain string, expiryTime *int) (*Cre
 		"password":       password,
 		"turnstileToken": "",
 	})
-	req1, err := http.NewRequest("POST", baseURL+"/api/auth/register", strings.NewReader(string(registerBody)))
+	req1, err := http.NewRequestWithContext(ctx, "POST", baseURL+"/api/auth/register", strings.NewReader(string(registerBody)))
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +174,7 @@ func zhujumpLoginAndCreate(baseURL string, domain string, expiryTime *int) (*Cre
 	_, _ = io.Copy(io.Discard, resp1.Body)
 	cookieHdr = zhujumpMergeCookies(cookieHdr, resp1.Cookies())
 
-	req2, err := http.NewRequest("GET", baseURL+"/api/auth/csrf", nil)
+	req2, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/api/auth/csrf", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +209,7 @@ func zhujumpLoginAndCreate(baseURL string, domain string, expiryTime *int) (*Cre
 	form.Set("redirect", "false")
 	form.Set("csrfToken", csrfResp.CSRFToken)
 	form.Set("callbackUrl", loginReferer)
-	req3, err := http.NewRequest("POST", baseURL+"/api/auth/callback/credentials?", strings.NewReader(form.Encode()))
+	req3, err := http.NewRequestWithContext(ctx, "POST", baseURL+"/api/auth/callback/credentials?", strings.NewReader(form.Encode()))
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +227,7 @@ func zhujumpLoginAndCreate(baseURL string, domain string, expiryTime *int) (*Cre
 	_, _ = io.Copy(io.Discard, resp3.Body)
 	cookieHdr = zhujumpMergeCookies(cookieHdr, resp3.Cookies())
 
-	req4, err := http.NewRequest("GET", baseURL+"/api/auth/session", nil)
+	req4, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/api/auth/session", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +268,7 @@ func zhujumpLoginAndCreate(baseURL string, domain string, expiryTime *int) (*Cre
 		generateReq["expiryTime"] = *expiryTime
 	}
 	generateBody, _ := json.Marshal(generateReq)
-	req5, err := http.NewRequest("POST", baseURL+"/api/emails/generate", strings.NewReader(string(generateBody)))
+	req5, err := http.NewRequestWithContext(ctx, "POST", baseURL+"/api/emails/generate", strings.NewReader(string(generateBody)))
 	if err != nil {
 		return nil, err
 	}
@@ -306,8 +307,8 @@ func zhujumpLoginAndCreate(baseURL string, domain string, expiryTime *int) (*Cre
 	}, nil
 }
 
-func ZhujumpGenerate(domain string, channel string) (*CreatedMailbox, error) {
-	mailbox, err := zhujumpLoginAndCreate(zhujumpBase, domain, nil)
+func ZhujumpGenerate(ctx context.Context, domain string, channel string) (*CreatedMailbox, error) {
+	mailbox, err := zhujumpLoginAndCreate(ctx, zhujumpBase, domain, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -315,8 +316,8 @@ func ZhujumpGenerate(domain string, channel string) (*CreatedMailbox, error) {
 	return mailbox, nil
 }
 
-func MoemailGenerate(baseURL string, domain string, channel string, expiryTime int) (*CreatedMailbox, error) {
-	mailbox, err := zhujumpLoginAndCreate(baseURL, domain, &expiryTime)
+func MoemailGenerate(ctx context.Context, baseURL string, domain string, channel string, expiryTime int) (*CreatedMailbox, error) {
+	mailbox, err := zhujumpLoginAndCreate(ctx, baseURL, domain, &expiryTime)
 	if err != nil {
 		return nil, err
 	}
@@ -333,13 +334,13 @@ func zhujumpMessageHasBody(msg map[string]interface{}) bool {
 	return content != "" || html != ""
 }
 
-func zhujumpFetchMessageDetail(baseURL string, cookie string, emailID string, messageID string) (map[string]interface{}, error) {
-	req, err := http.NewRequest("GET", baseURL+"/api/emails/"+url.PathEscape(emailID)+"/"+url.PathEscape(messageID), nil)
+func zhujumpFetchMessageDetail(ctx context.Context, baseURL string, cookie string, emailID string, messageID string) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/api/emails/"+url.PathEscape(emailID)+"/"+url.PathEscape(messageID), nil)
 	if err != nil {
 		return nil, err
 	}
 	zhujumpJSONHeaders(req, baseURL, cookie)
-	resp, err := zhujumpHTTPClient().Do(req)
+	resp, err := zhujumpHTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -360,18 +361,18 @@ func zhujumpFetchMessageDetail(baseURL string, cookie string, emailID string, me
 	return detailResp.Message, nil
 }
 
-func ZhujumpGetEmails(email, token string) ([]NormEmail, error) {
+func ZhujumpGetEmails(ctx context.Context, email, token string) ([]NormEmail, error) {
 	session, err := zhujumpDecodeSession(token)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", session.BaseURL+"/api/emails/"+url.PathEscape(session.EmailID), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", session.BaseURL+"/api/emails/"+url.PathEscape(session.EmailID), nil)
 	if err != nil {
 		return nil, err
 	}
 	zhujumpJSONHeaders(req, session.BaseURL, session.Cookie)
-	resp, err := zhujumpHTTPClient().Do(req)
+	resp, err := zhujumpHTTPClient(ctx).Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -392,7 +393,7 @@ func ZhujumpGetEmails(email, token string) ([]NormEmail, error) {
 	out := make([]NormEmail, 0, len(detailResp.Messages))
 	for _, msg := range detailResp.Messages {
 		if id := strings.TrimSpace(fmt.Sprint(msg["id"])); id != "" && !zhujumpMessageHasBody(msg) {
-			if detail, err := zhujumpFetchMessageDetail(session.BaseURL, session.Cookie, session.EmailID, id); err == nil && detail != nil {
+			if detail, err := zhujumpFetchMessageDetail(ctx, session.BaseURL, session.Cookie, session.EmailID, id); err == nil && detail != nil {
 				for k, v := range detail {
 					msg[k] = v
 				}
@@ -0,0 +1,220 @@
+package tempemail
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	prov "github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
+	http "github.com/bogdanfinn/fhttp"
+	tls_client "github.com/bogdanfinn/tls-client"
+)
+
+/*
+ * 按主机限速
+ * SDK 的 HTTP 客户端在发出请求前按目标主机取令牌（SDKConfig.RateLimits 配置的令牌桶），
+ * 并在收到响应后读取服务端的限流信号：
+ *   - 429 / 503 的 Retry-After：在该时长内暂停该主机
+ *   - X-RateLimit-Remaining（或 RateLimit-Remaining）为 0：暂停到 X-RateLimit-Reset / RateLimit-Reset
+ * 需等待的时长不超过 RateLimitMaxWait 时原地等待后发出请求；否则不发请求、直接返回 *ErrRateLimited
+ * （RetryAfter 为剩余等待时长），GenerateEmail 随即换到其他渠道，重试策略也据此决定是否重试。
+ * 服务端限流信号对所有主机始终生效，不需要配置；每个实例独立持有限速状态。
+ * 自建 TLS 客户端的渠道（mail-cx、ddker-com）经 provider.HTTPClientHooks 挂载同样的钩子；
+ * 使用标准库 net/http 的 fakemail、uncorreotemporal 以及 WebSocket 连接不经过该限速。
+ *
+ * 示例:
+ *   tempemail.SetConfig(tempemail.SDKConfig{
+ *       RateLimits: map[string]tempemail.RateLimit{
+ *           "mytempmail.cc": {Rate: 1.0 / 60, Burst: 3}, // 每分钟 1 个，最多连续 3 个
+ *           "apihz.cn":      {Rate: 1, Burst: 1},
+ *       },
+ *   })
+ */
+
+/* RateLimit 单个主机的令牌桶限速 */
+type RateLimit struct {
+	/* 每秒补充的令牌数，即长期平均请求速率，如 0.5 表示每 2 秒一次；0 表示不限速 */
+	Rate float64
+	/* 桶容量，即允许连续突发的请求数，默认 1 */
+	Burst int
+}
+
+/* defaultRateLimitMaxWait 默认的最长等待时长 */
+const defaultRateLimitMaxWait = 5 * time.Second
+
+/* hostLimit 单个主机的限速状态 */
+type hostLimit struct {
+	tokens       float64
+	last         time.Time /* 上次补充令牌的时间 */
+	blockedUntil time.Time /* 服务端要求的暂停截止时间 */
+}
+
+/* rateLimiter 实例内按主机的限速状态，并发安全 */
+type rateLimiter struct {
+	mu    sync.Mutex
+	hosts map[string]*hostLimit
+}
+
+/* rateLimitFor 查找主机的限速配置：精确匹配优先，其次按域名后缀匹配（"mail.tm" 匹配 "api.mail.tm"），最后为 "*" */
+func rateLimitFor(limits map[string]RateLimit, host string) (RateLimit, bool) {
+	if l, ok := limits[host]; ok {
+		return l, l.Rate > 0
+	}
+	for h := host; ; {
+		i := strings.IndexByte(h, '.')
+		if i < 0 {
+			break
+		}
+		h = h[i+1:]
+		if l, ok := limits[h]; ok {
+			return l, l.Rate > 0
+		}
+	}
+	l, ok := limits["*"]
+	return l, ok && l.Rate > 0
+}
+
+func (rl *rateLimiter) host(h string) *hostLimit {
+	if rl.hosts == nil {
+		rl.hosts = make(map[string]*hostLimit)
+	}
+	s, ok := rl.hosts[h]
+	if !ok {
+		s = &hostLimit{tokens: math.NaN()}
+		rl.hosts[h] = s
+	}
+	return s
+}
+
+/*
+ * reserve 为一次请求预留令牌，返回发出请求前需等待的时长
+ * 需等待超过 maxWait 时不预留，返回 *ErrRateLimited
+ */
+func (rl *rateLimiter) reserve(host string, limit RateLimit, limited bool, maxWait time.Duration, now time.Time) (time.Duration, error) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	s := rl.host(host)
+
+	wait := time.Duration(0)
+	if s.blockedUntil.After(now) {
+		wait = s.blockedUntil.Sub(now)
+	}
+	if limited {
+		burst := float64(max(limit.Burst, 1))
+		if math.IsNaN(s.tokens) {
+			s.tokens = burst
+		} else {
+			s.tokens = math.Min(burst, s.tokens+now.Sub(s.last).Seconds()*limit.Rate)
+		}
+		s.last = now
+		/* 暂停结束时桶中已补充的令牌也可使用 */
+		available := s.tokens + wait.Seconds()*limit.Rate
+		if available < 1 {
+			wait += time.Duration((1 - available) / limit.Rate * float64(time.Second))
+		}
+	}
+	if wait > maxWait {
+		return 0, fmt.Errorf("%s: 本地限速，需等待 %s：%w", host, wait.Round(time.Millisecond), &ErrRateLimited{RetryAfter: wait})
+	}
+	if limited {
+		s.tokens--
+	}
+	return wait, nil
+}
+
+/* block 按服务端信号暂停主机直到 until（只延长、不缩短） */
+func (rl *rateLimiter) block(host string, until time.Time) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if s := rl.host(host); until.After(s.blockedUntil) {
+		s.blockedUntil = until
+	}
+}
+
+/*
+ * rateLimitSignal 从响应头解析服务端要求的暂停截止时间
+ * 429 / 503 读取 Retry-After；剩余额度为 0 时读取 X-RateLimit-Reset / RateLimit-Reset
+ * （小于 10 亿视为秒数，否则为 Unix 时间戳）
+ */
+func rateLimitSignal(resp *http.Response, now time.Time) (time.Time, bool) {
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if d := prov.ParseRetryAfter(resp.Header.Get("Retry-After")); d > 0 {
+			return now.Add(d), true
+		}
+	}
+	remaining := firstHeader(resp.Header, "X-RateLimit-Remaining", "RateLimit-Remaining")
+	if n, err := strconv.ParseFloat(remaining, 64); err != nil || n > 0 {
+		return time.Time{}, false
+	}
+	reset := firstHeader(resp.Header, "X-RateLimit-Reset", "RateLimit-Reset", "X-RateLimit-Reset-After")
+	v, err := strconv.ParseFloat(reset, 64)
+	if err != nil || v <= 0 {
+		return time.Time{}, false
+	}
+	if v >= 1e9 {
+		return time.Unix(0, int64(v*float64(time.Second))), true
+	}
+	return now.Add(time.Duration(v * float64(time.Second))), true
+}
+
+func firstHeader(h http.Header, keys ...string) string {
+	for _, k := range keys {
+		if v := strings.TrimSpace(h.Get(k)); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+/* rateLimitHooks 挂到实例 TLS 客户端上的请求前 / 响应后钩子，配置在每次请求时读取 */
+func (in *instance) rateLimitHooks() []tls_client.HttpClientOption {
+	pre := func(req *http.Request) error {
+		host := strings.ToLower(req.URL.Hostname())
+		if host == "" {
+			return nil
+		}
+		cfg := in.getConfig()
+		maxWait := cfg.RateLimitMaxWait
+		if maxWait <= 0 {
+			maxWait = defaultRateLimitMaxWait
+		}
+		limit, limited := rateLimitFor(cfg.RateLimits, host)
+		wait, err := in.limiter.reserve(host, limit, limited, maxWait, time.Now())
+		if err != nil {
+			in.log().Debug("主机限速中，跳过请求", "host", host, "error", err.Error())
+			return err
+		}
+		if wait <= 0 {
+			return nil
+		}
+		in.log().Debug("主机限速，等待后请求", "host", host, "wait", wait.String())
+		ctx := req.Context()
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		t := time.NewTimer(wait)
+		defer t.Stop()
+		select {
+		case <-t.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	post := func(c *tls_client.PostResponseContext) error {
+		if c.Response == nil || c.Request == nil {
+			return nil
+		}
+		if until, ok := rateLimitSignal(c.Response, time.Now()); ok {
+			host := strings.ToLower(c.Request.URL.Hostname())
+			in.limiter.block(host, until)
+			in.log().Info("服务端限流，暂停该主机", "host", host, "until", until.Format(time.RFC3339))
+		}
+		return nil
+	}
+	return []tls_client.HttpClientOption{tls_client.WithPreHook(pre), tls_client.WithPostHook(post)}
+}
@@ -0,0 +1,167 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	prov "github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
+	fhttp "github.com/bogdanfinn/fhttp"
+	tls_client "github.com/bogdanfinn/tls-client"
+)
+
+/* TestRateLimitForMatchesHostSuffix 校验限速配置按精确主机、域名后缀、"*" 的顺序匹配，Rate 为 0 表示不限速 */
+func TestRateLimitForMatchesHostSuffix(t *testing.T) {
+	limits := map[string]RateLimit{
+		"mail.tm":     {Rate: 1},
+		"api.mail.tm": {Rate: 2},
+		"apihz.cn":    {Rate: 3},
+		"*":           {Rate: 4},
+		"off.example": {Rate: 0},
+	}
+	cases := map[string]float64{
+		"api.mail.tm": 2,
+		"x.mail.tm":   1,
+		"cn.apihz.cn": 3,
+		"other.com":   4,
+	}
+	for host, want := range cases {
+		l, ok := rateLimitFor(limits, host)
+		if !ok || l.Rate != want {
+			t.Errorf("%s: 期望速率 %v，实际 %+v %v", host, want, l, ok)
+		}
+	}
+	if _, ok := rateLimitFor(limits, "off.example"); ok {
+		t.Error("Rate 为 0 时不应限速")
+	}
+	if _, ok := rateLimitFor(nil, "any.host"); ok {
+		t.Error("未配置时不应限速")
+	}
+}
+
+/* TestRateLimiterTokenBucket 校验令牌桶：突发额度内不等待、超出后按速率等待、超过最长等待时返回 ErrRateLimited */
+func TestRateLimiterTokenBucket(t *testing.T) {
+	var rl rateLimiter
+	limit := RateLimit{Rate: 1, Burst: 2}
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if wait, err := rl.reserve("h", limit, true, time.Second, now); err != nil || wait != 0 {
+			t.Fatalf("第 %d 个突发请求：wait=%v err=%v", i+1, wait, err)
+		}
+	}
+	wait, err := rl.reserve("h", limit, true, 2*time.Second, now)
+	if err != nil || wait != time.Second {
+		t.Fatalf("第 3 个请求期望等待 1s，实际 wait=%v err=%v", wait, err)
+	}
+
+	_, err = rl.reserve("h", limit, true, time.Second, now)
+	var rateLimited *ErrRateLimited
+	if !errors.As(err, &rateLimited) || rateLimited.RetryAfter != 2*time.Second {
+		t.Fatalf("期望 RetryAfter 为 2s 的 ErrRateLimited，实际 %v", err)
+	}
+
+	/* 失败的预留不消耗令牌，3 秒后补满一个 */
+	if wait, err := rl.reserve("h", limit, true, time.Second, now.Add(3*time.Second)); err != nil || wait != 0 {
+		t.Fatalf("补充令牌后：wait=%v err=%v", wait, err)
+	}
+	/* 其他主机互不影响 */
+	if wait, err := rl.reserve("other", limit, true, 0, now); err != nil || wait != 0 {
+		t.Fatalf("其他主机：wait=%v err=%v", wait, err)
+	}
+}
+
+/* TestRateLimiterBlock 校验服务端要求的暂停只延长不缩短，临近截止时原地等待剩余时长 */
+func TestRateLimiterBlock(t *testing.T) {
+	var rl rateLimiter
+	now := time.Now()
+	rl.block("h", now.Add(30*time.Second))
+	rl.block("h", now.Add(10*time.Second)) /* 不缩短 */
+
+	_, err := rl.reserve("h", RateLimit{}, false, 5*time.Second, now)
+	var rateLimited *ErrRateLimited
+	if !errors.As(err, &rateLimited) || rateLimited.RetryAfter != 30*time.Second {
+		t.Fatalf("期望暂停 30s，实际 %v", err)
+	}
+	if wait, err := rl.reserve("h", RateLimit{}, false, 5*time.Second, now.Add(27*time.Second)); err != nil || wait != 3*time.Second {
+		t.Fatalf("临近截止时期望等待 3s，实际 wait=%v err=%v", wait, err)
+	}
+}
+
+/* TestRateLimitSignal 校验从 Retry-After 与 RateLimit-* 响应头解析暂停截止时间 */
+func TestRateLimitSignal(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	resp := func(status int, kv ...string) *fhttp.Response {
+		h := fhttp.Header{}
+		for i := 0; i+1 < len(kv); i += 2 {
+			h.Set(kv[i], kv[i+1])
+		}
+		return &fhttp.Response{StatusCode: status, Header: h}
+	}
+
+	cases := []struct {
+		name string
+		resp *fhttp.Response
+		want time.Duration
+		ok   bool
+	}{
+		{"429 Retry-After 秒数", resp(429, "Retry-After", "12"), 12 * time.Second, true},
+		{"503 Retry-After", resp(503, "Retry-After", "5"), 5 * time.Second, true},
+		{"200 忽略 Retry-After", resp(200, "Retry-After", "12"), 0, false},
+		{"额度耗尽，Reset 为秒数", resp(200, "X-RateLimit-Remaining", "0", "X-RateLimit-Reset", "20"), 20 * time.Second, true},
+		{"额度耗尽，Reset 为时间戳", resp(200, "X-RateLimit-Remaining", "0", "X-RateLimit-Reset", strconv.FormatInt(now.Unix()+40, 10)), 40 * time.Second, true},
+		{"IETF RateLimit 头", resp(200, "RateLimit-Remaining", "0", "RateLimit-Reset", "7"), 7 * time.Second, true},
+		{"仍有剩余额度", resp(200, "X-RateLimit-Remaining", "3", "X-RateLimit-Reset", "20"), 0, false},
+		{"缺少 Reset", resp(200, "X-RateLimit-Remaining", "0"), 0, false},
+		{"无限流头", resp(429), 0, false},
+	}
+	for _, c := range cases {
+		until, ok := rateLimitSignal(c.resp, now)
+		if ok != c.ok || (ok && until.Sub(now) != c.want) {
+			t.Errorf("%s: 期望 %v %v，实际 %v %v", c.name, c.want, c.ok, until.Sub(now), ok)
+		}
+	}
+}
+
+/* TestRateLimitHooksSkipBlockedHost 校验收到 Retry-After 后，同一主机的后续请求在本地返回 ErrRateLimited、不再到达服务端 */
+func TestRateLimitHooksSkipBlockedHost(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	in := newOfflineClient().instance()
+	client := in.httpClient()
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("首个请求：%v", err)
+	}
+	resp.Body.Close()
+
+	_, err = client.Get(srv.URL)
+	var rateLimited *ErrRateLimited
+	if !errors.As(err, &rateLimited) || rateLimited.RetryAfter < 59*time.Second {
+		t.Fatalf("Retry-After 后期望本地返回 ErrRateLimited，实际 %v", err)
+	}
+	if n := hits.Load(); n != 1 {
+		t.Fatalf("暂停期间的请求到达了服务端：hits=%d", n)
+	}
+
+	/* 自建客户端的渠道（mail-cx 等）经 provider.HTTPClientHooks 共享同一实例的限速状态 */
+	own, err := tls_client.NewHttpClient(tls_client.NewNoopLogger(), prov.HTTPClientHooks(withInstance(context.Background(), in))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = own.Get(srv.URL); !errors.As(err, &rateLimited) || hits.Load() != 1 {
+		t.Fatalf("自建客户端期望本地返回 ErrRateLimited，实际 %v，hits=%d", err, hits.Load())
+	}
+}
@@ -0,0 +1,55 @@
+package tempemail
+
+import (
+	"context"
+	"fmt"
+)
+
+/*
+ * 原始邮件源码
+ * 归一化的 Email 只保留常用字段；需要 Message-ID、Reply-To、List-Unsubscribe、
+ * Authentication-Results 等完整头部或原始 MIME 时，声明了 CapRawSource 的渠道
+ * （mail.tm、duckmail、dropmail、anonbox）可通过 GetRawEmail 获取 RFC 5322 源码。
+ * 提供原始头部的渠道（anonbox、tempmail.cn、restmail 与 mjj.cm 等 Socket.IO 渠道）同时填充 Email.Headers。
+ *
+ * 示例:
+ *   raw, err := GetRawEmail(ctx, info, email.ID)
+ *   msg, _ := mail.ReadMessage(bytes.NewReader(raw))
+ *   fmt.Println(msg.Header.Get("Authentication-Results"))
+ */
+
+/*
+ * GetRawEmail 获取单封邮件的原始 MIME 源码，id 为 Email.ID
+ * 渠道不支持时返回 ErrUnsupported；邮件不存在时返回 ErrEmailNotFound
+ */
+func GetRawEmail(ctx context.Context, info *EmailInfo, id string) ([]byte, error) {
+	return instanceOf(info).getRawEmail(ctx, info, id)
+}
+
+func (in *instance) getRawEmail(ctx context.Context, info *EmailInfo, id string) ([]byte, error) {
+	spec, err := specFor(info)
+	if err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return nil, fmt.Errorf("email id is required")
+	}
+	if spec.GetRawEmail == nil {
+		return nil, fmt.Errorf("%s 渠道不支持获取原始邮件：%w", info.Channel, ErrUnsupported)
+	}
+
+	ctx = withInstance(ctx, in)
+	raw, _, err := withRetryAndAttempts(ctx, func() ([]byte, error) {
+		return spec.GetRawEmail(ctx, info.Email, info.token, id)
+	}, nil)
+	if err != nil {
+		in.log().Warn("获取原始邮件失败", "channel", string(info.Channel), "id", id, "error", err.Error())
+		return nil, err
+	}
+	return raw, nil
+}
+
+/* GetRawEmail 获取该邮箱中单封邮件的原始源码，见 GetRawEmail */
+func (m *Mailbox) GetRawEmail(ctx context.Context, id string) ([]byte, error) {
+	return GetRawEmail(ctx, m.info, id)
+}
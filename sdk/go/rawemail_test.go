@@ -0,0 +1,43 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+/*
+ * TestRawEmailAndHeaders 校验原始源码与邮件头：GetRawEmail 分发到渠道实现、
+ * 不支持的渠道返回 ErrUnsupported；归一化时邮件头键名规范化并保留重复头
+ */
+func TestRawEmailAndHeaders(t *testing.T) {
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-raw",
+		GetRawEmail: func(ctx context.Context, email, token, id string) ([]byte, error) {
+			return []byte("Message-ID: <" + id + "@x>\r\n\r\nbody"), nil
+		},
+		Capabilities: CapRawSource,
+	})
+	registerFakeChannel(t, ChannelSpec{Channel: "test-noraw"})
+
+	ctx := context.Background()
+	m := newOfflineClient().AddMailbox(&EmailInfo{Channel: "test-raw", Email: "a@x"}, nil)
+	if raw, err := m.GetRawEmail(ctx, "42"); err != nil || string(raw) != "Message-ID: <42@x>\r\n\r\nbody" {
+		t.Fatalf("GetRawEmail: %q, %v", raw, err)
+	}
+	if _, err := GetRawEmail(ctx, &EmailInfo{Channel: "test-noraw", Email: "b@x"}, "1"); !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("期望 ErrUnsupported，实际 %v", err)
+	}
+
+	e := normalizeRawEmail(map[string]interface{}{
+		"id": "1",
+		"headers": map[string]interface{}{
+			"reply-to":               "r@x",
+			"authentication-results": []interface{}{"spf=pass", "dkim=pass"},
+			"from":                   map[string]interface{}{"text": "ignored"},
+		},
+	}, "a@x")
+	if e.Headers["Reply-To"][0] != "r@x" || len(e.Headers["Authentication-Results"]) != 2 || e.Headers["From"] != nil {
+		t.Fatalf("邮件头归一化错误: %v", e.Headers)
+	}
+}
@@ -1,11 +1,16 @@
 package tempemail
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
 
 /*
  * ChannelSpec 单个渠道的注册规格
  * 每新增一个渠道只需在注册文件里追加一处 registerChannel(ChannelSpec{...})，
- * 渠道列表（allChannels）、信息映射表（channelInfoMap）、创建/收信分发逻辑
+ * 渠道列表（allChannels）、信息映射表（channelInfoMap）、后端归属（channelToBackend）、创建/收信分发逻辑
  * 全部由该结构自动派生，无需再手动同步多处平行结构。
  */
 type ChannelSpec struct {
@@ -15,10 +20,44 @@ type ChannelSpec struct {
 	Name string
 	/* 对应的临时邮箱服务网站 */
 	Website string
-	/* 创建邮箱的实现（对应原 generateEmailOnce 中该渠道的 case 体） */
-	Generate func(opts *GenerateEmailOptions) (*EmailInfo, error)
-	/* 获取邮件的实现（对应原 getEmailsOnce 中该渠道的 case 体） */
-	GetEmails func(email, token string) ([]Email, error)
+	/*
+	 * 渠道所属后端：共用同一服务端基础设施的渠道（镜像域名、固定域名变体等）填写相同的值，
+	 * GenerateEmail 据此对同后端渠道去重并按后端熔断；空表示该渠道自成一个后端
+	 */
+	Backend string
+	/* 渠道声明的能力集合（HTML、附件、域名选择等），供 ListChannels 展示与 GenerateEmailOptions.Require 筛选 */
+	Capabilities Capability
+	/* 创建邮箱的实现（对应原 generateEmailOnce 中该渠道的 case 体），ctx 透传到 provider 的每个 HTTP 请求 */
+	Generate func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error)
+	/* 获取邮件的实现（对应原 getEmailsOnce 中该渠道的 case 体），ctx 透传到 provider 的每个 HTTP 请求 */
+	GetEmails func(ctx context.Context, email, token string) ([]Email, error)
+	/* 仅获取邮件摘要（可选），不逐封拉取正文；nil 表示渠道的列表接口已含正文，ListEmails 回退到 GetEmails */
+	ListEmails func(ctx context.Context, email, token string) ([]Email, error)
+	/* 获取单封邮件完整内容（可选）；nil 时 GetEmail 从 GetEmails 的结果中查找 */
+	GetEmail func(ctx context.Context, email, token, id string) (*Email, error)
+	/*
+	 * 基于渠道原生游标的增量获取（可选），cursor 为渠道自身的游标（空串表示从头开始），
+	 * 返回新邮件与下一个游标；nil 时 GetEmailsSince 在客户端按已见邮件去重
+	 */
+	GetEmailsSince func(ctx context.Context, email, token, cursor string) ([]Email, string, error)
+	/* 获取邮件原始 MIME 源码（可选），nil 表示渠道不支持 */
+	GetRawEmail func(ctx context.Context, email, token, id string) ([]byte, error)
+	/* 使用会话凭据（Bearer Token、匿名 JWT 等）下载附件（可选）；nil 时 DownloadAttachment 直接 GET 附件 URL */
+	DownloadAttachment func(ctx context.Context, email, token string, att EmailAttachment) (io.ReadCloser, error)
+	/*
+	 * 新邮件推送订阅（可选），仅 WebSocket / Socket.IO 等推送型渠道实现；
+	 * 返回的通道在新邮件到达时收到信号，取消函数结束订阅。nil 表示该渠道只能轮询
+	 */
+	Subscribe func(ctx context.Context, email, token string) (<-chan struct{}, func(), error)
+	/* 删除单封邮件（可选），nil 表示渠道不支持 */
+	DeleteEmail func(ctx context.Context, email, token, id string) error
+	/* 销毁邮箱（可选）：删除服务端账号或放弃该地址；nil 表示渠道不支持 */
+	Destroy func(ctx context.Context, email, token string) error
+	/*
+	 * 延长邮箱有效期（可选），d 为期望延长的时长，服务端可能忽略并按自身规则续期；
+	 * 返回续期后的过期时间。nil 表示渠道不支持
+	 */
+	Extend func(ctx context.Context, email, token string, d time.Duration) (time.Time, error)
 }
 
 /* 有序渠道注册表，注册顺序即枚举顺序（硬约束，五端一致） */
@@ -42,8 +81,40 @@ func registerChannel(spec ChannelSpec) {
 	if _, exists := channelRegistryMap[spec.Channel]; exists {
 		panic(fmt.Sprintf("duplicate channel registration: %s", spec.Channel))
 	}
+	if spec.Capabilities.Has(CapPush) != (spec.Subscribe != nil) {
+		panic(fmt.Sprintf("channel %s: CapPush must be declared together with Subscribe", spec.Channel))
+	}
+	if spec.Capabilities.Has(CapDelete) != (spec.DeleteEmail != nil || spec.Destroy != nil) {
+		panic(fmt.Sprintf("channel %s: CapDelete must be declared together with DeleteEmail or Destroy", spec.Channel))
+	}
+	if spec.Capabilities.Has(CapRawSource) != (spec.GetRawEmail != nil) {
+		panic(fmt.Sprintf("channel %s: CapRawSource must be declared together with GetRawEmail", spec.Channel))
+	}
+	if spec.Capabilities.Has(CapExtend) != (spec.Extend != nil) {
+		panic(fmt.Sprintf("channel %s: CapExtend must be declared together with Extend", spec.Channel))
+	}
 	stored := spec
 	channelRegistry = append(channelRegistry, &stored)
 	channelRegistryMap[spec.Channel] = &stored
 	allChannels = append(allChannels, spec.Channel)
+	if spec.Backend != "" {
+		channelToBackend[spec.Channel] = spec.Backend
+	}
+}
+
+/* info 渠道对外展示的信息 */
+func (spec *ChannelSpec) info() ChannelInfo {
+	return ChannelInfo{Channel: spec.Channel, Name: spec.Name, Website: spec.Website, Backend: backendOf(spec.Channel), Capabilities: spec.Capabilities}
+}
+
+/* specFor 校验 EmailInfo 并取得其渠道规格，供清理、续期、单封邮件、增量获取、附件与原始源码等按邮箱的操作使用 */
+func specFor(info *EmailInfo) (*ChannelSpec, error) {
+	if info == nil {
+		return nil, fmt.Errorf("EmailInfo is required, call GenerateEmail() first")
+	}
+	spec, ok := channelRegistryMap[info.Channel]
+	if !ok {
+		return nil, fmt.Errorf("unknown channel: %s", info.Channel)
+	}
+	return spec, nil
 }
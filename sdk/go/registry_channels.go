@@ -1,7 +1,10 @@
 package tempemail
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"time"
 
 	prov "github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
 )
@@ -15,42 +18,44 @@ func init() {
 		Channel: ChannelTempmail,
 		Name:    "TempMail",
 		Website: "tempmail.ing",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
 			duration := opts.Duration
 			if duration <= 0 {
 				duration = 30
 			}
-			return fromMailbox(prov.TempmailGenerate(duration))
+			return fromMailbox(prov.TempmailGenerate(ctx, duration))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapTTLControl,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempmailCn,
 		Name:    "TempMail CN",
 		Website: "tempmail.cn",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailCNGenerate(opts.Domain))
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailCNGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailCNGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailCNGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTaEasy,
 		Name:    "TA Easy",
 		Website: "ta-easy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TaEasyGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TaEasyGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for ta-easy channel")
 			}
-			return normEmailsResult(prov.TaEasyGetEmails(email, token))
+			return normEmailsResult(prov.TaEasyGetEmails(ctx, email, token))
 		},
 	})
 
@@ -58,161 +63,178 @@ func init() {
 		Channel: Channel10minuteOne,
 		Name:    "10 Minute Email",
 		Website: "10minutemail.one",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TenminuteOneGenerate(opts.Domain))
+		Backend: "10minute-one",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TenminuteOneGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for 10minute-one channel")
 			}
-			return normEmailsResult(prov.TenminuteOneGetEmails(email, token))
+			return normEmailsResult(prov.TenminuteOneGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelXghffCom,
 		Name:    "xghff.com",
 		Website: "10minutemail.one",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genTenminuteVariant("xghff.com", ChannelXghffCom)
+		Backend: "10minute-one",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genTenminuteVariant(ctx, "xghff.com", ChannelXghffCom)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelXghffCom)
 			}
-			return normEmailsResult(prov.TenminuteOneGetEmails(email, token))
+			return normEmailsResult(prov.TenminuteOneGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelOqqajCom,
 		Name:    "oqqaj.com",
 		Website: "10minutemail.one",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genTenminuteVariant("oqqaj.com", ChannelOqqajCom)
+		Backend: "10minute-one",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genTenminuteVariant(ctx, "oqqaj.com", ChannelOqqajCom)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelOqqajCom)
 			}
-			return normEmailsResult(prov.TenminuteOneGetEmails(email, token))
+			return normEmailsResult(prov.TenminuteOneGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelPsovvCom,
 		Name:    "psovv.com",
 		Website: "10minutemail.one",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genTenminuteVariant("psovv.com", ChannelPsovvCom)
+		Backend: "10minute-one",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genTenminuteVariant(ctx, "psovv.com", ChannelPsovvCom)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelPsovvCom)
 			}
-			return normEmailsResult(prov.TenminuteOneGetEmails(email, token))
+			return normEmailsResult(prov.TenminuteOneGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDbwotCom,
 		Name:    "dbwot.com",
 		Website: "10minutemail.one",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genTenminuteVariant("dbwot.com", ChannelDbwotCom)
+		Backend: "10minute-one",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genTenminuteVariant(ctx, "dbwot.com", ChannelDbwotCom)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelDbwotCom)
 			}
-			return normEmailsResult(prov.TenminuteOneGetEmails(email, token))
+			return normEmailsResult(prov.TenminuteOneGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelYgwprCom,
 		Name:    "ygwpr.com",
 		Website: "10minutemail.one",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genTenminuteVariant("ygwpr.com", ChannelYgwprCom)
+		Backend: "10minute-one",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genTenminuteVariant(ctx, "ygwpr.com", ChannelYgwprCom)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelYgwprCom)
 			}
-			return normEmailsResult(prov.TenminuteOneGetEmails(email, token))
+			return normEmailsResult(prov.TenminuteOneGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelImxweCom,
 		Name:    "imxwe.com",
 		Website: "10minutemail.one",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genTenminuteVariant("imxwe.com", ChannelImxweCom)
+		Backend: "10minute-one",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genTenminuteVariant(ctx, "imxwe.com", ChannelImxweCom)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelImxweCom)
 			}
-			return normEmailsResult(prov.TenminuteOneGetEmails(email, token))
+			return normEmailsResult(prov.TenminuteOneGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelLinshiyou,
 		Name:    "临时邮",
 		Website: "linshiyou.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.LinshiyouGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.LinshiyouGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for linshiyou channel")
 			}
-			return normEmailsResult(prov.LinshiyouGetEmails(token, email))
+			return normEmailsResult(prov.LinshiyouGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMffac,
 		Name:    "MFFAC",
 		Website: "mffac.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MffacGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MffacGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MffacGetEmails(email, token))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MffacGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempmailLol,
 		Name:    "TempMail LOL",
 		Website: "tempmail.lol",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailLolGenerate(opts.Domain))
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailLolGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempmail-lol channel")
 			}
-			return normEmailsResult(prov.TempmailLolGetEmails(token, email))
+			return normEmailsResult(prov.TempmailLolGetEmails(ctx, token, email))
 		},
+		Capabilities: CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelChatgptOrgUk,
 		Name:    "ChatGPT Mail",
 		Website: "mail.chatgpt.org.uk",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ChatgptOrgUkGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ChatgptOrgUkGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for chatgpt-org-uk channel")
 			}
-			return normEmailsResult(prov.ChatgptOrgUkGetEmails(email, token))
+			return normEmailsResult(prov.ChatgptOrgUkGetEmails(ctx, email, token))
 		},
 	})
 
@@ -220,130 +242,147 @@ func init() {
 		Channel: ChannelTempMailIo,
 		Name:    "Temp-Mail.io",
 		Website: "temp-mail.io",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempMailIoGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempMailIoGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempMailIoGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempMailIoGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailCx,
 		Name:    "Mail.cx",
 		Website: "mail.cx",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailCxGenerate(opts.Domain))
+		Backend: "mail-cx",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailCxGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for mail-cx channel")
 			}
-			return normEmailsResult(prov.MailCxGetEmails(token, email))
+			return normEmailsResult(prov.MailCxGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDdkerCom,
 		Name:    "ddker.com",
 		Website: "mail.cx",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			info, err := fromMailbox(prov.MailCxGenerate(fixedDomain("ddker.com")))
+		Backend: "mail-cx",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			info, err := fromMailbox(prov.MailCxGenerate(ctx, fixedDomain("ddker.com")))
 			if err != nil {
 				return nil, err
 			}
 			info.Channel = ChannelDdkerCom
 			return info, nil
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for ddker-com channel")
 			}
-			return normEmailsResult(prov.MailCxGetEmails(token, email))
+			return normEmailsResult(prov.MailCxGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelCatchmail,
 		Name:    "Catchmail",
 		Website: "catchmail.io",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.CatchmailGenerate(opts.Domain))
+		Backend: "catchmail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.CatchmailGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.CatchmailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.CatchmailGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelCatchmailMailistry,
 		Name:    "Catchmail Mailistry",
 		Website: "mailistry.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.CatchmailGenerate(fixedDomain("mailistry.com"), string(ChannelCatchmailMailistry)))
+		Backend: "catchmail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.CatchmailGenerate(ctx, fixedDomain("mailistry.com"), string(ChannelCatchmailMailistry)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.CatchmailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.CatchmailGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelCatchmailZeppost,
 		Name:    "Catchmail Zeppost",
 		Website: "zeppost.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.CatchmailGenerate(fixedDomain("zeppost.com"), string(ChannelCatchmailZeppost)))
+		Backend: "catchmail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.CatchmailGenerate(ctx, fixedDomain("zeppost.com"), string(ChannelCatchmailZeppost)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.CatchmailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.CatchmailGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailforspam,
 		Name:    "MailForSpam",
 		Website: "mailforspam.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailforspamGenerate(opts.Domain))
+		Backend: "mailforspam",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailforspamGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailforspamGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailforspamGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailforspamTempmailIo,
 		Name:    "MailForSpam TempMail.io",
 		Website: "tempmail.io",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailforspamGenerate(fixedDomain("tempmail.io"), string(ChannelMailforspamTempmailIo)))
+		Backend: "mailforspam",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailforspamGenerate(ctx, fixedDomain("tempmail.io"), string(ChannelMailforspamTempmailIo)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailforspamGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailforspamGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailforspamDisposable,
 		Name:    "MailForSpam Disposable",
 		Website: "disposable.email",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailforspamGenerate(fixedDomain("disposable.email"), string(ChannelMailforspamDisposable)))
+		Backend: "mailforspam",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailforspamGenerate(ctx, fixedDomain("disposable.email"), string(ChannelMailforspamDisposable)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailforspamGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailforspamGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempmailc,
 		Name:    "TempMailC",
 		Website: "tempmailc.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailcGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailcGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailcGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailcGetEmails(ctx, email))
 		},
 	})
 
@@ -351,95 +390,102 @@ func init() {
 		Channel: ChannelMailnesia,
 		Name:    "Mailnesia",
 		Website: "mailnesia.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailnesiaGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailnesiaGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailnesiaGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailnesiaGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelThrowawaymail,
 		Name:    "ThrowawayMail",
 		Website: "throwawaymail.app",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ThrowawaymailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ThrowawaymailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for throwawaymail channel")
 			}
-			return normEmailsResult(prov.ThrowawaymailGetEmails(token, email))
+			return normEmailsResult(prov.ThrowawaymailGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempmailFish,
 		Name:    "TempMail Fish",
 		Website: "tempmail.fish",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailFishGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailFishGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempmail-fish channel")
 			}
-			return normEmailsResult(prov.TempmailFishGetEmails(token, email))
+			return normEmailsResult(prov.TempmailFishGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelNeighboursSh,
 		Name:    "Neighbours",
 		Website: "neighbours.sh",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.NeighboursShGenerate())
+		Backend: "neighbours",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.NeighboursShGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.NeighboursShGetEmails(token, email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.NeighboursShGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelShittyEmail,
 		Name:    "shitty.email",
 		Website: "shitty.email",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ShittyEmailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ShittyEmailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for shitty-email channel")
 			}
-			return normEmailsResult(prov.ShittyEmailGetEmails(token, email))
+			return normEmailsResult(prov.ShittyEmailGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempmailpro,
 		Name:    "TempMail Pro",
 		Website: "tempmailpro.us",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailproGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailproGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempmailpro channel")
 			}
-			return normEmailsResult(prov.TempmailproGetEmails(token, email))
+			return normEmailsResult(prov.TempmailproGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDevmailUk,
 		Name:    "DevMail UK",
 		Website: "devmail.uk",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.DevmailUkGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.DevmailUkGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.DevmailUkGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.DevmailUkGetEmails(ctx, email))
 		},
 	})
 
@@ -447,23 +493,24 @@ func init() {
 		Channel: ChannelInboxkitten,
 		Name:    "InboxKitten",
 		Website: "inboxkitten.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
 			return fromMailbox(prov.InboxkittenGenerate())
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.InboxkittenGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.InboxkittenGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelCleanTempMail,
 		Name:    "CleanTempMail",
 		Website: "cleantempmail.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.CleanTempMailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.CleanTempMailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.CleanTempMailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.CleanTempMailGetEmails(ctx, email))
 		},
 	})
 
@@ -471,596 +518,674 @@ func init() {
 		Channel: ChannelGetnada,
 		Name:    "GetNada",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(opts.Domain))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for getnada channel")
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelOneVpnNet,
 		Name:    "1vpn.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("1vpn.net"), string(ChannelOneVpnNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("1vpn.net"), string(ChannelOneVpnNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelOneVpnNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelAbematvCom,
 		Name:    "abematv.com",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("abematv.com"), string(ChannelAbematvCom)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("abematv.com"), string(ChannelAbematvCom)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelAbematvCom)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelAbematvNet,
 		Name:    "abematv.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("abematv.net"), string(ChannelAbematvNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("abematv.net"), string(ChannelAbematvNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelAbematvNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelAbematvOrg,
 		Name:    "abematv.org",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("abematv.org"), string(ChannelAbematvOrg)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("abematv.org"), string(ChannelAbematvOrg)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelAbematvOrg)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelAcehCc,
 		Name:    "aceh.cc",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("aceh.cc"), string(ChannelAcehCc)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("aceh.cc"), string(ChannelAcehCc)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelAcehCc)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelBangkabelitungNet,
 		Name:    "bangkabelitung.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("bangkabelitung.net"), string(ChannelBangkabelitungNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("bangkabelitung.net"), string(ChannelBangkabelitungNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelBangkabelitungNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelCctruyenCom,
 		Name:    "cctruyen.com",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("cctruyen.com"), string(ChannelCctruyenCom)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("cctruyen.com"), string(ChannelCctruyenCom)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelCctruyenCom)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGetnadaCom,
 		Name:    "getnada.com",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("getnada.com"), string(ChannelGetnadaCom)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("getnada.com"), string(ChannelGetnadaCom)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelGetnadaCom)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGetnadaEmail,
 		Name:    "getnada.email",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("getnada.email"), string(ChannelGetnadaEmail)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("getnada.email"), string(ChannelGetnadaEmail)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelGetnadaEmail)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGetnadaNet,
 		Name:    "getnada.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("getnada.net"), string(ChannelGetnadaNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("getnada.net"), string(ChannelGetnadaNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelGetnadaNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelJawatengahNet,
 		Name:    "jawatengah.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("jawatengah.net"), string(ChannelJawatengahNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("jawatengah.net"), string(ChannelJawatengahNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelJawatengahNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelJawatimurNet,
 		Name:    "jawatimur.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("jawatimur.net"), string(ChannelJawatimurNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("jawatimur.net"), string(ChannelJawatimurNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelJawatimurNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelKalimantanbaratNet,
 		Name:    "kalimantanbarat.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("kalimantanbarat.net"), string(ChannelKalimantanbaratNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("kalimantanbarat.net"), string(ChannelKalimantanbaratNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelKalimantanbaratNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelKalimantanselatanNet,
 		Name:    "kalimantanselatan.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("kalimantanselatan.net"), string(ChannelKalimantanselatanNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("kalimantanselatan.net"), string(ChannelKalimantanselatanNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelKalimantanselatanNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelKalimantantengahNet,
 		Name:    "kalimantantengah.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("kalimantantengah.net"), string(ChannelKalimantantengahNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("kalimantantengah.net"), string(ChannelKalimantantengahNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelKalimantantengahNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelKalimantantimurNet,
 		Name:    "kalimantantimur.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("kalimantantimur.net"), string(ChannelKalimantantimurNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("kalimantantimur.net"), string(ChannelKalimantantimurNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelKalimantantimurNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelKalimantanutaraNet,
 		Name:    "kalimantanutara.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("kalimantanutara.net"), string(ChannelKalimantanutaraNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("kalimantanutara.net"), string(ChannelKalimantanutaraNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelKalimantanutaraNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelKepulauanriauNet,
 		Name:    "kepulauanriau.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("kepulauanriau.net"), string(ChannelKepulauanriauNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("kepulauanriau.net"), string(ChannelKepulauanriauNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelKepulauanriauNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelLuxury345Com,
 		Name:    "luxury345.com",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("luxury345.com"), string(ChannelLuxury345Com)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("luxury345.com"), string(ChannelLuxury345Com)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelLuxury345Com)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMalukuutaraNet,
 		Name:    "malukuutara.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("malukuutara.net"), string(ChannelMalukuutaraNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("malukuutara.net"), string(ChannelMalukuutaraNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelMalukuutaraNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelNusatenggarabaratNet,
 		Name:    "nusatenggarabarat.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("nusatenggarabarat.net"), string(ChannelNusatenggarabaratNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("nusatenggarabarat.net"), string(ChannelNusatenggarabaratNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelNusatenggarabaratNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelNusatenggaratimurNet,
 		Name:    "nusatenggaratimur.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("nusatenggaratimur.net"), string(ChannelNusatenggaratimurNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("nusatenggaratimur.net"), string(ChannelNusatenggaratimurNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelNusatenggaratimurNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelPapuabaratNet,
 		Name:    "papuabarat.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("papuabarat.net"), string(ChannelPapuabaratNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("papuabarat.net"), string(ChannelPapuabaratNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelPapuabaratNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelPapuabaratdayaNet,
 		Name:    "papuabaratdaya.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("papuabaratdaya.net"), string(ChannelPapuabaratdayaNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("papuabaratdaya.net"), string(ChannelPapuabaratdayaNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelPapuabaratdayaNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelPapuaselatanNet,
 		Name:    "papuaselatan.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("papuaselatan.net"), string(ChannelPapuaselatanNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("papuaselatan.net"), string(ChannelPapuaselatanNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelPapuaselatanNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelPeholCom,
 		Name:    "pehol.com",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("pehol.com"), string(ChannelPeholCom)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("pehol.com"), string(ChannelPeholCom)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelPeholCom)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelPtruyenCom,
 		Name:    "ptruyen.com",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("ptruyen.com"), string(ChannelPtruyenCom)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("ptruyen.com"), string(ChannelPtruyenCom)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelPtruyenCom)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelPulaubaliNet,
 		Name:    "pulaubali.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("pulaubali.net"), string(ChannelPulaubaliNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("pulaubali.net"), string(ChannelPulaubaliNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelPulaubaliNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelRiauNet,
 		Name:    "riau.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("riau.net"), string(ChannelRiauNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("riau.net"), string(ChannelRiauNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelRiauNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSeokeyOrg,
 		Name:    "seokey.org",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("seokey.org"), string(ChannelSeokeyOrg)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("seokey.org"), string(ChannelSeokeyOrg)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelSeokeyOrg)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSulawesibaratNet,
 		Name:    "sulawesibarat.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("sulawesibarat.net"), string(ChannelSulawesibaratNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("sulawesibarat.net"), string(ChannelSulawesibaratNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelSulawesibaratNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSulawesiselatanNet,
 		Name:    "sulawesiselatan.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("sulawesiselatan.net"), string(ChannelSulawesiselatanNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("sulawesiselatan.net"), string(ChannelSulawesiselatanNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelSulawesiselatanNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSulawesitengahNet,
 		Name:    "sulawesitengah.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("sulawesitengah.net"), string(ChannelSulawesitengahNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("sulawesitengah.net"), string(ChannelSulawesitengahNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelSulawesitengahNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSulawesitenggaraNet,
 		Name:    "sulawesitenggara.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("sulawesitenggara.net"), string(ChannelSulawesitenggaraNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("sulawesitenggara.net"), string(ChannelSulawesitenggaraNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelSulawesitenggaraNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSumaterabaratNet,
 		Name:    "sumaterabarat.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("sumaterabarat.net"), string(ChannelSumaterabaratNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("sumaterabarat.net"), string(ChannelSumaterabaratNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelSumaterabaratNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSumateraselatanNet,
 		Name:    "sumateraselatan.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("sumateraselatan.net"), string(ChannelSumateraselatanNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("sumateraselatan.net"), string(ChannelSumateraselatanNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelSumateraselatanNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSumaterautaraNet,
 		Name:    "sumaterautara.net",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("sumaterautara.net"), string(ChannelSumaterautaraNet)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("sumaterautara.net"), string(ChannelSumaterautaraNet)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelSumaterautaraNet)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelVillatogelCom,
 		Name:    "villatogel.com",
 		Website: "getnada.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GetnadaGenerate(fixedDomain("villatogel.com"), string(ChannelVillatogelCom)))
+		Backend: "getnada",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GetnadaGenerate(ctx, fixedDomain("villatogel.com"), string(ChannelVillatogelCom)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelVillatogelCom)
 			}
-			return normEmailsResult(prov.GetnadaGetEmails(token, email))
+			return normEmailsResult(prov.GetnadaGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMail123,
 		Name:    "Mail123",
 		Website: "mail123.fr",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Mail123Generate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Mail123Generate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.Mail123GetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.Mail123GetEmails(ctx, email))
 		},
 	})
 
@@ -1068,56 +1193,59 @@ func init() {
 		Channel: ChannelMail10s,
 		Name:    "Mail10s",
 		Website: "mail10s.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Mail10sGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Mail10sGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.Mail10sGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.Mail10sGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelWebmailtemp,
 		Name:    "WebMailTemp",
 		Website: "webmailtemp.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.WebmailtempGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.WebmailtempGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for webmailtemp channel")
 			}
-			return normEmailsResult(prov.WebmailtempGetEmails(token, email))
+			return normEmailsResult(prov.WebmailtempGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempfastmail,
 		Name:    "TempFastMail",
 		Website: "tempfastmail.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempfastmailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempfastmailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempfastmail channel")
 			}
-			return normEmailsResult(prov.TempfastmailGetEmails(token, email))
+			return normEmailsResult(prov.TempfastmailGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelOneSecMail,
 		Name:    "1SecMail",
 		Website: "1sec-mail.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.OneSecMailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.OneSecMailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for 1sec-mail channel")
 			}
-			return normEmailsResult(prov.OneSecMailGetEmails(token, email))
+			return normEmailsResult(prov.OneSecMailGetEmails(ctx, token, email))
 		},
 	})
 
@@ -1125,71 +1253,75 @@ func init() {
 		Channel: ChannelFakemail,
 		Name:    "FakeMail",
 		Website: "fakemail.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.FakemailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.FakemailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for fakemail channel")
 			}
-			return normEmailsResult(prov.FakemailGetEmails(token, email))
+			return normEmailsResult(prov.FakemailGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelOpeninbox,
 		Name:    "OpenInbox",
 		Website: "openinbox.io",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.OpeninboxGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.OpeninboxGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for openinbox channel")
 			}
-			return normEmailsResult(prov.OpeninboxGetEmails(token, email))
+			return normEmailsResult(prov.OpeninboxGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelInboxes,
 		Name:    "Inboxes",
 		Website: "inboxes.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.InboxesGenerate(opts.Domain))
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.InboxesGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.InboxesGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.InboxesGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelUncorreotemporal,
 		Name:    "UnCorreoTemporal",
 		Website: "uncorreotemporal.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.UncorreotemporalGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.UncorreotemporalGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for uncorreotemporal channel")
 			}
-			return normEmailsResult(prov.UncorreotemporalGetEmails(token, email))
+			return normEmailsResult(prov.UncorreotemporalGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelAwamail,
 		Name:    "AwaMail",
 		Website: "awamail.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.AwamailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.AwamailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for awamail channel")
 			}
-			return normEmailsResult(prov.AwamailGetEmails(token, email))
+			return normEmailsResult(prov.AwamailGetEmails(ctx, token, email))
 		},
 	})
 
@@ -1197,1033 +1329,1465 @@ func init() {
 		Channel: ChannelMailTm,
 		Name:    "Mail.tm",
 		Website: "mail.tm",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailTmGenerate())
+		Backend: "mail-tm",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailTmGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for mail-tm channel")
 			}
-			return normEmailsResult(prov.MailTmGetEmails(token, email))
+			return normEmailsResult(prov.MailTmGetEmails(ctx, token, email))
 		},
+		GetRawEmail: func(ctx context.Context, email, token, id string) ([]byte, error) {
+			return prov.MailTmGetSource(ctx, token, id)
+		},
+		DownloadAttachment: func(ctx context.Context, email, token string, att EmailAttachment) (io.ReadCloser, error) {
+			return prov.MailTmDownloadAttachment(ctx, token, att.URL)
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.MailTmGetEmailsSince(ctx, token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailTmListEmails(ctx, token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailTmGetEmail(ctx, token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.MailTmDeleteMessage(ctx, token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.MailTmDeleteAccount(ctx, token)
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart | CapDelete | CapRawSource,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelWebLibraryNet,
 		Name:    "web-library.net",
 		Website: "mail.tm",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			info, err := fromMailbox(prov.MailTmGenerate())
+		Backend: "mail-tm",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			info, err := fromMailbox(prov.MailTmGenerate(ctx))
 			if err != nil {
 				return nil, err
 			}
 			info.Channel = ChannelWebLibraryNet
 			return info, nil
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for web-library-net channel")
 			}
-			return normEmailsResult(prov.MailTmGetEmails(token, email))
+			return normEmailsResult(prov.MailTmGetEmails(ctx, token, email))
+		},
+		GetRawEmail: func(ctx context.Context, email, token, id string) ([]byte, error) {
+			return prov.MailTmGetSource(ctx, token, id)
 		},
+		DownloadAttachment: func(ctx context.Context, email, token string, att EmailAttachment) (io.ReadCloser, error) {
+			return prov.MailTmDownloadAttachment(ctx, token, att.URL)
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.MailTmGetEmailsSince(ctx, token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailTmListEmails(ctx, token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailTmGetEmail(ctx, token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.MailTmDeleteMessage(ctx, token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.MailTmDeleteAccount(ctx, token)
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart | CapDelete | CapRawSource,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDropmail,
 		Name:    "DropMail",
 		Website: "dropmail.me",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.DropmailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.DropmailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for dropmail channel")
 			}
-			return normEmailsResult(prov.DropmailGetEmails(token, email))
+			return normEmailsResult(prov.DropmailGetEmails(ctx, token, email))
+		},
+		GetRawEmail: func(ctx context.Context, email, token, id string) ([]byte, error) {
+			return prov.DropmailGetRawEmail(ctx, token, id)
 		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.DropmailGetEmailsSince(ctx, token, email, cursor))
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.DropmailDeleteAddress(ctx, token, email)
+		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.DropmailExtendSession(ctx, token)
+		},
+		Capabilities: CapHTML | CapDelete | CapExtend | CapRawSource,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGuerrillaMail,
 		Name:    "Guerrilla Mail",
 		Website: "guerrillamail.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillaMailGenerate())
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillaMailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for guerrillamail channel")
 			}
-			return normEmailsResult(prov.GuerrillaMailGetEmails(token, email))
+			return normEmailsResult(prov.GuerrillaMailGetEmails(ctx, token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillaMailGetEmailsSince(ctx, token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillaMailListEmails(ctx, token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillaMailGetEmail(ctx, token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillaMailDeleteEmail(ctx, token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillaMailForgetMe(ctx, token, email)
 		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillaMailExtend(ctx, token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGuerrillamailCom,
 		Name:    "GuerrillaMail Root",
 		Website: "guerrillamail.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("guerrillamail-com", "https://guerrillamail.com/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "guerrillamail-com", "https://guerrillamail.com/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for guerrillamail-com channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://guerrillamail.com/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://guerrillamail.com/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://guerrillamail.com/ajax.php", token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://guerrillamail.com/ajax.php", token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "guerrillamail-com", "https://guerrillamail.com/ajax.php", token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "guerrillamail-com", "https://guerrillamail.com/ajax.php", token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "guerrillamail-com", "https://guerrillamail.com/ajax.php", token, email)
+		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "guerrillamail-com", "https://guerrillamail.com/ajax.php", token)
 		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMaildrop,
 		Name:    "Maildrop",
 		Website: "maildrop.cx",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MaildropGenerate(opts.Domain))
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MaildropGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for maildrop channel")
 			}
-			return normEmailsResult(prov.MaildropGetEmails(token, email))
+			return normEmailsResult(prov.MaildropGetEmails(ctx, token, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MaildropListEmails(ctx, token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MaildropGetEmail(ctx, token, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSmailPw,
 		Name:    "Smail.pw",
 		Website: "smail.pw",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SmailPwGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SmailPwGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for smail-pw channel")
 			}
-			return normEmailsResult(prov.SmailPwGetEmails(token, email))
+			return normEmailsResult(prov.SmailPwGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelVip215,
 		Name:    "VIP 215",
 		Website: "vip.215.im",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailVip215Generate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailVip215Generate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for vip-215 channel")
 			}
-			return normEmailsResult(prov.MailVip215GetEmails(token, email))
+			return normEmailsResult(prov.MailVip215GetEmails(ctx, token, email))
 		},
+		Subscribe: func(ctx context.Context, email, token string) (<-chan struct{}, func(), error) {
+			return prov.MailVip215Subscribe(ctx, token, email)
+		},
+		Capabilities: CapHTML | CapPush,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelFakeLegal,
 		Name:    "Fake Legal",
 		Website: "fake.legal",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.FakeLegalGenerate(opts.Domain))
+		Backend: "fake-legal",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.FakeLegalGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.FakeLegalGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.FakeLegalGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelImguiDe,
 		Name:    "imgui.de",
 		Website: "fake.legal",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.FakeLegalGenerate(fixedDomain("imgui.de"), string(ChannelImguiDe)))
+		Backend: "fake-legal",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.FakeLegalGenerate(ctx, fixedDomain("imgui.de"), string(ChannelImguiDe)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.FakeLegalGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.FakeLegalGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelPulsewebmenuDe,
 		Name:    "pulsewebmenu.de",
 		Website: "fake.legal",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.FakeLegalGenerate(fixedDomain("pulsewebmenu.de"), string(ChannelPulsewebmenuDe)))
+		Backend: "fake-legal",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.FakeLegalGenerate(ctx, fixedDomain("pulsewebmenu.de"), string(ChannelPulsewebmenuDe)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.FakeLegalGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.FakeLegalGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMoakt,
 		Name:    "Moakt",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MoaktGenerate(opts.Domain))
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MoaktGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for moakt channel")
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDrmailIn,
 		Name:    "drmail.in",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("drmail.in", ChannelDrmailIn)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "drmail.in", ChannelDrmailIn)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelDrmailIn)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTemlNet,
 		Name:    "teml.net",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("teml.net", ChannelTemlNet)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "teml.net", ChannelTemlNet)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelTemlNet)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTmpemlCom,
 		Name:    "tmpeml.com",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("tmpeml.com", ChannelTmpemlCom)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "tmpeml.com", ChannelTmpemlCom)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelTmpemlCom)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTmpboxNet,
 		Name:    "tmpbox.net",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("tmpbox.net", ChannelTmpboxNet)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "tmpbox.net", ChannelTmpboxNet)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelTmpboxNet)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMoaktCc,
 		Name:    "moakt.cc",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("moakt.cc", ChannelMoaktCc)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "moakt.cc", ChannelMoaktCc)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelMoaktCc)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDisboxNet,
 		Name:    "disbox.net",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("disbox.net", ChannelDisboxNet)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "disbox.net", ChannelDisboxNet)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelDisboxNet)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTmpmailOrg,
 		Name:    "tmpmail.org",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("tmpmail.org", ChannelTmpmailOrg)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "tmpmail.org", ChannelTmpmailOrg)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelTmpmailOrg)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTmpmailNet,
 		Name:    "tmpmail.net",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("tmpmail.net", ChannelTmpmailNet)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "tmpmail.net", ChannelTmpmailNet)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelTmpmailNet)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTmailsNet,
 		Name:    "tmails.net",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("tmails.net", ChannelTmailsNet)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "tmails.net", ChannelTmailsNet)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelTmailsNet)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDisboxOrg,
 		Name:    "disbox.org",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("disbox.org", ChannelDisboxOrg)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "disbox.org", ChannelDisboxOrg)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelDisboxOrg)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMoaktCo,
 		Name:    "moakt.co",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("moakt.co", ChannelMoaktCo)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "moakt.co", ChannelMoaktCo)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelMoaktCo)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMoaktWs,
 		Name:    "moakt.ws",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("moakt.ws", ChannelMoaktWs)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "moakt.ws", ChannelMoaktWs)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelMoaktWs)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTmailWs,
 		Name:    "tmail.ws",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("tmail.ws", ChannelTmailWs)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "tmail.ws", ChannelTmailWs)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelTmailWs)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelBareedWs,
 		Name:    "bareed.ws",
 		Website: "moakt.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return genMoaktVariant("bareed.ws", ChannelBareedWs)
+		Backend: "moakt",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return genMoaktVariant(ctx, "bareed.ws", ChannelBareedWs)
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelBareedWs)
 			}
-			return normEmailsResult(prov.MoaktGetEmails(email, token))
+			return normEmailsResult(prov.MoaktGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelEmail10min,
 		Name:    "Email10Min",
 		Website: "email10min.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Email10minGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Email10minGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for email10min channel")
 			}
-			return normEmailsResult(prov.Email10minGetEmails(email, token))
+			return normEmailsResult(prov.Email10minGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMjjCm,
 		Name:    "MJJ Mail",
 		Website: "mjj.cm",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MjjCmGenerate())
+		Backend: "socketio-mail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MjjCmGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MjjCmGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MjjCmGetEmails(email))
+		Subscribe: func(ctx context.Context, email, token string) (<-chan struct{}, func(), error) {
+			return prov.MjjCmSubscribe(ctx, email)
 		},
+		Capabilities: CapHTML | CapPush,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelLinshiCo,
 		Name:    "临时Co",
 		Website: "linshi.co",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.LinshiCoGenerate())
+		Backend: "socketio-mail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.LinshiCoGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.LinshiCoGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.LinshiCoGetEmails(ctx, email))
 		},
+		Subscribe: func(ctx context.Context, email, token string) (<-chan struct{}, func(), error) {
+			return prov.LinshiCoSubscribe(ctx, email)
+		},
+		Capabilities: CapHTML | CapPush,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelHarakirimail,
 		Name:    "HarakiriMail",
 		Website: "harakirimail.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.HarakirimailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.HarakirimailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.HarakirimailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.HarakirimailGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelJqkjqkXyz,
 		Name:    "jqkjqk.xyz",
 		Website: "mail.zhujump.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ZhujumpGenerate("jqkjqk.xyz", string(ChannelJqkjqkXyz)))
+		Backend: "zhujump",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ZhujumpGenerate(ctx, "jqkjqk.xyz", string(ChannelJqkjqkXyz)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelJqkjqkXyz)
 			}
-			return normEmailsResult(prov.ZhujumpGetEmails(email, token))
+			return normEmailsResult(prov.ZhujumpGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelLyhleviCom,
 		Name:    "LyhLevi MoeMail",
 		Website: "lyhlevi.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MoemailGenerate("https://lyhlevi.com", "lyhlevi.com", string(ChannelLyhleviCom), 24*60*60*1000))
+		Backend: "zhujump",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MoemailGenerate(ctx, "https://lyhlevi.com", "lyhlevi.com", string(ChannelLyhleviCom), 24*60*60*1000))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for %s channel", ChannelLyhleviCom)
 			}
-			return normEmailsResult(prov.ZhujumpGetEmails(email, token))
+			return normEmailsResult(prov.ZhujumpGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempmailPlus,
 		Name:    "TempMail Plus",
 		Website: "tempmail.plus",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailPlusGenerate(opts.Domain))
+		Backend: "tempmail-plus",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailPlusGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailPlusGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailPlusGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelFexpostCom,
 		Name:    "fexpost.com",
 		Website: "tempmail.plus",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailPlusGenerate(fixedDomain("fexpost.com"), string(ChannelFexpostCom)))
+		Backend: "tempmail-plus",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailPlusGenerate(ctx, fixedDomain("fexpost.com"), string(ChannelFexpostCom)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailPlusGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailPlusGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelFexboxOrg,
 		Name:    "fexbox.org",
 		Website: "tempmail.plus",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailPlusGenerate(fixedDomain("fexbox.org"), string(ChannelFexboxOrg)))
+		Backend: "tempmail-plus",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailPlusGenerate(ctx, fixedDomain("fexbox.org"), string(ChannelFexboxOrg)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailPlusGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailPlusGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailboxInUa,
 		Name:    "mailbox.in.ua",
 		Website: "tempmail.plus",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailPlusGenerate(fixedDomain("mailbox.in.ua"), string(ChannelMailboxInUa)))
+		Backend: "tempmail-plus",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailPlusGenerate(ctx, fixedDomain("mailbox.in.ua"), string(ChannelMailboxInUa)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailPlusGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailPlusGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelRoverInfo,
 		Name:    "rover.info",
 		Website: "tempmail.plus",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailPlusGenerate(fixedDomain("rover.info"), string(ChannelRoverInfo)))
+		Backend: "tempmail-plus",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailPlusGenerate(ctx, fixedDomain("rover.info"), string(ChannelRoverInfo)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailPlusGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailPlusGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelChitthiIn,
 		Name:    "chitthi.in",
 		Website: "tempmail.plus",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailPlusGenerate(fixedDomain("chitthi.in"), string(ChannelChitthiIn)))
+		Backend: "tempmail-plus",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailPlusGenerate(ctx, fixedDomain("chitthi.in"), string(ChannelChitthiIn)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailPlusGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailPlusGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelFextempCom,
 		Name:    "fextemp.com",
 		Website: "tempmail.plus",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailPlusGenerate(fixedDomain("fextemp.com"), string(ChannelFextempCom)))
+		Backend: "tempmail-plus",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailPlusGenerate(ctx, fixedDomain("fextemp.com"), string(ChannelFextempCom)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailPlusGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailPlusGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelAnyPink,
 		Name:    "any.pink",
 		Website: "tempmail.plus",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailPlusGenerate(fixedDomain("any.pink"), string(ChannelAnyPink)))
+		Backend: "tempmail-plus",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailPlusGenerate(ctx, fixedDomain("any.pink"), string(ChannelAnyPink)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailPlusGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailPlusGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMerepostCom,
 		Name:    "merepost.com",
 		Website: "tempmail.plus",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailPlusGenerate(fixedDomain("merepost.com"), string(ChannelMerepostCom)))
+		Backend: "tempmail-plus",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailPlusGenerate(ctx, fixedDomain("merepost.com"), string(ChannelMerepostCom)))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempmailPlusGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempmailPlusGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempmailLolV2,
 		Name:    "TempMail LOL V2",
 		Website: "tempmail.lol",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailLolV2Generate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailLolV2Generate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempmail-lol-v2 channel")
 			}
-			return normEmailsResult(prov.TempmailLolV2GetEmails(token, email))
+			return normEmailsResult(prov.TempmailLolV2GetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempgbox,
 		Name:    "TempGBox",
 		Website: "tempgbox.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempgboxGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempgboxGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempgbox channel")
 			}
-			return normEmailsResult(prov.TempgboxGetEmails(token, email))
+			return normEmailsResult(prov.TempgboxGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelEmailnator,
 		Name:    "Emailnator",
 		Website: "emailnator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.EmailnatorGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.EmailnatorGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for emailnator channel")
 			}
-			return normEmailsResult(prov.EmailnatorGetEmails(token, email))
+			return normEmailsResult(prov.EmailnatorGetEmails(ctx, token, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.EmailnatorListEmails(ctx, token, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.EmailnatorGetEmail(ctx, token, email, id))
+		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTemporam,
 		Name:    "Temporam",
 		Website: "temporam.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TemporamGenerate(opts.Domain))
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TemporamGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TemporamGetEmails(token, email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TemporamGetEmails(ctx, token, email))
 		},
+		Capabilities: CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelNeighbours,
 		Name:    "Neighbours",
 		Website: "neighbours.sh",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.NeighboursGenerate(opts.Domain))
+		Backend: "neighbours",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.NeighboursGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.NeighboursGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.NeighboursGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSharklasers,
 		Name:    "SharkLasers",
 		Website: "sharklasers.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("sharklasers", "https://www.sharklasers.com/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "sharklasers", "https://www.sharklasers.com/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for sharklasers channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://www.sharklasers.com/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://www.sharklasers.com/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://www.sharklasers.com/ajax.php", token, email, cursor))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://www.sharklasers.com/ajax.php", token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "sharklasers", "https://www.sharklasers.com/ajax.php", token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "sharklasers", "https://www.sharklasers.com/ajax.php", token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "sharklasers", "https://www.sharklasers.com/ajax.php", token, email)
+		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "sharklasers", "https://www.sharklasers.com/ajax.php", token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSharklasersCom,
 		Name:    "SharkLasers Root",
 		Website: "sharklasers.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("sharklasers-com", "https://sharklasers.com/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "sharklasers-com", "https://sharklasers.com/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for sharklasers-com channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://sharklasers.com/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://sharklasers.com/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://sharklasers.com/ajax.php", token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://sharklasers.com/ajax.php", token, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "sharklasers-com", "https://sharklasers.com/ajax.php", token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "sharklasers-com", "https://sharklasers.com/ajax.php", token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "sharklasers-com", "https://sharklasers.com/ajax.php", token, email)
+		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "sharklasers-com", "https://sharklasers.com/ajax.php", token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGrrLa,
 		Name:    "Grr.la",
 		Website: "grr.la",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("grr-la", "https://www.grr.la/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "grr-la", "https://www.grr.la/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for grr-la channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://www.grr.la/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://www.grr.la/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://www.grr.la/ajax.php", token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://www.grr.la/ajax.php", token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "grr-la", "https://www.grr.la/ajax.php", token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "grr-la", "https://www.grr.la/ajax.php", token, id)
 		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "grr-la", "https://www.grr.la/ajax.php", token, email)
+		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "grr-la", "https://www.grr.la/ajax.php", token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGrrLaCom,
 		Name:    "Grr.la Root",
 		Website: "grr.la",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("grr-la-com", "https://grr.la/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "grr-la-com", "https://grr.la/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for grr-la-com channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://grr.la/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://grr.la/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://grr.la/ajax.php", token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://grr.la/ajax.php", token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "grr-la-com", "https://grr.la/ajax.php", token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "grr-la-com", "https://grr.la/ajax.php", token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "grr-la-com", "https://grr.la/ajax.php", token, email)
 		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "grr-la-com", "https://grr.la/ajax.php", token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGuerrillamailInfo,
 		Name:    "GuerrillaMail Info",
 		Website: "guerrillamail.info",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("guerrillamail-info", "https://www.guerrillamail.info/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "guerrillamail-info", "https://www.guerrillamail.info/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for guerrillamail-info channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://www.guerrillamail.info/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://www.guerrillamail.info/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://www.guerrillamail.info/ajax.php", token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://www.guerrillamail.info/ajax.php", token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "guerrillamail-info", "https://www.guerrillamail.info/ajax.php", token, email, id))
 		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "guerrillamail-info", "https://www.guerrillamail.info/ajax.php", token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "guerrillamail-info", "https://www.guerrillamail.info/ajax.php", token, email)
+		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "guerrillamail-info", "https://www.guerrillamail.info/ajax.php", token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpam4me,
 		Name:    "Spam4.me",
 		Website: "spam4.me",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("spam4me", "https://www.spam4.me/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "spam4me", "https://www.spam4.me/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for spam4me channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://www.spam4.me/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://www.spam4.me/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://www.spam4.me/ajax.php", token, email, cursor))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://www.spam4.me/ajax.php", token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "spam4me", "https://www.spam4.me/ajax.php", token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "spam4me", "https://www.spam4.me/ajax.php", token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "spam4me", "https://www.spam4.me/ajax.php", token, email)
+		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "spam4me", "https://www.spam4.me/ajax.php", token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGuerrillamailNet,
 		Name:    "GuerrillaMail Net",
 		Website: "guerrillamail.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("guerrillamail-net", "https://www.guerrillamail.net/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "guerrillamail-net", "https://www.guerrillamail.net/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for guerrillamail-net channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://www.guerrillamail.net/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://www.guerrillamail.net/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://www.guerrillamail.net/ajax.php", token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://www.guerrillamail.net/ajax.php", token, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "guerrillamail-net", "https://www.guerrillamail.net/ajax.php", token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "guerrillamail-net", "https://www.guerrillamail.net/ajax.php", token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "guerrillamail-net", "https://www.guerrillamail.net/ajax.php", token, email)
+		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "guerrillamail-net", "https://www.guerrillamail.net/ajax.php", token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGuerrillamailOrg,
 		Name:    "GuerrillaMail Org",
 		Website: "guerrillamail.org",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("guerrillamail-org", "https://www.guerrillamail.org/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "guerrillamail-org", "https://www.guerrillamail.org/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for guerrillamail-org channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://www.guerrillamail.org/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://www.guerrillamail.org/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://www.guerrillamail.org/ajax.php", token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://www.guerrillamail.org/ajax.php", token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "guerrillamail-org", "https://www.guerrillamail.org/ajax.php", token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "guerrillamail-org", "https://www.guerrillamail.org/ajax.php", token, id)
 		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "guerrillamail-org", "https://www.guerrillamail.org/ajax.php", token, email)
+		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "guerrillamail-org", "https://www.guerrillamail.org/ajax.php", token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGuerrillamailBlock,
 		Name:    "GuerrillaMailBlock",
 		Website: "guerrillamailblock.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("guerrillamailblock", "https://www.guerrillamailblock.com/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "guerrillamailblock", "https://www.guerrillamailblock.com/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for guerrillamailblock channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://www.guerrillamailblock.com/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://www.guerrillamailblock.com/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://www.guerrillamailblock.com/ajax.php", token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://www.guerrillamailblock.com/ajax.php", token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "guerrillamailblock", "https://www.guerrillamailblock.com/ajax.php", token, email, id))
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "guerrillamailblock", "https://www.guerrillamailblock.com/ajax.php", token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "guerrillamailblock", "https://www.guerrillamailblock.com/ajax.php", token, email)
 		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "guerrillamailblock", "https://www.guerrillamailblock.com/ajax.php", token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGuerrillamailComWww,
 		Name:    "GuerrillaMail WWW",
 		Website: "guerrillamail.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GuerrillamailMirrorGenerate("guerrillamail-com-www", "https://www.guerrillamail.com/ajax.php"))
+		Backend: "guerrillamail",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GuerrillamailMirrorGenerate(ctx, "guerrillamail-com-www", "https://www.guerrillamail.com/ajax.php"))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for guerrillamail-com-www channel")
 			}
-			return normEmailsResult(prov.GuerrillamailMirrorGetEmails("https://www.guerrillamail.com/ajax.php", token, email))
+			return normEmailsResult(prov.GuerrillamailMirrorGetEmails(ctx, "https://www.guerrillamail.com/ajax.php", token, email))
+		},
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			return normEmailsSinceResult(prov.GuerrillamailMirrorGetEmailsSince(ctx, "https://www.guerrillamail.com/ajax.php", token, email, cursor))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GuerrillamailMirrorListEmails(ctx, "https://www.guerrillamail.com/ajax.php", token, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.GuerrillamailMirrorGetEmail(ctx, "guerrillamail-com-www", "https://www.guerrillamail.com/ajax.php", token, email, id))
 		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.GuerrillamailMirrorDeleteEmail(ctx, "guerrillamail-com-www", "https://www.guerrillamail.com/ajax.php", token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.GuerrillamailMirrorForgetMe(ctx, "guerrillamail-com-www", "https://www.guerrillamail.com/ajax.php", token, email)
+		},
+		Extend: func(ctx context.Context, email, token string, d time.Duration) (time.Time, error) {
+			return prov.GuerrillamailMirrorExtend(ctx, "guerrillamail-com-www", "https://www.guerrillamail.com/ajax.php", token)
+		},
+		Capabilities: CapHTML | CapCustomLocalPart | CapDelete | CapExtend,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelM2u,
 		Name:    "MailToYou",
 		Website: "m2u.io",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.M2uGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.M2uGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for m2u channel")
 			}
-			return normEmailsResult(prov.M2uGetEmails(token, email))
+			return normEmailsResult(prov.M2uGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempyEmail,
 		Name:    "Tempy Email",
 		Website: "tempy.email",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempyEmailGenerate(opts.Domain))
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempyEmailGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempyEmailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempyEmailGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelFmail,
 		Name:    "FMail",
 		Website: "fmail.men",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.FmailGenerate(opts.Domain))
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.FmailGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.FmailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.FmailGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelOckito,
 		Name:    "Ockito",
 		Website: "ockito.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.OckitoGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.OckitoGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for ockito channel")
 			}
-			return normEmailsResult(prov.OckitoGetEmails(token, email))
+			return normEmailsResult(prov.OckitoGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelAnonbox,
 		Name:    "Anonbox",
 		Website: "anonbox.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.AnonboxGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.AnonboxGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for anonbox channel")
 			}
-			return normEmailsResult(prov.AnonboxGetEmails(token, email))
+			return normEmailsResult(prov.AnonboxGetEmails(ctx, token, email))
+		},
+		GetRawEmail: func(ctx context.Context, email, token, id string) ([]byte, error) {
+			return prov.AnonboxGetRawEmail(ctx, token, email, id)
 		},
+		Capabilities: CapHTML | CapAttachments | CapRawSource,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDuckmail,
 		Name:    "DuckMail",
 		Website: "duckmail.sbs",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.DuckmailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.DuckmailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for duckmail channel")
 			}
-			return normEmailsResult(prov.DuckmailGetEmails(token, email))
+			return normEmailsResult(prov.DuckmailGetEmails(ctx, token, email))
 		},
+		GetRawEmail: func(ctx context.Context, email, token, id string) ([]byte, error) {
+			return prov.DuckmailGetSource(ctx, token, id)
+		},
+		DownloadAttachment: func(ctx context.Context, email, token string, att EmailAttachment) (io.ReadCloser, error) {
+			return prov.DuckmailDownloadAttachment(ctx, token, att.URL)
+		},
+		DeleteEmail: func(ctx context.Context, email, token, id string) error {
+			return prov.DuckmailDeleteMessage(ctx, token, id)
+		},
+		Destroy: func(ctx context.Context, email, token string) error {
+			return prov.DuckmailDeleteAccount(ctx, token)
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart | CapDelete | CapRawSource,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailinator,
 		Name:    "Mailinator",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailinatorGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailinatorGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailinatorGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempmail365,
 		Name:    "Tempmail365",
 		Website: "https://tempmail365.cn",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Tempmail365Generate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Tempmail365Generate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.Tempmail365GetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.Tempmail365GetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempinbox,
 		Name:    "TempInbox",
 		Website: "https://www.tempinbox.xyz",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempinboxGenerate(opts.Domain))
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempinboxGenerate(ctx, opts.Domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TempinboxGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TempinboxGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapDomainChoice,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelByom,
 		Name:    "Byom",
 		Website: "byom.de",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ByomGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ByomGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.ByomGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.ByomGetEmails(ctx, email))
 		},
+		Capabilities: CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelAnonymmail,
 		Name:    "AnonymMail",
 		Website: "anonymmail.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.AnonymmailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.AnonymmailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.AnonymmailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.AnonymmailGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelEyepaste,
 		Name:    "EyePaste",
 		Website: "eyepaste.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.EyepasteGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.EyepasteGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.EyepasteGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.EyepasteGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailSunls,
 		Name:    "Mail Sunls",
 		Website: "mail.sunls.de",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailSunlsGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailSunlsGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailSunlsGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailSunlsGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelExpressinboxhub,
 		Name:    "ExpressInboxHub",
 		Website: "expressinboxhub.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ExpressinboxhubGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ExpressinboxhubGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for expressinboxhub channel")
 			}
-			return normEmailsResult(prov.ExpressinboxhubGetEmails(email, token))
+			return normEmailsResult(prov.ExpressinboxhubGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelLroid,
 		Name:    "Lroid",
 		Website: "lroid.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.LroidGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.LroidGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for lroid channel")
 			}
-			return normEmailsResult(prov.LroidGetEmails(token, email))
+			return normEmailsResult(prov.LroidGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelHaribu,
 		Name:    "Haribu",
 		Website: "haribu.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.HaribuGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.HaribuGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for haribu channel")
 			}
-			return normEmailsResult(prov.HaribuGetEmails(email, token))
+			return normEmailsResult(prov.HaribuGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelRootsh,
 		Name:    "Rootsh(BccTo)",
 		Website: "rootsh.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.RootshGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.RootshGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for rootsh channel")
 			}
-			return normEmailsResult(prov.RootshGetEmails(email, token))
+			return normEmailsResult(prov.RootshGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelFakeEmailSite,
 		Name:    "FakeEmailSite",
 		Website: "fake-email.site",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.FakeEmailSiteGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.FakeEmailSiteGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.FakeEmailSiteGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.FakeEmailSiteGetEmails(ctx, email))
 		},
 	})
 
@@ -2231,44 +2795,46 @@ func init() {
 		Channel: ChannelMohmal,
 		Name:    "Mohmal",
 		Website: "mohmal.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MohmalGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MohmalGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for mohmal channel")
 			}
-			return normEmailsResult(prov.MohmalGetEmails(email, token))
+			return normEmailsResult(prov.MohmalGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailgolem,
 		Name:    "MailGolem",
 		Website: "mailgolem.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailgolemGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailgolemGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for mailgolem channel")
 			}
-			return normEmailsResult(prov.MailgolemGetEmails(email, token))
+			return normEmailsResult(prov.MailgolemGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelBestTempMail,
 		Name:    "BestTempMail",
 		Website: "best-temp-mail.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.BestTempMailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.BestTempMailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for best-temp-mail channel")
 			}
-			return normEmailsResult(prov.BestTempMailGetEmails(email, token))
+			return normEmailsResult(prov.BestTempMailGetEmails(ctx, email, token))
 		},
 	})
 
@@ -2276,14 +2842,14 @@ func init() {
 		Channel: ChannelDisposablemailApp,
 		Name:    "DisposableMail",
 		Website: "disposablemail.app",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.DisposablemailAppGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.DisposablemailAppGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for disposablemail-app channel")
 			}
-			return normEmailsResult(prov.DisposablemailAppGetEmails(email, token))
+			return normEmailsResult(prov.DisposablemailAppGetEmails(ctx, email, token))
 		},
 	})
 
@@ -2291,329 +2857,351 @@ func init() {
 		Channel: ChannelMailtempCc,
 		Name:    "MailTemp.cc",
 		Website: "mailtemp.cc",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailtempCcGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailtempCcGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for mailtemp-cc channel")
 			}
-			return normEmailsResult(prov.MailtempCcGetEmails(email, token))
+			return normEmailsResult(prov.MailtempCcGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMinuteinbox,
 		Name:    "MinuteInbox",
 		Website: "minuteinbox.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MinuteinboxGenerate(opts.Duration, ""))
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MinuteinboxGenerate(ctx, opts.Duration, ""))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for minuteinbox channel")
 			}
-			return normEmailsResult(prov.MinuteinboxGetEmails(token, email))
+			return normEmailsResult(prov.MinuteinboxGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapTTLControl,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailcatch,
 		Name:    "MailCatch",
 		Website: "mailcatch.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailcatchGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailcatchGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailcatchGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailcatchGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempemailCo,
 		Name:    "TempEmail.co",
 		Website: "tempemail.co",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempemailCoGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempemailCoGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempemail-co channel")
 			}
-			return normEmailsResult(prov.TempemailCoGetEmails(email, token))
+			return normEmailsResult(prov.TempemailCoGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempemailsNet,
 		Name:    "TempEmails.net",
 		Website: "tempemails.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempemailsNetGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempemailsNetGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempemails-net channel")
 			}
-			return normEmailsResult(prov.TempemailsNetGetEmails(email, token))
+			return normEmailsResult(prov.TempemailsNetGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelAltmails,
 		Name:    "AltMails",
 		Website: "tempmail.altmails.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.AltmailsGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.AltmailsGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for altmails channel")
 			}
-			return normEmailsResult(prov.AltmailsGetEmails(email, token))
+			return normEmailsResult(prov.AltmailsGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempemailInfo,
 		Name:    "TempEmailInfo",
 		Website: "tempemail.info",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempemailInfoGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempemailInfoGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempemail-info channel")
 			}
-			return normEmailsResult(prov.TempemailInfoGetEmails(email, token))
+			return normEmailsResult(prov.TempemailInfoGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSmailpro,
 		Name:    "SmailPro",
 		Website: "smailpro.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SmailproGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SmailproGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SmailproGetEmails(email, token))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SmailproGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempmailten,
 		Name:    "TempMailTen",
 		Website: "tempmailten.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempmailtenGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempmailtenGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempmailten channel")
 			}
-			return normEmailsResult(prov.TempmailtenGetEmails(email, token))
+			return normEmailsResult(prov.TempmailtenGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMaildropCc,
 		Name:    "MailDrop.cc",
 		Website: "maildrop.cc",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MaildropCcGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MaildropCcGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MaildropCcGetEmails(email, token))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MaildropCcGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTenminutemailNet,
 		Name:    "10MinuteMail.net",
 		Website: "10minutemail.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TenminutemailNetGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TenminutemailNetGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for 10minutemail-net channel")
 			}
-			return normEmailsResult(prov.TenminutemailNetGetEmails(email, token))
+			return normEmailsResult(prov.TenminutemailNetGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelLinshiyouxiangNet,
 		Name:    "临时邮箱(linshiyouxiang)",
 		Website: "linshiyouxiang.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.LinshiyouxiangNetGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.LinshiyouxiangNetGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for linshiyouxiang-net channel")
 			}
-			return normEmailsResult(prov.LinshiyouxiangNetGetEmails(email, token))
+			return normEmailsResult(prov.LinshiyouxiangNetGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempMailFyi,
 		Name:    "Temp-Mail.fyi",
 		Website: "temp-mail.fyi",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempMailFyiGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempMailFyiGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempmail-fyi channel")
 			}
-			return normEmailsResult(prov.TempMailFyiGetEmails(email, token))
+			return normEmailsResult(prov.TempMailFyiGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDisposablemailCom,
 		Name:    "DisposableMail.com",
 		Website: "disposablemail.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.DisposablemailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.DisposablemailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for disposablemail-com channel")
 			}
-			return normEmailsResult(prov.DisposablemailGetEmails(email, token))
+			return normEmailsResult(prov.DisposablemailGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTemppMails,
 		Name:    "TemppMails",
 		Website: "tempp-mails.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TemppMailsGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TemppMailsGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempp-mails channel")
 			}
-			return normEmailsResult(prov.TemppMailsGetEmails(email, token))
+			return normEmailsResult(prov.TemppMailsGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelEmailtempOrg,
 		Name:    "EmailTemp",
 		Website: "emailtemp.org",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.EmailtempOrgGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.EmailtempOrgGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for emailtemp-org channel")
 			}
-			return normEmailsResult(prov.EmailtempOrgGetEmails(email, token))
+			return normEmailsResult(prov.EmailtempOrgGetEmails(ctx, email, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMytempmailCc,
 		Name:    "MyTempMail.cc",
 		Website: "mytempmail.cc",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MytempmailCcGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MytempmailCcGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for mytempmail-cc channel")
 			}
-			return normEmailsResult(prov.MytempmailCcGetEmails(token, email))
+			return normEmailsResult(prov.MytempmailCcGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempMailNow,
 		Name:    "TempMailNow",
 		Website: "temp-mail.now",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempMailNowGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempMailNowGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for temp-mail-now channel")
 			}
-			return normEmailsResult(prov.TempMailNowGetEmails(token, email))
+			return normEmailsResult(prov.TempMailNowGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailTd,
 		Name:    "Mail.td",
 		Website: "mail.td",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailTdGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailTdGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for mail-td channel")
 			}
-			return normEmailsResult(prov.MailTdGetEmails(token, email))
+			return normEmailsResult(prov.MailTdGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailholeDe,
 		Name:    "Mailhole.de",
 		Website: "mailhole.de",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailholeDeGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailholeDeGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for mailhole-de channel")
 			}
-			return normEmailsResult(prov.MailholeDeGetEmails(token, email))
+			return normEmailsResult(prov.MailholeDeGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTmailLink,
 		Name:    "TMail.link",
 		Website: "tmail.link",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TmailLinkGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TmailLinkGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tmail-link channel")
 			}
-			return normEmailsResult(prov.TmailLinkGetEmails(token, email))
+			return normEmailsResult(prov.TmailLinkGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: Channel24mailChacuo,
 		Name:    "24Mail Chacuo",
 		Website: "24mail.chacuo.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TwentyfourmailChacuoGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TwentyfourmailChacuoGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TwentyfourmailChacuoGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TwentyfourmailChacuoGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelNimail,
 		Name:    "NiMail",
 		Website: "nimail.cn",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.NimailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.NimailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.NimailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.NimailGetEmails(ctx, email))
 		},
 	})
 
@@ -2621,1103 +3209,1682 @@ func init() {
 		Channel: ChannelFreecustom,
 		Name:    "FreeCustom.Email",
 		Website: "freecustom.email",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.FreecustomGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.FreecustomGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.FreecustomGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.FreecustomGetEmails(email))
+		DownloadAttachment: func(ctx context.Context, email, token string, att EmailAttachment) (io.ReadCloser, error) {
+			return prov.FreecustomDownloadAttachment(ctx, att.URL)
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelN16888888Cyou,
 		Name:    "Mailmomy (16888888.cyou)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.N16888888CyouGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.N16888888CyouGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.N16888888CyouGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.N16888888CyouGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelN17666688Shop,
 		Name:    "Mailmomy (17666688.shop)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.N17666688ShopGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.N17666688ShopGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.N17666688ShopGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.N17666688ShopGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelN282mailCom,
 		Name:    "Mailmomy (282mail.com)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.N282mailComGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.N282mailComGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.N282mailComGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.N282mailComGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelBlackholeDjurbySe,
 		Name:    "Mailinator (blackhole.djurby.se)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.BlackholeDjurbySeGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.BlackholeDjurbySeGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.BlackholeDjurbySeGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.BlackholeDjurbySeGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelBlockBdeaCc,
 		Name:    "Mailinator (block.bdea.cc)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.BlockBdeaCcGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.BlockBdeaCcGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.BlockBdeaCcGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.BlockBdeaCcGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelBsdu32Buzz,
 		Name:    "Mailmomy (bsdu32.buzz)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Bsdu32BuzzGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Bsdu32BuzzGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.Bsdu32BuzzGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.Bsdu32BuzzGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelBSmellyCc,
 		Name:    "Mailinator (b.smelly.cc)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.BSmellyCcGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.BSmellyCcGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.BSmellyCcGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.BSmellyCcGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelCarlton183ChangeipNet,
 		Name:    "Mailinator (183carlton.changeip.net)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Carlton183ChangeipNetGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Carlton183ChangeipNetGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.Carlton183ChangeipNetGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.Carlton183ChangeipNetGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDeaSoonIt,
 		Name:    "Mailinator (dea.soon.it)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.DeaSoonItGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.DeaSoonItGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.DeaSoonItGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.DeaSoonItGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDisposableAlSudaniCom,
 		Name:    "Mailinator (disposable.al-sudani.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.DisposableAlSudaniComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.DisposableAlSudaniComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.DisposableAlSudaniComGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.DisposableAlSudaniComGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDisposableNogonadNl,
 		Name:    "Mailinator (disposable.nogonad.nl)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.DisposableNogonadNlGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.DisposableNogonadNlGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.DisposableNogonadNlGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.DisposableNogonadNlGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDoxu243Buzz,
 		Name:    "Mailmomy (doxu243.buzz)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Doxu243BuzzGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Doxu243BuzzGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.Doxu243BuzzGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.Doxu243BuzzGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelEasymePro,
 		Name:    "Mailmomy (easyme.pro)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.EasymeProGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.EasymeProGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.EasymeProGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.EasymeProGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelEbsComAr,
 		Name:    "Mailinator (ebs.com.ar)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.EbsComArGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.EbsComArGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.EbsComArGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.EbsComArGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelEtgdevDe,
 		Name:    "Mailinator (etgdev.de)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.EtgdevDeGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.EtgdevDeGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.EtgdevDeGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.EtgdevDeGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelEvergreencoShop,
 		Name:    "Mailmomy (evergreenco.shop)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.EvergreencoShopGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.EvergreencoShopGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.EvergreencoShopGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.EvergreencoShopGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelFwd2mEszettEs,
 		Name:    "Mailinator (fwd2m.eszett.es)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Fwd2mEszettEsGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Fwd2mEszettEsGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.Fwd2mEszettEsGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.Fwd2mEszettEsGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelJamaTrenetEu,
 		Name:    "Mailinator (jama.trenet.eu)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.JamaTrenetEuGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.JamaTrenetEuGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.JamaTrenetEuGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.JamaTrenetEuGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelJFairuseOrg,
 		Name:    "Mailinator (j.fairuse.org)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.JFairuseOrgGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.JFairuseOrgGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.JFairuseOrgGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.JFairuseOrgGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelLayuemingPics,
 		Name:    "Mailmomy (layueming.pics)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.LayuemingPicsGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.LayuemingPicsGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.LayuemingPicsGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.LayuemingPicsGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelM887At,
 		Name:    "Mailinator (m.887.at)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.M887AtGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.M887AtGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.M887AtGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.M887AtGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelM8rDavidfuhrDe,
 		Name:    "Mailinator (m8r.davidfuhr.de)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.M8rDavidfuhrDeGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.M8rDavidfuhrDeGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.M8rDavidfuhrDeGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.M8rDavidfuhrDeGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelM8rMcasalCom,
 		Name:    "Mailinator (m8r.mcasal.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.M8rMcasalComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.M8rMcasalComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.M8rMcasalComGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.M8rMcasalComGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailBentraskCom,
 		Name:    "Mailinator (mail.bentrask.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailBentraskComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailBentraskComGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailBentraskComGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailBentraskComGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailFsmashOrg,
 		Name:    "Mailinator (mail.fsmash.org)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailFsmashOrgGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailFsmashOrgGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailFsmashOrgGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailFsmashOrgGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailinatorzzmoooCom,
 		Name:    "Mailinator (mailinatorzz.mooo.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailinatorzzmoooComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailinatorzzmoooComGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailinatorzzmoooComGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorzzmoooComGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMiMeonBe,
 		Name:    "Mailinator (mi.meon.be)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MiMeonBeGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MiMeonBeGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MiMeonBeGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MiMeonBeGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMingyuekejiOnline,
 		Name:    "Mailmomy (mingyuekeji.online)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MingyuekejiOnlineGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MingyuekejiOnlineGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MingyuekejiOnlineGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MingyuekejiOnlineGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMingyuemingClick,
 		Name:    "Mailmomy (mingyueming.click)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MingyuemingClickGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MingyuemingClickGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MingyuemingClickGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MingyuemingClickGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMingyuemingShop,
 		Name:    "Mailmomy (mingyueming.shop)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MingyuemingShopGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MingyuemingShopGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MingyuemingShopGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MingyuemingShopGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMingyukejiLol,
 		Name:    "Mailmomy (mingyukeji.lol)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MingyukejiLolGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MingyukejiLolGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MingyukejiLolGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MingyukejiLolGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMnCurppaCom,
 		Name:    "Mailinator (mn.curppa.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MnCurppaComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MnCurppaComGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MnCurppaComGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MnCurppaComGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMNikMe,
 		Name:    "Mailinator (m.nik.me)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MNikMeGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MNikMeGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MNikMeGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MNikMeGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMtmdevCom,
 		Name:    "Mailinator (mtmdev.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MtmdevComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MtmdevComGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MtmdevComGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MtmdevComGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelNospamThurstonsUs,
 		Name:    "Mailinator (nospam.thurstons.us)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.NospamThurstonsUsGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.NospamThurstonsUsGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.NospamThurstonsUsGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.NospamThurstonsUsGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelNotfond404Mn,
 		Name:    "Mailinator (notfond.404.mn)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Notfond404MnGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Notfond404MnGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.Notfond404MnGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.Notfond404MnGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelNullK3vinNet,
 		Name:    "Mailinator (null.k3vin.net)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.NullK3vinNetGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.NullK3vinNetGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.NullK3vinNetGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.NullK3vinNetGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelNuxh62Space,
 		Name:    "Mailmomy (nuxh62.space)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Nuxh62SpaceGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Nuxh62SpaceGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.Nuxh62SpaceGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.Nuxh62SpaceGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelProidCloudIpCc,
 		Name:    "Mailmomy (proid.cloud-ip.cc)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ProidCloudIpCcGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ProidCloudIpCcGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.ProidCloudIpCcGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.ProidCloudIpCcGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelRamjaneMoooCom,
 		Name:    "Mailinator (ramjane.mooo.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.RamjaneMoooComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.RamjaneMoooComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.RamjaneMoooComGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.RamjaneMoooComGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelRauxaSenyCat,
 		Name:    "Mailinator (rauxa.seny.cat)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.RauxaSenyCatGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.RauxaSenyCatGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.RauxaSenyCatGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.RauxaSenyCatGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelReallyIstrashCom,
 		Name:    "Mailinator (really.istrash.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ReallyIstrashComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ReallyIstrashComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.ReallyIstrashComGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.ReallyIstrashComGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSbookPics,
 		Name:    "Mailmomy (sbook.pics)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SbookPicsGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SbookPicsGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SbookPicsGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SbookPicsGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamHortukOvh,
 		Name:    "Mailinator (spam.hortuk.ovh)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamHortukOvhGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamHortukOvhGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamHortukOvhGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamHortukOvhGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpWootAt,
 		Name:    "Mailinator (sp.woot.at)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpWootAtGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpWootAtGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpWootAtGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpWootAtGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTestUnergieCom,
 		Name:    "Mailinator (test.unergie.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TestUnergieComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TestUnergieComGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TestUnergieComGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TestUnergieComGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTorchYiOrg,
 		Name:    "Mailinator (torch.yi.org)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TorchYiOrgGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TorchYiOrgGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TorchYiOrgGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TorchYiOrgGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTZibetNet,
 		Name:    "Mailinator (t.zibet.net)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TZibetNetGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TZibetNetGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.TZibetNetGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.TZibetNetGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelXue32Buzz,
 		Name:    "Mailmomy (xue32.buzz)",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.Xue32BuzzGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.Xue32BuzzGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.Xue32BuzzGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.Xue32BuzzGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelApihz,
 		Name:    "ApiHz TempMail",
 		Website: "apihz.cn",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ApihzGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ApihzGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for apihz channel")
 			}
-			return normEmailsResult(prov.ApihzGetEmails(token))
+			return normEmailsResult(prov.ApihzGetEmails(ctx, token))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSogetthisCom,
 		Name:    "Mailinator (sogetthis.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SogetthisComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SogetthisComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SogetthisComGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SogetthisComGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelBobmailInfo,
 		Name:    "Mailinator (bobmail.info)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.BobmailInfoGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.BobmailInfoGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.BobmailInfoGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.BobmailInfoGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSuremailInfo,
 		Name:    "Mailinator (suremail.info)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SuremailInfoGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SuremailInfoGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SuremailInfoGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SuremailInfoGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelBinkmailCom,
 		Name:    "Mailinator (binkmail.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.BinkmailComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.BinkmailComGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.BinkmailComGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.BinkmailComGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelVeryrealemailCom,
 		Name:    "Mailinator (veryrealemail.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.VeryrealemailComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.VeryrealemailComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.VeryrealemailComGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.VeryrealemailComGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMailmomy,
 		Name:    "Mailmomy",
 		Website: "mailmomy.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailmomyGenerate())
+		Backend: "mailmomy",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailmomyGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MailmomyGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailmomyGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelChammyInfo,
 		Name:    "Mailinator (chammy.info)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ChammyInfoGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ChammyInfoGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.ChammyInfoGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.ChammyInfoGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelThisisnotmyrealemailCom,
 		Name:    "Mailinator (thisisnotmyrealemail.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.ThisisnotmyrealemailComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.ThisisnotmyrealemailComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.ThisisnotmyrealemailComGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.ThisisnotmyrealemailComGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelNotmailinatorCom,
 		Name:    "Mailinator (notmailinator.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.NotmailinatorComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.NotmailinatorComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.NotmailinatorComGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.NotmailinatorComGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamherepleaseCom,
 		Name:    "Mailinator (spamhereplease.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamherepleaseComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamherepleaseComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamherepleaseComGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamherepleaseComGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSendspamhereCom,
 		Name:    "Mailinator (sendspamhere.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SendspamhereComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SendspamhereComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SendspamhereComGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SendspamhereComGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSendfreeOrg,
 		Name:    "Mailinator (sendfree.org)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SendfreeOrgGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SendfreeOrgGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SendfreeOrgGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SendfreeOrgGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelJunkBeatsOrg,
 		Name:    "Mailinator (junk.beats.org)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.JunkBeatsOrgGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.JunkBeatsOrgGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.JunkBeatsOrgGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.JunkBeatsOrgGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelJunkIhmehlCom,
 		Name:    "Mailinator (junk.ihmehl.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.JunkIhmehlComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.JunkIhmehlComGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.JunkIhmehlComGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.JunkIhmehlComGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelJunkNoplayOrg,
 		Name:    "Mailinator (junk.noplay.org)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.JunkNoplayOrgGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.JunkNoplayOrgGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.JunkNoplayOrgGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.JunkNoplayOrgGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelJunkVanillasystemCom,
 		Name:    "Mailinator (junk.vanillasystem.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.JunkVanillasystemComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.JunkVanillasystemComGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.JunkVanillasystemComGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.JunkVanillasystemComGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamJasonpearceCom,
 		Name:    "Mailinator (spam.jasonpearce.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamJasonpearceComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamJasonpearceComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamJasonpearceComGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamJasonpearceComGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelFishSkytaleNet,
 		Name:    "Mailinator (fish.skytale.net)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.FishSkytaleNetGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.FishSkytaleNetGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.FishSkytaleNetGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.FishSkytaleNetGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamMccrewCom,
 		Name:    "Mailinator (spam.mccrew.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamMccrewComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamMccrewComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamMccrewComGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamMccrewComGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDropmailClick,
 		Name:    "DropMail.click",
 		Website: "dropmail.click",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.DropmailClickGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.DropmailClickGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.DropmailClickGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.DropmailClickGetEmails(ctx, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamCoroiuCom,
 		Name:    "Mailinator (spam.coroiu.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamCoroiuComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamCoroiuComGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamCoroiuComGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamCoroiuComGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamDeluserNet,
 		Name:    "Mailinator (spam.deluser.net)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamDeluserNetGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamDeluserNetGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamDeluserNetGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamDeluserNetGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamDhsfNet,
 		Name:    "Mailinator (spam.dhsf.net)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamDhsfNetGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamDhsfNetGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamDhsfNetGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamDhsfNetGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamLucatntCom,
 		Name:    "Mailinator (spam.lucatnt.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamLucatntComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamLucatntComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamLucatntComGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamLucatntComGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamLyceumLifeComRu,
 		Name:    "Mailinator (spam.lyceum-life.com.ru)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamLyceumLifeComRuGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamLyceumLifeComRuGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamLyceumLifeComRuGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamLyceumLifeComRuGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamNetpiratesNet,
 		Name:    "Mailinator (spam.netpirates.net)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamNetpiratesNetGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamNetpiratesNetGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamNetpiratesNetGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamNetpiratesNetGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamNoIpNet,
 		Name:    "Mailinator (spam.no-ip.net)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamNoIpNetGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamNoIpNetGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamNoIpNetGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamNoIpNetGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamOzhOrg,
 		Name:    "Mailinator (spam.ozh.org)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamOzhOrgGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamOzhOrgGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamOzhOrgGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamOzhOrgGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamPyphusOrg,
 		Name:    "Mailinator (spam.pyphus.org)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamPyphusOrgGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamPyphusOrgGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamPyphusOrgGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamPyphusOrgGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamShepPw,
 		Name:    "Mailinator (spam.shep.pw)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamShepPwGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamShepPwGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamShepPwGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamShepPwGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamWtfAt,
 		Name:    "Mailinator (spam.wtf.at)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamWtfAtGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamWtfAtGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamWtfAtGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamWtfAtGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamWulczerOrg,
 		Name:    "Mailinator (spam.wulczer.org)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamWulczerOrgGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamWulczerOrgGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamWulczerOrgGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamWulczerOrgGetEmails(ctx, email))
 		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
+		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelCrapKakaduaNet,
 		Name:    "Mailinator (crap.kakadua.net)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.CrapKakaduaNetGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.CrapKakaduaNetGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.CrapKakaduaNetGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.CrapKakaduaNetGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSpamJanlugtNl,
 		Name:    "Mailinator (spam.janlugt.nl)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SpamJanlugtNlGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SpamJanlugtNlGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SpamJanlugtNlGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SpamJanlugtNlGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelMinBurningfishNet,
 		Name:    "Mailinator (min.burningfish.net)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MinBurningfishNetGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MinBurningfishNetGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MinBurningfishNetGetEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.MinBurningfishNetGetEmails(email))
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
+		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelSinkFblayCom,
 		Name:    "Mailinator (sink.fblay.com)",
 		Website: "mailinator.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.SinkFblayComGenerate())
+		Backend: "mailinator",
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.SinkFblayComGenerate(ctx))
+		},
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.SinkFblayComGetEmails(ctx, email))
+		},
+		ListEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.MailinatorListEmails(ctx, email))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.SinkFblayComGetEmails(email))
+		GetEmail: func(ctx context.Context, email, token, id string) (*Email, error) {
+			return normEmailResult(prov.MailinatorGetEmail(ctx, email, id))
 		},
+		Capabilities: CapHTML | CapAttachments | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempgmailer,
 		Name:    "TempGmailer",
 		Website: "tempgmailer.com",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempgmailerGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempgmailerGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempgmailer channel")
 			}
-			return normEmailsResult(prov.TempgmailerGetEmails(token, email))
+			return normEmailsResult(prov.TempgmailerGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTempMailOrg,
 		Name:    "Temp-Mail.org",
 		Website: "temp-mail.org",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempMailOrgGenerate(opts.Duration, ""))
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempMailOrgGenerate(ctx, opts.Duration, ""))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for temp-mail-org channel")
 			}
-			return normEmailsResult(prov.TempMailOrgGetEmails(token, email))
+			return normEmailsResult(prov.TempMailOrgGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapAttachments | CapTTLControl,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelXkxMe,
 		Name:    "XKX.me",
 		Website: "xkx.me",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.XkxMeGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.XkxMeGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for xkx-me channel")
 			}
-			return normEmailsResult(prov.XkxMeGetEmails(token, email))
+			return normEmailsResult(prov.XkxMeGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelGoneboxEmail,
 		Name:    "Gonebox Email",
 		Website: "gonebox.email",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.GoneboxEmailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.GoneboxEmailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.GoneboxEmailGetEmails(email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.GoneboxEmailGetEmails(ctx, email))
 		},
 	})
 
@@ -3725,14 +4892,14 @@ func init() {
 		Channel: ChannelMailcatAi,
 		Name:    "Mailcat AI",
 		Website: "mailcat.ai",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.MailcatAiGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.MailcatAiGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for mailcat-ai channel")
 			}
-			return normEmailsResult(prov.MailcatAiGetEmails(token, email))
+			return normEmailsResult(prov.MailcatAiGetEmails(ctx, token, email))
 		},
 	})
 
@@ -3740,14 +4907,14 @@ func init() {
 		Channel: ChannelTempgoEmail,
 		Name:    "TempGo Email",
 		Website: "tempgo.email",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.TempgoEmailGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.TempgoEmailGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for tempgo-email channel")
 			}
-			return normEmailsResult(prov.TempgoEmailGetEmails(token, email))
+			return normEmailsResult(prov.TempgoEmailGetEmails(ctx, token, email))
 		},
 	})
 
@@ -3755,49 +4922,52 @@ func init() {
 		Channel: ChannelRestmailNet,
 		Name:    "Restmail.net",
 		Website: "restmail.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
-			return fromMailbox(prov.RestmailNetGenerate())
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
+			return fromMailbox(prov.RestmailNetGenerate(ctx))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
-			return normEmailsResult(prov.RestmailNetGetEmails(token, email))
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return normEmailsResult(prov.RestmailNetGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapCustomLocalPart,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelDropmailMe,
 		Name:    "DropMail.me",
 		Website: "dropmail.me",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
 			domain := ""
 			if opts.Domain != nil {
 				domain = *opts.Domain
 			}
-			return fromMailbox(prov.DropmailMeGenerate(opts.Duration, domain))
+			return fromMailbox(prov.DropmailMeGenerate(ctx, opts.Duration, domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for dropmail-me channel")
 			}
-			return normEmailsResult(prov.DropmailMeGetEmails(token, email))
+			return normEmailsResult(prov.DropmailMeGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapDomainChoice | CapTTLControl,
 	})
 
 	registerChannel(ChannelSpec{
 		Channel: ChannelTenMinuteMailNet,
 		Name:    "10MinuteMail.net",
 		Website: "10minutemail.net",
-		Generate: func(opts *GenerateEmailOptions) (*EmailInfo, error) {
+		Generate: func(ctx context.Context, opts *GenerateEmailOptions) (*EmailInfo, error) {
 			domain := ""
 			if opts.Domain != nil {
 				domain = *opts.Domain
 			}
-			return fromMailbox(prov.TenMinuteMailNetGenerate(opts.Duration, domain))
+			return fromMailbox(prov.TenMinuteMailNetGenerate(ctx, opts.Duration, domain))
 		},
-		GetEmails: func(email, token string) ([]Email, error) {
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
 			if token == "" {
 				return nil, fmt.Errorf("internal error: token missing for ten-minute-mail-net channel")
 			}
-			return normEmailsResult(prov.TenMinuteMailNetGetEmails(token, email))
+			return normEmailsResult(prov.TenMinuteMailNetGetEmails(ctx, token, email))
 		},
+		Capabilities: CapHTML | CapDomainChoice | CapTTLControl,
 	})
 }
@@ -1,18 +1,22 @@
 package tempemail
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"io"
 	"math"
-	"strings"
+	"net"
+	"syscall"
 	"time"
 
+	prov "github.com/XxxXTeam/tempmail-sdk/sdk/go/provider"
 	http "github.com/bogdanfinn/fhttp"
 )
 
 /*
  * RetryOptions 重试配置选项
- * SDK 内部对网络错误、超时、HTTP 4xx/5xx 错误自动重试
- * 仅 SDK 内部的参数校验类错误不会重试
+ * SDK 内部对网络错误、超时、限流与 HTTP 5xx 错误自动重试
+ * 人机验证、令牌无效、邮箱过期及其余 4xx 错误不会重试
  *
  * 示例:
  *   opts := &RetryOptions{MaxRetries: 3, InitialDelay: 2 * time.Second}
@@ -62,47 +66,47 @@ func mergeRetryOptions(opts *RetryOptions) RetryOptions {
 }
 
 /*
- * shouldRetry 判断错误是否应该重试
+ * shouldRetry 判断错误是否应该重试（基于错误分类，见 errors.go）
  *
  * 可重试的错误类型:
- * - 网络连接错误（connection refused, connection reset, broken pipe）
- * - 超时错误（timeout, timed out, i/o timeout）
- * - DNS 解析失败（no such host, dns）
- * - HTTP 429 限流
- * - HTTP 4xx/5xx 错误（含状态码的错误消息）
+ * - 限流 *ErrRateLimited（退避时长参考 RetryAfter）
+ * - HTTP 408/425/429/5xx（*HTTPStatusError.Temporary）
+ * - 网络错误：net.Error（超时、连接失败、DNS）、连接被重置/拒绝、意外 EOF；
+ *   tls-client 与标准库的传输层错误均包装为 *url.Error（实现 net.Error），不再按错误消息文本判断
  *
  * 不可重试的错误:
+ * - ErrCaptchaRequired、ErrInvalidToken、ErrMailboxExpired
+ * - 其余 4xx 状态码
  * - JSON 解析错误、参数校验错误等 SDK 内部错误
  */
 func shouldRetry(err error) bool {
 	if err == nil {
 		return false
 	}
-
-	msg := strings.ToLower(err.Error())
-
-	/* 网络级别错误 → 重试 */
-	networkKeywords := []string{
-		"connection refused", "connection reset",
-		"timeout", "timed out",
-		"no such host", "dns",
-		"eof", "broken pipe",
-		"network is unreachable",
-		"i/o timeout",
-	}
-	for _, kw := range networkKeywords {
-		if strings.Contains(msg, kw) {
-			return true
-		}
+	if errors.Is(err, ErrCaptchaRequired) || errors.Is(err, ErrInvalidToken) || errors.Is(err, ErrMailboxExpired) {
+		return false
 	}
 
-	/* HTTP 429 限流 → 重试 */
-	if strings.Contains(msg, "429") || strings.Contains(msg, "too many requests") || strings.Contains(msg, "rate limit") {
+	var rateLimited *ErrRateLimited
+	if errors.As(err, &rateLimited) {
+		return true
+	}
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Temporary()
+	}
+	if errors.Is(err, ErrChannelUnavailable) {
 		return true
 	}
 
-	/* HTTP 4xx/5xx 错误（含状态码的错误消息）→ 重试 */
-	if strings.Contains(msg, ": 4") || strings.Contains(msg, ": 5") {
+	/* 网络级别错误 → 重试 */
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) || errors.Is(err, syscall.ENETUNREACH) {
 		return true
 	}
 
@@ -113,7 +117,7 @@ func shouldRetry(err error) bool {
  * WithRetry 带重试的泛型操作执行器
  *
  * 功能:
- * - 自动重试可恢复的错误（网络错误、超时、HTTP 4xx/5xx）
+ * - 自动重试可恢复的错误（网络错误、超时、限流、HTTP 5xx）
  * - 指数退避策略避免短时间内过度请求
  * - 不可恢复的错误（SDK 内部参数校验错误等）直接返回，不浪费重试次数
  *
@@ -123,31 +127,41 @@ func shouldRetry(err error) bool {
  */
 /*
  * withRetryAndAttempts 与 WithRetry 相同，但额外返回总尝试次数（含首次，至少为 1）
+ * ctx 取消后不再发起新的尝试，退避等待立即返回 ctx.Err()
  */
-func withRetryAndAttempts[T any](fn func() (T, error), opts *RetryOptions) (T, int, error) {
+func withRetryAndAttempts[T any](ctx context.Context, fn func() (T, error), opts *RetryOptions) (T, int, error) {
 	merged := mergeRetryOptions(opts)
+	log := instanceFromContext(ctx).log()
 	var lastErr error
 	var zero T
 
 	for attempt := 0; attempt <= merged.MaxRetries; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, attempt, err
+		}
 		result, err := fn()
 		attempts := attempt + 1
 		if err == nil {
 			if attempt > 0 {
-				sdkLogger.Info("重试成功", "attempt", attempts)
+				log.Info("重试成功", "attempt", attempts)
 			}
 			return result, attempts, nil
 		}
 
+		/* 调用方已取消：请求失败源于 ctx，直接返回，不浪费重试次数 */
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return zero, attempts, ctxErr
+		}
+
 		lastErr = err
 		errorMsg := err.Error()
 
 		/* 最后一次尝试失败或不可重试的错误 → 直接返回 */
 		if attempt >= merged.MaxRetries || !shouldRetry(err) {
 			if attempt >= merged.MaxRetries && merged.MaxRetries > 0 {
-				sdkLogger.Error("重试耗尽后仍失败", "retries", merged.MaxRetries, "error", errorMsg)
+				log.Error("重试耗尽后仍失败", "retries", merged.MaxRetries, "error", errorMsg)
 			} else if !shouldRetry(err) {
-				sdkLogger.Debug("不可重试的错误", "error", errorMsg)
+				log.Debug("不可重试的错误", "error", errorMsg)
 			}
 			return zero, attempts, err
 		}
@@ -157,15 +171,50 @@ func withRetryAndAttempts[T any](fn func() (T, error), opts *RetryOptions) (T, i
 		if delay > merged.MaxDelay {
 			delay = merged.MaxDelay
 		}
-		sdkLogger.Warn("请求失败，即将重试", "error", errorMsg, "delay", delay.String(), "attempt", attempt+2)
-		time.Sleep(delay)
+		/* 限流：服务端要求的等待超出退避上限时不再重试，交由调用方换渠道或稍后再试 */
+		var rateLimited *ErrRateLimited
+		if errors.As(err, &rateLimited) && rateLimited.RetryAfter > delay {
+			if rateLimited.RetryAfter > merged.MaxDelay {
+				log.Debug("限流等待超出退避上限，不再重试", "retryAfter", rateLimited.RetryAfter.String())
+				return zero, attempts, err
+			}
+			delay = rateLimited.RetryAfter
+		}
+		log.Warn("请求失败，即将重试", "error", errorMsg, "delay", delay.String(), "attempt", attempt+2)
+		if err := sleepContext(ctx, delay); err != nil {
+			return zero, attempts, err
+		}
 	}
 
 	return zero, merged.MaxRetries + 1, lastErr
 }
 
+/*
+ * sleepContext 可被 ctx 打断的等待
+ * 等待期间 ctx 取消则立即返回 ctx.Err()，正常到期返回 nil
+ */
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func WithRetry[T any](fn func() (T, error), opts *RetryOptions) (T, error) {
-	v, _, err := withRetryAndAttempts(fn, opts)
+	v, _, err := withRetryAndAttempts(context.Background(), fn, opts)
+	return v, err
+}
+
+/*
+ * WithRetryContext 与 WithRetry 相同，但退避等待受 ctx 控制
+ * ctx 取消后不再重试，立即返回 ctx.Err()
+ */
+func WithRetryContext[T any](ctx context.Context, fn func() (T, error), opts *RetryOptions) (T, error) {
+	v, _, err := withRetryAndAttempts(ctx, fn, opts)
 	return v, err
 }
 
@@ -182,12 +231,12 @@ func httpClientWithTimeout(opts *RetryOptions) *http.Client {
 
 /*
  * checkHTTPStatus 检查 HTTP 响应状态码
- * 返回包含状态码的格式化错误，便于 shouldRetry 识别 5xx 进行重试
+ * 状态码 >= 400 时返回 *HTTPStatusError（含 Retry-After），由 shouldRetry 按状态码分类决定是否重试
  * 状态码 < 400 时返回 nil
  */
 func checkHTTPStatus(resp *http.Response, action string) error {
 	if resp.StatusCode >= 400 {
-		return fmt.Errorf("%s: %d", action, resp.StatusCode)
+		return prov.NewHTTPStatusError(resp, "%s: %d", action, resp.StatusCode)
 	}
 	return nil
 }
@@ -0,0 +1,105 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	http "github.com/bogdanfinn/fhttp"
+)
+
+/*
+ * TestWithRetryStopsOnCancel 校验 ctx 取消后退避等待立即返回，且不再发起新的尝试
+ */
+func TestWithRetryStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	fn := func() (int, error) {
+		calls++
+		cancel()
+		return 0, errors.New("connection reset")
+	}
+
+	start := time.Now()
+	_, attempts, err := withRetryAndAttempts(ctx, fn, &RetryOptions{MaxRetries: 3, InitialDelay: 10 * time.Second})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("期望 context.Canceled，实际 %v", err)
+	}
+	if calls != 1 || attempts != 1 {
+		t.Fatalf("取消后不应继续尝试: calls=%d attempts=%d", calls, attempts)
+	}
+	if time.Since(start) > time.Second {
+		t.Fatalf("退避等待未被 ctx 打断")
+	}
+}
+
+/*
+ * TestGenerateEmailContextCanceled 校验已取消的 ctx 不会尝试任何渠道
+ */
+func TestGenerateEmailContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	info, err := GenerateEmailContext(ctx, &GenerateEmailOptions{Channel: ChannelMailTm})
+	if info != nil || !errors.Is(err, context.Canceled) {
+		t.Fatalf("期望返回 context.Canceled，实际 info=%v err=%v", info, err)
+	}
+}
+
+/*
+ * TestShouldRetryClassification 校验重试策略按错误分类判断，而非错误消息文本
+ */
+func TestShouldRetryClassification(t *testing.T) {
+	status := func(code int, header http.Header) error {
+		if header == nil {
+			header = http.Header{}
+		}
+		return checkHTTPStatus(&http.Response{StatusCode: code, Header: header}, "demo")
+	}
+
+	rateLimited := status(429, http.Header{"Retry-After": {"3"}})
+	var rl *ErrRateLimited
+	if !errors.As(rateLimited, &rl) || rl.RetryAfter != 3*time.Second {
+		t.Fatalf("429 应展开为 *ErrRateLimited{RetryAfter: 3s}，实际 %v", rateLimited)
+	}
+
+	cases := []struct {
+		name  string
+		err   error
+		retry bool
+	}{
+		{"429", rateLimited, true},
+		{"503", status(503, nil), true},
+		{"404", status(404, nil), false},
+		{"401", status(401, nil), false},
+		{"cf-challenge", status(403, http.Header{"Cf-Mitigated": {"challenge"}}), false},
+		{"wrapped-expired", fmt.Errorf("inbox: %w", ErrMailboxExpired), false},
+		{"eof", fmt.Errorf("read body: %w", io.ErrUnexpectedEOF), true},
+		{"message-with-4", errors.New("parse: 4 fields"), false},
+		{"untyped-timeout-text", errors.New("demo: field timeout missing"), false},
+		{"untyped-eof-text", errors.New("demo: unexpected eof in json"), false},
+		{"url-error-http2", &url.Error{Op: "Get", URL: "https://x", Err: errors.New("http2: client connection lost")}, true},
+		{"url-error-eof", &url.Error{Op: "Get", URL: "https://x", Err: io.EOF}, true},
+		{"conn-refused", fmt.Errorf("demo: %w", &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}), true},
+		{"dns", fmt.Errorf("demo: %w", &net.DNSError{Err: "no such host", Name: "x.invalid", IsNotFound: true}), true},
+	}
+	for _, c := range cases {
+		if got := shouldRetry(c.err); got != c.retry {
+			t.Errorf("%s: shouldRetry=%v，期望 %v（%v）", c.name, got, c.retry, c.err)
+		}
+	}
+	if c := ClassifyError(errors.New("demo: field timeout missing")); c != ErrorClassUnknown {
+		t.Fatalf("未分类错误不应按消息文本归为网络或超时，实际 %s", c)
+	}
+	if c := ClassifyError(&url.Error{Op: "Get", URL: "https://x", Err: io.EOF}); c != ErrorClassNetwork {
+		t.Fatalf("传输层错误应归为 network，实际 %s", c)
+	}
+	if !errors.Is(status(503, nil), ErrChannelUnavailable) || !errors.Is(status(401, nil), ErrInvalidToken) {
+		t.Fatalf("状态码应展开为对应分类")
+	}
+}
@@ -0,0 +1,207 @@
+package tempemail
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+/*
+ * 邮箱会话序列化
+ * EmailInfo 的 token 不参与普通 JSON 序列化，跨进程保存/恢复邮箱须使用 MarshalSession / RestoreEmailInfo。
+ * 会话数据带格式版本号，可选使用对称密钥（AES-256-GCM）加密后再落库。
+ *
+ * 示例:
+ *   data, _ := info.MarshalSession()
+ *   restored, _ := RestoreEmailInfo(data)
+ *   result, _ := restored.GetEmails(nil)
+ *
+ *   sealed, _ := info.MarshalSessionWithKey(key)
+ *   restored, _ := RestoreEmailInfoWithKey(sealed, key)
+ */
+
+/* SessionFormatVersion 当前会话格式版本，格式不兼容变更时递增 */
+const SessionFormatVersion = 1
+
+/* sessionCipherName 加密会话使用的算法标识 */
+const sessionCipherName = "aes-256-gcm"
+
+/* sessionPayload 会话明文结构 */
+type sessionPayload struct {
+	Version   int     `json:"v"`
+	Channel   Channel `json:"channel"`
+	Email     string  `json:"email"`
+	Token     string  `json:"token,omitempty"`
+	ExpiresAt any     `json:"expiresAt,omitempty"`
+	/* 渠道原始过期时间，旧版本会话中不存在 */
+	ExpiresAtRaw any    `json:"expiresAtRaw,omitempty"`
+	CreatedAt    string `json:"createdAt,omitempty"`
+}
+
+/* sessionEnvelope 加密会话外层结构，Data 为 GCM 密文（含认证标签） */
+type sessionEnvelope struct {
+	Version int    `json:"v"`
+	Cipher  string `json:"enc"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+/*
+ * MarshalSession 将邮箱会话（含内部 token）序列化为带版本号的 JSON
+ * 结果包含认证令牌，等同于邮箱凭据，落库时建议使用 MarshalSessionWithKey 加密
+ */
+func (info *EmailInfo) MarshalSession() ([]byte, error) {
+	if info == nil {
+		return nil, fmt.Errorf("EmailInfo is required")
+	}
+	return json.Marshal(sessionPayload{
+		Version:      SessionFormatVersion,
+		Channel:      info.Channel,
+		Email:        info.Email,
+		Token:        info.token,
+		ExpiresAt:    info.ExpiresAt,
+		ExpiresAtRaw: info.ExpiresAtRaw,
+		CreatedAt:    info.CreatedAt,
+	})
+}
+
+/*
+ * MarshalSessionWithKey 序列化邮箱会话并使用对称密钥加密
+ * key 可为任意长度，内部经 SHA-256 派生为 AES-256 密钥；建议使用 32 字节随机密钥
+ */
+func (info *EmailInfo) MarshalSessionWithKey(key []byte) ([]byte, error) {
+	plain, err := info.MarshalSession()
+	if err != nil {
+		return nil, err
+	}
+	aead, err := sessionAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("session: generate nonce failed: %w", err)
+	}
+	return json.Marshal(sessionEnvelope{
+		Version: SessionFormatVersion,
+		Cipher:  sessionCipherName,
+		Nonce:   nonce,
+		Data:    aead.Seal(nil, nonce, plain, nil),
+	})
+}
+
+/*
+ * RestoreEmailInfo 从 MarshalSession 的输出恢复 EmailInfo
+ * 恢复后的 EmailInfo 可直接用于 GetEmails（使用默认实例，绑定其他实例见 Client.RestoreEmailInfo）；
+ * 加密会话须使用 RestoreEmailInfoWithKey
+ */
+func RestoreEmailInfo(data []byte) (*EmailInfo, error) {
+	return RestoreEmailInfoWithKey(data, nil)
+}
+
+/*
+ * RestoreEmailInfoWithKey 恢复会话，data 为加密会话时使用 key 解密
+ * data 为明文会话时忽略 key
+ */
+func RestoreEmailInfoWithKey(data []byte, key []byte) (*EmailInfo, error) {
+	var env sessionEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("session: invalid data: %w", err)
+	}
+	if env.Cipher != "" {
+		if env.Cipher != sessionCipherName {
+			return nil, fmt.Errorf("session: unsupported cipher %q", env.Cipher)
+		}
+		if len(key) == 0 {
+			return nil, fmt.Errorf("session: data is encrypted, key is required")
+		}
+		aead, err := sessionAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(env.Nonce) != aead.NonceSize() {
+			return nil, fmt.Errorf("session: invalid nonce")
+		}
+		plain, err := aead.Open(nil, env.Nonce, env.Data, nil)
+		if err != nil {
+			return nil, fmt.Errorf("session: decrypt failed (wrong key or corrupted data)")
+		}
+		data = plain
+	}
+
+	var payload sessionPayload
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("session: invalid payload: %w", err)
+	}
+	if payload.Version <= 0 || payload.Version > SessionFormatVersion {
+		return nil, fmt.Errorf("session: unsupported format version %d", payload.Version)
+	}
+	if payload.Channel == "" {
+		return nil, fmt.Errorf("session: channel is required")
+	}
+	if _, ok := channelRegistryMap[payload.Channel]; !ok {
+		return nil, fmt.Errorf("session: unknown channel: %s", payload.Channel)
+	}
+
+	return &EmailInfo{
+		Channel:      payload.Channel,
+		Email:        payload.Email,
+		token:        payload.Token,
+		ExpiresAt:    sessionExpiresAt(payload.ExpiresAt),
+		ExpiresAtRaw: sessionExpiresAt(payload.ExpiresAtRaw),
+		CreatedAt:    payload.CreatedAt,
+	}, nil
+}
+
+/* Client.RestoreEmailInfo 恢复会话并绑定到客户端所属实例，后续请求使用该实例的代理与配置 */
+func (c *Client) RestoreEmailInfo(data []byte) (*EmailInfo, error) {
+	return c.RestoreEmailInfoWithKey(data, nil)
+}
+
+/* Client.RestoreEmailInfoWithKey 同 RestoreEmailInfoWithKey，并绑定到客户端所属实例 */
+func (c *Client) RestoreEmailInfoWithKey(data []byte, key []byte) (*EmailInfo, error) {
+	info, err := RestoreEmailInfoWithKey(data, key)
+	if err != nil {
+		return nil, err
+	}
+	info.inst = c.inst
+	return info, nil
+}
+
+/* sessionAEAD 由任意长度密钥派生 AES-256-GCM 实例 */
+func sessionAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("session: key is required")
+	}
+	sum := sha256.Sum256(key)
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+/*
+ * sessionExpiresAt 还原 ExpiresAt 的原始类型
+ * 毫秒/秒时间戳在 JSON 中为数字，UseNumber 解码后转回 int64，避免变成 float64
+ */
+func sessionExpiresAt(v any) any {
+	n, ok := v.(json.Number)
+	if !ok {
+		return v
+	}
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return n.String()
+}
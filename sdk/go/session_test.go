@@ -0,0 +1,47 @@
+package tempemail
+
+import "testing"
+
+/*
+ * TestSessionRoundTrip 校验会话明文/加密两种形式均可完整还原 token 与时间戳类型
+ */
+func TestSessionRoundTrip(t *testing.T) {
+	info := &EmailInfo{
+		Channel:   ChannelGuerrillaMail,
+		Email:     "abc@guerrillamail.com",
+		token:     "sid-123",
+		ExpiresAt: int64(1760000000000),
+		CreatedAt: "2026-10-17T00:00:00Z",
+	}
+
+	data, err := info.MarshalSession()
+	if err != nil {
+		t.Fatalf("MarshalSession: %v", err)
+	}
+	restored, err := RestoreEmailInfo(data)
+	if err != nil {
+		t.Fatalf("RestoreEmailInfo: %v", err)
+	}
+	if *restored != *info {
+		t.Fatalf("还原结果不一致: %+v vs %+v", restored, info)
+	}
+
+	key := []byte("job-db-secret")
+	sealed, err := info.MarshalSessionWithKey(key)
+	if err != nil {
+		t.Fatalf("MarshalSessionWithKey: %v", err)
+	}
+	if _, err := RestoreEmailInfo(sealed); err == nil {
+		t.Fatalf("加密会话缺少密钥时应返回错误")
+	}
+	if _, err := RestoreEmailInfoWithKey(sealed, []byte("wrong")); err == nil {
+		t.Fatalf("错误密钥应返回错误")
+	}
+	restored, err = RestoreEmailInfoWithKey(sealed, key)
+	if err != nil {
+		t.Fatalf("RestoreEmailInfoWithKey: %v", err)
+	}
+	if *restored != *info {
+		t.Fatalf("解密还原结果不一致: %+v vs %+v", restored, info)
+	}
+}
@@ -0,0 +1,155 @@
+package tempemail
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+)
+
+/*
+ * 增量获取
+ * GetEmailsSince 只返回游标之后到达的新邮件，并返回下一次调用使用的游标。
+ * guerrillamail 系列（check_email 的 seq）、mail.tm（分页列表）、dropmail（receivedAt 及同一时刻的邮件 ID）使用渠道原生游标，
+ * 只为新邮件请求正文；其余渠道在客户端按已见邮件去重，游标中记录最近见过的邮件指纹。
+ * 游标是不透明字符串，可持久化后跨进程继续使用，但只对生成它的渠道有效。
+ *
+ * 示例:
+ *   cursor := ""
+ *   for {
+ *       emails, next, err := GetEmailsSince(ctx, info, cursor)
+ *       if err != nil { ... }
+ *       for _, e := range emails { handle(e) }
+ *       cursor = next
+ *       time.Sleep(5 * time.Second)
+ *   }
+ */
+
+/* maxCursorSeen 客户端去重游标至少保留的邮件指纹数；本次列表更长时保留整个列表，避免靠后的邮件被反复当作新邮件 */
+const maxCursorSeen = 256
+
+/* emailsCursor 游标内容，序列化为 base64url(JSON) */
+type emailsCursor struct {
+	/* 生成游标的渠道 */
+	Channel Channel `json:"c"`
+	/* 渠道原生游标 */
+	Native string `json:"n,omitempty"`
+	/* 客户端去重：最近见过的邮件指纹，新到旧 */
+	Seen []string `json:"s,omitempty"`
+}
+
+/*
+ * GetEmailsSince 获取 cursor 之后到达的新邮件，返回新邮件与下一个游标
+ * cursor 为空串表示从头开始（返回当前全部邮件）；失败时返回 error，调用方应保留原游标重试
+ */
+func GetEmailsSince(ctx context.Context, info *EmailInfo, cursor string) ([]Email, string, error) {
+	return instanceOf(info).getEmailsSince(ctx, info, cursor)
+}
+
+func (in *instance) getEmailsSince(ctx context.Context, info *EmailInfo, cursor string) ([]Email, string, error) {
+	spec, err := specFor(info)
+	if err != nil {
+		return nil, "", err
+	}
+	cur, err := decodeEmailsCursor(cursor, info.Channel)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ctx = withInstance(ctx, in)
+	var emails []Email
+	if spec.GetEmailsSince != nil {
+		var native string
+		emails, _, err = withRetryAndAttempts(ctx, func() ([]Email, error) {
+			list, next, err := spec.GetEmailsSince(ctx, info.Email, info.token, cur.Native)
+			native = next
+			return list, err
+		}, nil)
+		cur.Native = native
+	} else {
+		var all []Email
+		all, _, err = withRetryAndAttempts(ctx, func() ([]Email, error) {
+			return getEmailsOnce(ctx, info.Channel, info.Email, info.token)
+		}, nil)
+		emails = cur.filterSeen(all)
+	}
+	if err != nil {
+		in.log().Warn("增量获取邮件失败", "channel", string(info.Channel), "error", err.Error())
+		return nil, "", err
+	}
+	if len(emails) > 0 {
+		in.log().Info("获取到新邮件", "channel", string(info.Channel), "count", len(emails))
+	}
+	return emails, cur.encode(), nil
+}
+
+/*
+ * filterSeen 返回未见过的邮件，并把本次列表的指纹记入游标：本次列表的指纹全部保留且在前，
+ * 此前见过、已不在列表中的指纹在总数超过 maxCursorSeen 时丢弃最旧的
+ */
+func (c *emailsCursor) filterSeen(all []Email) []Email {
+	seen := make(map[string]bool, len(c.Seen))
+	for _, k := range c.Seen {
+		seen[k] = true
+	}
+	fresh := make([]Email, 0)
+	current := make([]string, 0, len(all))
+	inCurrent := make(map[string]bool, len(all))
+	for _, e := range all {
+		k := emailFingerprint(e)
+		if !seen[k] {
+			fresh = append(fresh, e)
+		}
+		if !inCurrent[k] {
+			inCurrent[k] = true
+			current = append(current, k)
+		}
+	}
+	for _, k := range c.Seen {
+		if !inCurrent[k] {
+			current = append(current, k)
+		}
+	}
+	if limit := max(maxCursorSeen, len(inCurrent)); len(current) > limit {
+		current = current[:limit]
+	}
+	c.Seen = current
+	return fresh
+}
+
+/* emailFingerprint 邮件去重键的 64 位哈希，使游标保持紧凑 */
+func emailFingerprint(e Email) string {
+	h := fnv.New64a()
+	h.Write([]byte(emailDedupeKey(e)))
+	return strconv.FormatUint(h.Sum64(), 36)
+}
+
+func (c emailsCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeEmailsCursor(s string, ch Channel) (emailsCursor, error) {
+	if s == "" {
+		return emailsCursor{Channel: ch}, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	var c emailsCursor
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil {
+		return emailsCursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if c.Channel != ch {
+		return emailsCursor{}, fmt.Errorf("cursor belongs to channel %s, not %s", c.Channel, ch)
+	}
+	return c, nil
+}
+
+/* GetEmailsSince 增量获取该邮箱的新邮件，见 GetEmailsSince */
+func (m *Mailbox) GetEmailsSince(ctx context.Context, cursor string) ([]Email, string, error) {
+	return GetEmailsSince(ctx, m.info, cursor)
+}
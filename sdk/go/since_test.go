@@ -0,0 +1,71 @@
+package tempemail
+
+import (
+	"context"
+	"strconv"
+	"testing"
+)
+
+/*
+ * TestGetEmailsSince 校验增量获取：原生游标透传给渠道并只返回新邮件，
+ * 无原生游标的渠道在客户端去重，游标不能跨渠道使用
+ */
+func TestGetEmailsSince(t *testing.T) {
+	inbox := []Email{{ID: "1"}, {ID: "2"}}
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-native",
+		GetEmailsSince: func(ctx context.Context, email, token, cursor string) ([]Email, string, error) {
+			n, _ := strconv.Atoi(cursor)
+			return inbox[n:], strconv.Itoa(len(inbox)), nil
+		},
+	})
+	registerFakeChannel(t, ChannelSpec{
+		Channel: "test-dedupe",
+		GetEmails: func(ctx context.Context, email, token string) ([]Email, error) {
+			return inbox, nil
+		},
+	})
+
+	ctx := context.Background()
+	client := newOfflineClient()
+	for _, ch := range []Channel{"test-native", "test-dedupe"} {
+		inbox = []Email{{ID: "1"}, {ID: "2"}}
+		m := client.AddMailbox(&EmailInfo{Channel: ch, Email: string(ch) + "@x"}, nil)
+		emails, cursor, err := m.GetEmailsSince(ctx, "")
+		if err != nil || len(emails) != 2 {
+			t.Fatalf("%s 首次获取: %v, %v", ch, emails, err)
+		}
+		emails, cursor, err = m.GetEmailsSince(ctx, cursor)
+		if err != nil || len(emails) != 0 {
+			t.Fatalf("%s 无新邮件时应为空: %v, %v", ch, emails, err)
+		}
+		inbox = append(inbox, Email{ID: "3"})
+		emails, _, err = m.GetEmailsSince(ctx, cursor)
+		if err != nil || len(emails) != 1 || emails[0].ID != "3" {
+			t.Fatalf("%s 应只返回新邮件 3: %v, %v", ch, emails, err)
+		}
+	}
+
+	_, cursor, _ := GetEmailsSince(ctx, &EmailInfo{Channel: "test-native", Email: "a@x"}, "")
+	if _, _, err := GetEmailsSince(ctx, &EmailInfo{Channel: "test-dedupe", Email: "a@x"}, cursor); err == nil {
+		t.Fatal("跨渠道使用游标应返回错误")
+	}
+}
+
+/* TestFilterSeenLargeInbox 校验收件箱超过 maxCursorSeen 封时，列表靠后的邮件不会被反复当作新邮件 */
+func TestFilterSeenLargeInbox(t *testing.T) {
+	all := make([]Email, maxCursorSeen+50)
+	for i := range all {
+		all[i] = Email{ID: strconv.Itoa(i)}
+	}
+	c := emailsCursor{Channel: "x"}
+	if fresh := c.filterSeen(all); len(fresh) != len(all) {
+		t.Fatalf("首次应全部为新邮件，实际 %d", len(fresh))
+	}
+	if fresh := c.filterSeen(all); len(fresh) != 0 {
+		t.Fatalf("列表未变化时不应有新邮件，实际 %d", len(fresh))
+	}
+	if fresh := c.filterSeen(append(all, Email{ID: "new"})); len(fresh) != 1 || fresh[0].ID != "new" {
+		t.Fatalf("应只返回新邮件 new，实际 %d", len(fresh))
+	}
+}
@@ -10,7 +10,7 @@ import (
 /*
  * 匿名用量上报
  *
- * 环境变量（进程启动时读入全局默认，可被 SetConfig 覆盖）：
+ * 环境变量（进程启动时读入默认实例配置，可被 SetConfig / WithConfig 覆盖）：
  *   TEMPMAIL_TELEMETRY_ENABLED - 未设置则默认开启；false/0/no 关闭，true/1/yes 显式开启
  *   TEMPMAIL_TELEMETRY_URL      - 覆盖上报端点 URL
  *
@@ -49,8 +49,12 @@ func sanitizeTelemetryError(s string) string {
 var telemetryHTTP = &http.Client{Timeout: 8 * time.Second}
 
 /*
- * reportTelemetry 将事件入队，与同一进程内其它事件合并后统一 POST（见 telemetry_batch.go）
+ * reportTelemetry 按实例配置决定是否上报及上报端点，事件入队后与同一进程内发往同一端点的其它事件合并 POST（见 telemetry_batch.go）
  */
-func reportTelemetry(operation, channel string, success bool, attemptCount, channelsTried int, errMsg string) {
-	enqueueTelemetryEvent(operation, channel, success, attemptCount, channelsTried, errMsg)
+func (in *instance) reportTelemetry(operation, channel string, success bool, attemptCount, channelsTried int, errMsg string) {
+	cfg := in.getConfig()
+	if !telemetryOn(cfg) {
+		return
+	}
+	enqueueTelemetryEvent(telemetryURLResolved(cfg), operation, channel, success, attemptCount, channelsTried, errMsg)
 }
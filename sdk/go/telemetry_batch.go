@@ -33,9 +33,10 @@ type telemetryBatchEnvelope struct {
 	Events        []telemetryEvent `json:"events"`
 }
 
+/* 事件按上报端点分组排队：各实例可配置不同端点，同一端点的事件合并为一批 */
 var (
 	telemetryQueueMu sync.Mutex
-	telemetryQueue   []telemetryEvent
+	telemetryQueue   = make(map[string][]telemetryEvent)
 	telemetryFlushMu sync.Mutex /* 避免并发 POST 同一批 */
 )
 
@@ -51,14 +52,6 @@ func telemetryPeriodicFlush() {
 }
 
 func flushTelemetryQueue() {
-	cfg := GetConfig()
-	if !telemetryOn(cfg) {
-		telemetryQueueMu.Lock()
-		telemetryQueue = nil
-		telemetryQueueMu.Unlock()
-		return
-	}
-
 	telemetryFlushMu.Lock()
 	defer telemetryFlushMu.Unlock()
 
@@ -67,50 +60,45 @@ func flushTelemetryQueue() {
 		telemetryQueueMu.Unlock()
 		return
 	}
-	events := telemetryQueue
-	telemetryQueue = nil
+	queued := telemetryQueue
+	telemetryQueue = make(map[string][]telemetryEvent)
 	telemetryQueueMu.Unlock()
 
-	url := telemetryURLResolved(cfg)
-	if url == "" {
-		return
-	}
-
 	ver := SDKVersion()
-	env := telemetryBatchEnvelope{
-		SchemaVersion: 2,
-		SDKLanguage:   "go",
-		SDKVersion:    ver,
-		OS:            runtime.GOOS,
-		Arch:          runtime.GOARCH,
-		Events:        events,
-	}
-	body, err := json.Marshal(env)
-	if err != nil {
-		return
-	}
-
-	go func(postURL string, jsonBody []byte, uaVer string) {
-		req, err := http.NewRequest(http.MethodPost, postURL, bytes.NewReader(jsonBody))
-		if err != nil {
-			return
+	for url, events := range queued {
+		if url == "" || len(events) == 0 {
+			continue
 		}
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("User-Agent", "tempmail-sdk-go/"+uaVer)
-		resp, err := telemetryHTTP.Do(req)
-		if resp != nil {
-			_ = resp.Body.Close()
+		env := telemetryBatchEnvelope{
+			SchemaVersion: 2,
+			SDKLanguage:   "go",
+			SDKVersion:    ver,
+			OS:            runtime.GOOS,
+			Arch:          runtime.GOARCH,
+			Events:        events,
+		}
+		body, err := json.Marshal(env)
+		if err != nil {
+			continue
 		}
-		_ = err
-	}(url, body, ver)
-}
 
-func enqueueTelemetryEvent(operation, channel string, success bool, attemptCount, channelsTried int, errMsg string) {
-	cfg := GetConfig()
-	if !telemetryOn(cfg) {
-		return
+		go func(postURL string, jsonBody []byte, uaVer string) {
+			req, err := http.NewRequest(http.MethodPost, postURL, bytes.NewReader(jsonBody))
+			if err != nil {
+				return
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("User-Agent", "tempmail-sdk-go/"+uaVer)
+			resp, err := telemetryHTTP.Do(req)
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+			_ = err
+		}(url, body, ver)
 	}
+}
 
+func enqueueTelemetryEvent(url, operation, channel string, success bool, attemptCount, channelsTried int, errMsg string) {
 	ev := telemetryEvent{
 		Operation:     operation,
 		Channel:       channel,
@@ -122,8 +110,8 @@ func enqueueTelemetryEvent(operation, channel string, success bool, attemptCount
 	}
 
 	telemetryQueueMu.Lock()
-	telemetryQueue = append(telemetryQueue, ev)
-	n := len(telemetryQueue)
+	telemetryQueue[url] = append(telemetryQueue[url], ev)
+	n := len(telemetryQueue[url])
 	telemetryQueueMu.Unlock()
 
 	if n >= telemetryMaxBatch {
@@ -293,6 +293,7 @@ const (
 /*
  * EmailInfo 创建临时邮箱后返回的邮箱信息
  * 包含邮箱地址和生命周期信息，认证令牌由 SDK 内部维护，不对外暴露
+ * 跨进程保存/恢复（含令牌）使用 MarshalSession / RestoreEmailInfo，见 session.go
  */
 type EmailInfo struct {
 	/* 创建该邮箱所使用的渠道 */
@@ -301,10 +302,17 @@ type EmailInfo struct {
 	Email string `json:"email"`
 	/* 认证令牌，由 SDK 内部维护，不对外暴露 */
 	token string
-	/* 邮箱过期时间（ISO 8601 字符串或 Unix 时间戳） */
+	/*
+	 * 邮箱过期时间，SDK 创建的邮箱统一为 RFC3339（UTC）字符串，渠道未返回时为 nil；
+	 * 类型保持 any 以兼容旧版本会话中的时间戳，读取请使用 ExpiresAtTime / TTL / IsExpired
+	 */
 	ExpiresAt any `json:"expiresAt,omitempty"`
+	/* 渠道返回的原始过期时间（毫秒/秒时间戳或各种日期字符串），仅用于排查 */
+	ExpiresAtRaw any `json:"expiresAtRaw,omitempty"`
 	/* 邮箱创建时间（ISO 8601 字符串） */
 	CreatedAt string `json:"createdAt,omitempty"`
+	/* 创建该邮箱的 SDK 实例，后续请求沿用其代理与配置；nil 表示默认实例 */
+	inst *instance
 }
 
 /*
@@ -318,8 +326,10 @@ type EmailAttachment struct {
 	Size int64 `json:"size,omitempty"`
 	/* MIME 类型，如 application/pdf */
 	ContentType string `json:"contentType,omitempty"`
-	/* 附件下载地址 */
+	/* 附件下载地址，可能需要渠道会话凭据，应通过 DownloadAttachment 下载 */
 	URL string `json:"url,omitempty"`
+	/* 附件内容，渠道在邮件中内嵌附件（如 anonbox 的 mbox）时直接填充 */
+	Content []byte `json:"content,omitempty"`
 }
 
 /*
@@ -329,10 +339,18 @@ type EmailAttachment struct {
 type Email struct {
 	/* 邮件唯一标识 */
 	ID string `json:"id"`
-	/* 发件人邮箱地址 */
+	/* 发件人，渠道返回的原始字符串（可能带显示名称） */
 	From string `json:"from"`
-	/* 收件人邮箱地址 */
+	/* 收件人，渠道返回的原始字符串 */
 	To string `json:"to"`
+	/* 解析后的发件人（显示名称与地址） */
+	FromAddress Address `json:"fromAddress"`
+	/* 解析后的全部收件人 */
+	ToAddresses []Address `json:"toAddresses,omitempty"`
+	/* 抄送，渠道提供时填充 */
+	Cc []Address `json:"cc,omitempty"`
+	/* 回复地址（Reply-To），渠道提供时填充 */
+	ReplyTo []Address `json:"replyTo,omitempty"`
 	/* 邮件主题 */
 	Subject string `json:"subject"`
 	/* 纯文本内容 */
@@ -341,10 +359,19 @@ type Email struct {
 	HTML string `json:"html"`
 	/* ISO 8601 格式的日期字符串 */
 	Date string `json:"date"`
+	/* 解析后的接收时间，Date 无法解析时为零值 */
+	ReceivedAt time.Time `json:"receivedAt"`
 	/* 是否已读 */
 	IsRead bool `json:"isRead"`
 	/* 附件列表 */
 	Attachments []EmailAttachment `json:"attachments"`
+	/*
+	 * 完整邮件头（键为规范化形式，如 Message-Id、Reply-To、List-Unsubscribe、Authentication-Results），
+	 * 仅在渠道提供原始头部时填充（如 anonbox）；其余渠道可通过 GetRawEmail 获取原始源码
+	 */
+	Headers map[string][]string `json:"headers,omitempty"`
+	/* 仅含摘要（ListEmails 的 SummaryOnly 结果），Text 可能只是预览、HTML 与附件可能为空，完整内容通过 GetEmail 加载 */
+	Summary bool `json:"summary,omitempty"`
 }
 
 /*
@@ -360,6 +387,8 @@ type GetEmailsResult struct {
 	Emails []Email `json:"emails"`
 	/* 请求是否成功，false 表示重试耗尽后仍失败 */
 	Success bool `json:"success"`
+	/* 失败原因（Success 为 false 时非 nil），可用 errors.Is / errors.As 判断分类，如 ErrMailboxExpired */
+	Err error `json:"-"`
 }
 
 /*
@@ -388,6 +417,21 @@ type GenerateEmailOptions struct {
 	Suffix string
 	/* 多个目标域名筛选（如 ["outlook.com", "hotmail.com"]），仅尝试支持这些域名的渠道 */
 	Domains []string
+	/*
+	 * 同时竞速的渠道数，默认 1（逐个尝试）
+	 * 大于 1 时同时启动多个渠道（同一后端不会同时尝试），返回最先成功者并取消其余；
+	 * MaxChannelsTried、TotalTimeout 与后端熔断同样适用。已在服务端创建但落后的邮箱会被丢弃
+	 */
+	Parallelism int
+	/* 所需渠道能力（如 CapAttachments | CapHTML），仅尝试声明了全部这些能力的渠道 */
+	Require Capability
+	/*
+	 * 指定邮箱用户名（@ 前的部分），支持 {rand} / {rand:N} 随机占位符，如 "signup-{rand:6}"
+	 * 设置后仅尝试声明了 CapCustomLocalPart 的渠道
+	 */
+	LocalPart string
+	/* 用户名生成钩子，每次尝试渠道时调用，非 nil 时优先于 LocalPart */
+	LocalPartFunc func(channel Channel) string
 }
 
 /*
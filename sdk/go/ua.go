@@ -2,7 +2,6 @@ package tempemail
 
 import (
 	"math/rand"
-	"sync"
 
 	"github.com/bogdanfinn/tls-client/profiles"
 )
@@ -64,11 +63,6 @@ var browserConfigs = []BrowserConfig{
 	{profiles.Safari_IOS_18_5, "Mozilla/5.0 (iPhone; CPU iPhone OS 18_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/18.5 Mobile/15E148 Safari/604.1"},
 }
 
-var (
-	currentBrowser *BrowserConfig
-	browserMu      sync.RWMutex
-)
-
 /**
  * RandomBrowserConfig 从配置池中随机选取一个浏览器配置
  * @returns BrowserConfig 随机选取的 profile+UA 配对
@@ -78,31 +72,35 @@ func RandomBrowserConfig() BrowserConfig {
 }
 
 /**
- * GetCurrentBrowser 获取当前生效的浏览器配置
+ * GetCurrentBrowser 获取默认实例当前生效的浏览器配置
  * 如果尚未初始化，返回随机配置（不缓存）
  */
 func GetCurrentBrowser() BrowserConfig {
-	browserMu.RLock()
-	defer browserMu.RUnlock()
-	if currentBrowser != nil {
-		return *currentBrowser
-	}
-	return RandomBrowserConfig()
+	return defaultInstance.currentBrowserConfig()
 }
 
 /**
- * setCurrentBrowser 设置当前生效的浏览器配置（SDK 内部使用）
+ * GetCurrentUA 获取默认实例当前 TLS 客户端对应的 User-Agent
+ * 返回与当前 TLS 指纹匹配的 UA 字符串，确保 TLS 层和 HTTP 层指纹一致
  */
-func setCurrentBrowser(bc BrowserConfig) {
-	browserMu.Lock()
-	defer browserMu.Unlock()
-	currentBrowser = &bc
+func GetCurrentUA() string {
+	return GetCurrentBrowser().UA
+}
+
+func (in *instance) currentBrowserConfig() BrowserConfig {
+	in.clients.browserMu.RLock()
+	defer in.clients.browserMu.RUnlock()
+	if in.clients.currentBrowser != nil {
+		return *in.clients.currentBrowser
+	}
+	return RandomBrowserConfig()
 }
 
 /**
- * GetCurrentUA 获取当前 TLS 客户端对应的 User-Agent
- * 返回与当前 TLS 指纹匹配的 UA 字符串，确保 TLS 层和 HTTP 层指纹一致
+ * setCurrentBrowser 设置实例当前生效的浏览器配置（SDK 内部使用）
  */
-func GetCurrentUA() string {
-	return GetCurrentBrowser().UA
+func (in *instance) setCurrentBrowser(bc BrowserConfig) {
+	in.clients.browserMu.Lock()
+	defer in.clients.browserMu.Unlock()
+	in.clients.currentBrowser = &bc
 }
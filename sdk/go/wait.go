@@ -0,0 +1,211 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+/*
+ * EmailMatch 邮件匹配条件
+ * 所有非空条件须同时满足（AND）；全部为空时匹配任意邮件
+ * 字符串条件为不区分大小写的子串匹配
+ *
+ * 示例:
+ *   &EmailMatch{From: "noreply@github.com", Subject: "verify"}
+ *   &EmailMatch{BodyRegexp: regexp.MustCompile(`\b\d{6}\b`)}
+ */
+type EmailMatch struct {
+	/* 发件人包含 */
+	From string
+	/* 主题包含 */
+	Subject string
+	/* 正文（Text 或 HTML）包含 */
+	Body string
+	/* 主题正则 */
+	SubjectRegexp *regexp.Regexp
+	/* 正文（Text 或 HTML）正则 */
+	BodyRegexp *regexp.Regexp
+	/* 自定义谓词，返回 true 表示匹配 */
+	Func func(Email) bool
+}
+
+/* Matches 判断邮件是否满足全部条件，nil 视为匹配任意邮件 */
+func (m *EmailMatch) Matches(e Email) bool {
+	if m == nil {
+		return true
+	}
+	if m.From != "" && !containsFold(e.From, m.From) {
+		return false
+	}
+	if m.Subject != "" && !containsFold(e.Subject, m.Subject) {
+		return false
+	}
+	if m.Body != "" && !containsFold(e.Text, m.Body) && !containsFold(e.HTML, m.Body) {
+		return false
+	}
+	if m.SubjectRegexp != nil && !m.SubjectRegexp.MatchString(e.Subject) {
+		return false
+	}
+	if m.BodyRegexp != nil && !m.BodyRegexp.MatchString(e.Text) && !m.BodyRegexp.MatchString(e.HTML) {
+		return false
+	}
+	if m.Func != nil && !m.Func(e) {
+		return false
+	}
+	return true
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+/*
+ * WaitOptions 等待邮件的选项
+ *
+ * 示例:
+ *   email, err := WaitForEmail(ctx, info, WaitOptions{
+ *       Match:   &EmailMatch{Subject: "验证码"},
+ *       Timeout: 2 * time.Minute,
+ *   })
+ */
+type WaitOptions struct {
+	/* 匹配条件，nil 则返回第一封新邮件 */
+	Match *EmailMatch
+	/* 初始轮询间隔，默认 3s；收到新邮件后回落到该值 */
+	Interval time.Duration
+	/* 自适应轮询间隔上限，默认 30s */
+	MaxInterval time.Duration
+	/* 等待超时，0 表示仅受 ctx 控制 */
+	Timeout time.Duration
+	/* 为 true 时忽略首次拉取时已存在的邮件，只等待之后到达的邮件 */
+	SkipExisting bool
+	/* 单次拉取的重试配置，nil 则使用默认值 */
+	Retry *RetryOptions
+}
+
+const (
+	defaultWaitInterval    = 3 * time.Second
+	defaultWaitMaxInterval = 30 * time.Second
+)
+
+/*
+ * WaitForEmail 轮询收件箱，直到出现满足条件的新邮件
+ *
+ * 行为:
+ * - 按 Email.ID 去重，同一封邮件只判定一次（无 ID 时以发件人+主题+日期作为键）
+ * - 自适应间隔：无新邮件时间隔逐步放大至 MaxInterval，收到新邮件后回落到 Interval
+ * - GetEmails 返回 Success:false 视为暂时性失败，退避后继续轮询；
+ *   邮箱已过期（ErrMailboxExpired）或令牌无效（ErrInvalidToken）时立即返回该错误
+ * - ctx 取消或 Timeout 到期时返回的 error 可用 errors.Is 匹配 context.DeadlineExceeded / context.Canceled
+ */
+func WaitForEmail(ctx context.Context, info *EmailInfo, opts WaitOptions) (*Email, error) {
+	if info == nil {
+		return nil, fmt.Errorf("EmailInfo is required, call GenerateEmail() first")
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultWaitMaxInterval
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	seen := make(map[string]bool)
+	first := true
+	delay := interval
+	for {
+		result, err := GetEmailsContext(ctx, info, &GetEmailsOptions{Retry: opts.Retry})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, waitForEmailCtxErr(ctx)
+			}
+			return nil, err
+		}
+
+		if !result.Success {
+			if isMailboxGone(result.Err) {
+				return nil, fmt.Errorf("等待邮件失败：%w", result.Err)
+			}
+			instanceOf(info).log().Debug("等待邮件：本次拉取失败，退避后继续", "channel", string(info.Channel))
+			delay = minDuration(delay*2, maxInterval)
+		} else {
+			fresh := 0
+			for _, em := range result.Emails {
+				key := emailDedupeKey(em)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				fresh++
+				if first && opts.SkipExisting {
+					continue
+				}
+				if opts.Match.Matches(em) {
+					instanceOf(info).log().Info("等待邮件：命中", "channel", string(info.Channel), "id", em.ID)
+					matched := em
+					return &matched, nil
+				}
+			}
+			if fresh > 0 && !first {
+				delay = interval
+			} else {
+				delay = minDuration(delay*3/2, maxInterval)
+			}
+			first = false
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, waitForEmailCtxErr(ctx)
+		}
+	}
+}
+
+/* EmailInfo.WaitForEmail 等待当前邮箱收到满足条件的新邮件，见 WaitForEmail */
+func (info *EmailInfo) WaitForEmail(ctx context.Context, opts WaitOptions) (*Email, error) {
+	return WaitForEmail(ctx, info, opts)
+}
+
+/* Client.WaitForEmail 等待当前缓存邮箱收到满足条件的新邮件，必须先调用 Generate() */
+func (c *Client) WaitForEmail(ctx context.Context, opts WaitOptions) (*Email, error) {
+	info := c.GetEmailInfo()
+	if info == nil {
+		return nil, fmt.Errorf("no email generated. Call Generate() first")
+	}
+	return WaitForEmail(ctx, info, opts)
+}
+
+/* emailDedupeKey 邮件去重键，优先使用 ID */
+func emailDedupeKey(e Email) string {
+	if e.ID != "" {
+		return "id:" + e.ID
+	}
+	return "h:" + e.From + "\x00" + e.Subject + "\x00" + e.Date
+}
+
+func waitForEmailCtxErr(ctx context.Context) error {
+	err := ctx.Err()
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("等待邮件超时：%w", err)
+	}
+	return fmt.Errorf("等待邮件已取消：%w", err)
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
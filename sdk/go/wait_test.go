@@ -0,0 +1,191 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+)
+
+/* fakeInbox 按调用次数返回预设收件箱的离线渠道，超出预设后重复最后一次 */
+type fakeInbox struct {
+	mu    sync.Mutex
+	steps []func() ([]Email, error)
+	calls []time.Time
+}
+
+func (f *fakeInbox) get(ctx context.Context, email, token string) ([]Email, error) {
+	f.mu.Lock()
+	i := min(len(f.calls), len(f.steps)-1)
+	f.calls = append(f.calls, time.Now())
+	step := f.steps[i]
+	f.mu.Unlock()
+	return step()
+}
+
+func (f *fakeInbox) callTimes() []time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]time.Time(nil), f.calls...)
+}
+
+func inbox(emails ...Email) func() ([]Email, error) {
+	return func() ([]Email, error) { return emails, nil }
+}
+
+func failing(err error) func() ([]Email, error) {
+	return func() ([]Email, error) { return nil, err }
+}
+
+/* newWaitMailbox 注册离线渠道并返回绑定到独立实例的邮箱 */
+func newWaitMailbox(t *testing.T, f *fakeInbox) *EmailInfo {
+	t.Helper()
+	registerFakeChannel(t, ChannelSpec{Channel: "test-wait", GetEmails: f.get})
+	return &EmailInfo{Channel: "test-wait", Email: "me@test.invalid", inst: newOfflineClient().instance()}
+}
+
+/* TestEmailMatch 校验各匹配条件不区分大小写、正文同时匹配 Text 与 HTML、多个条件取交集 */
+func TestEmailMatch(t *testing.T) {
+	e := Email{From: "NoReply@GitHub.com", Subject: "Please Verify", Text: "", HTML: "<b>code 123456</b>"}
+	cases := []struct {
+		name  string
+		match *EmailMatch
+		want  bool
+	}{
+		{"nil 匹配任意邮件", nil, true},
+		{"空条件匹配任意邮件", &EmailMatch{}, true},
+		{"发件人", &EmailMatch{From: "noreply@github"}, true},
+		{"主题", &EmailMatch{Subject: "verify"}, true},
+		{"正文取 HTML", &EmailMatch{Body: "CODE"}, true},
+		{"主题正则", &EmailMatch{SubjectRegexp: regexp.MustCompile(`^Please`)}, true},
+		{"正文正则", &EmailMatch{BodyRegexp: regexp.MustCompile(`\b\d{6}\b`)}, true},
+		{"自定义谓词", &EmailMatch{Func: func(e Email) bool { return e.Subject != "" }}, true},
+		{"发件人不符", &EmailMatch{From: "gitlab"}, false},
+		{"多个条件取交集", &EmailMatch{From: "github", Subject: "reset"}, false},
+		{"正则不符", &EmailMatch{BodyRegexp: regexp.MustCompile(`\b\d{8}\b`)}, false},
+		{"谓词拒绝", &EmailMatch{Subject: "verify", Func: func(Email) bool { return false }}, false},
+	}
+	for _, c := range cases {
+		if got := c.match.Matches(e); got != c.want {
+			t.Errorf("%s: 期望 %v，实际 %v", c.name, c.want, got)
+		}
+	}
+}
+
+/* TestWaitForEmailDedup 校验同一封邮件只判定一次，无 ID 的邮件按发件人+主题+日期去重 */
+func TestWaitForEmailDedup(t *testing.T) {
+	a := Email{ID: "a", Subject: "welcome"}
+	b := Email{From: "x@y", Subject: "news", Date: "d1"}
+	c := Email{ID: "c", Subject: "your code"}
+	f := &fakeInbox{steps: []func() ([]Email, error){inbox(a), inbox(a, b), inbox(a, b, c)}}
+	info := newWaitMailbox(t, f)
+
+	judged := make(map[string]int)
+	got, err := WaitForEmail(context.Background(), info, WaitOptions{
+		Interval: time.Millisecond,
+		Match: &EmailMatch{Func: func(e Email) bool {
+			judged[emailDedupeKey(e)]++
+			return e.Subject == "your code"
+		}},
+	})
+	if err != nil || got == nil || got.ID != "c" {
+		t.Fatalf("期望命中邮件 c，实际 %+v %v", got, err)
+	}
+	if len(judged) != 3 {
+		t.Fatalf("期望判定 3 封不同邮件，实际 %v", judged)
+	}
+	for k, n := range judged {
+		if n != 1 {
+			t.Fatalf("邮件 %q 被判定 %d 次", k, n)
+		}
+	}
+}
+
+/* TestWaitForEmailSkipExisting 校验 SkipExisting 忽略首次拉取时已存在的邮件，只返回之后到达的邮件 */
+func TestWaitForEmailSkipExisting(t *testing.T) {
+	old := Email{ID: "old", Subject: "code 111111"}
+	fresh := Email{ID: "new", Subject: "code 222222"}
+	f := &fakeInbox{steps: []func() ([]Email, error){inbox(old), inbox(old), inbox(old, fresh)}}
+
+	got, err := WaitForEmail(context.Background(), newWaitMailbox(t, f), WaitOptions{Interval: time.Millisecond, SkipExisting: true})
+	if err != nil || got == nil || got.ID != "new" {
+		t.Fatalf("期望跳过已存在邮件并返回 new，实际 %+v %v", got, err)
+	}
+	if n := len(f.callTimes()); n != 3 {
+		t.Fatalf("期望拉取 3 次，实际 %d", n)
+	}
+
+	f2 := &fakeInbox{steps: []func() ([]Email, error){inbox(old)}}
+	got, err = WaitForEmail(context.Background(), newWaitMailbox(t, f2), WaitOptions{Interval: time.Millisecond})
+	if err != nil || got == nil || got.ID != "old" {
+		t.Fatalf("未设置 SkipExisting 时应返回已存在的邮件，实际 %+v %v", got, err)
+	}
+}
+
+/*
+ * TestWaitForEmailBackoff 校验 Success:false 时间隔翻倍且不超过 MaxInterval，
+ * 拉取恢复后仍能命中新邮件
+ */
+func TestWaitForEmailBackoff(t *testing.T) {
+	const interval, maxInterval = 20 * time.Millisecond, 50 * time.Millisecond
+	f := &fakeInbox{steps: []func() ([]Email, error){
+		failing(ErrCaptchaRequired),
+		failing(ErrCaptchaRequired),
+		failing(ErrCaptchaRequired),
+		inbox(Email{ID: "ok"}),
+	}}
+
+	got, err := WaitForEmail(context.Background(), newWaitMailbox(t, f), WaitOptions{Interval: interval, MaxInterval: maxInterval})
+	if err != nil || got == nil || got.ID != "ok" {
+		t.Fatalf("拉取恢复后应返回邮件，实际 %+v %v", got, err)
+	}
+	calls := f.callTimes()
+	if len(calls) != 4 {
+		t.Fatalf("期望拉取 4 次，实际 %d", len(calls))
+	}
+	gaps := []time.Duration{calls[1].Sub(calls[0]), calls[2].Sub(calls[1]), calls[3].Sub(calls[2])}
+	if gaps[0] < 2*interval || gaps[1] < maxInterval || gaps[2] < maxInterval {
+		t.Fatalf("失败后间隔应翻倍至上限，实际 %v", gaps)
+	}
+	if gaps[2] >= maxInterval+100*time.Millisecond {
+		t.Fatalf("间隔不应超过 MaxInterval，实际 %v", gaps)
+	}
+}
+
+/* TestWaitForEmailStopsWhenMailboxGone 校验邮箱过期或令牌无效时立即返回该错误，不再轮询 */
+func TestWaitForEmailStopsWhenMailboxGone(t *testing.T) {
+	for _, gone := range []error{ErrMailboxExpired, ErrInvalidToken} {
+		f := &fakeInbox{steps: []func() ([]Email, error){failing(gone)}}
+		_, err := WaitForEmail(context.Background(), newWaitMailbox(t, f), WaitOptions{Interval: time.Millisecond, Timeout: time.Second})
+		if !errors.Is(err, gone) {
+			t.Fatalf("期望返回 %v，实际 %v", gone, err)
+		}
+		if n := len(f.callTimes()); n != 1 {
+			t.Fatalf("%v: 期望只拉取 1 次，实际 %d", gone, n)
+		}
+	}
+}
+
+/* TestWaitForEmailContext 校验 Timeout 到期与 ctx 取消时返回对应的 ctx 错误 */
+func TestWaitForEmailContext(t *testing.T) {
+	f := &fakeInbox{steps: []func() ([]Email, error){inbox()}}
+	info := newWaitMailbox(t, f)
+
+	start := time.Now()
+	_, err := WaitForEmail(context.Background(), info, WaitOptions{Interval: 10 * time.Millisecond, Timeout: 50 * time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("期望超时错误，实际 %v", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Fatalf("超时后应立即返回，实际耗时 %v", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(30*time.Millisecond, cancel)
+	_, err = WaitForEmail(ctx, info, WaitOptions{Interval: 10 * time.Millisecond})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("期望取消错误，实际 %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+package tempemail
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+/*
+ * WatchOptions 监听收件箱的选项
+ *
+ * 示例:
+ *   ch, _ := Watch(ctx, info, &WatchOptions{SkipExisting: true})
+ *   for email := range ch {
+ *       fmt.Println(email.Subject)
+ *   }
+ */
+type WatchOptions struct {
+	/* 轮询型渠道的拉取间隔，默认 5s */
+	Interval time.Duration
+	/* 推送型渠道的兜底拉取间隔（补偿断线期间遗漏的邮件），默认 30s */
+	PushFallbackInterval time.Duration
+	/* 为 true 时不输出开始监听前已存在的邮件 */
+	SkipExisting bool
+	/* 输出通道缓冲大小，默认 16 */
+	Buffer int
+	/* 单次拉取的重试配置，nil 则使用默认值 */
+	Retry *RetryOptions
+}
+
+const (
+	defaultWatchInterval     = 5 * time.Second
+	defaultWatchPushFallback = 30 * time.Second
+	defaultWatchBuffer       = 16
+)
+
+/*
+ * Watch 以事件流形式监听收件箱，每封邮件只输出一次（按 Email.ID 去重）
+ *
+ * 渠道支持推送（vip-215 WebSocket、mjj-cm / linshi-co Socket.IO）时订阅原生推送，
+ * 新邮件到达即刻拉取并输出，另以 PushFallbackInterval 兜底拉取；
+ * 其余渠道由 SDK 按 Interval 托管轮询。推送订阅失败时自动回退为轮询。
+ *
+ * 返回的通道在 ctx 取消后关闭；拉取失败（Success:false）视为暂时性错误，继续监听，
+ * 但邮箱已过期（ErrMailboxExpired）或令牌无效（ErrInvalidToken）时停止监听并关闭通道。
+ * 仅参数校验失败时返回 error。
+ */
+func Watch(ctx context.Context, info *EmailInfo, opts *WatchOptions) (<-chan Email, error) {
+	if info == nil {
+		return nil, fmt.Errorf("EmailInfo is required, call GenerateEmail() first")
+	}
+	spec, ok := channelRegistryMap[info.Channel]
+	if !ok || spec.GetEmails == nil {
+		return nil, fmt.Errorf("unsupported channel: %s", info.Channel)
+	}
+	if opts == nil {
+		opts = &WatchOptions{}
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	buffer := opts.Buffer
+	if buffer <= 0 {
+		buffer = defaultWatchBuffer
+	}
+
+	var notify <-chan struct{}
+	var unsubscribe func()
+	if spec.Subscribe != nil {
+		ch, cancel, err := spec.Subscribe(ctx, info.Email, info.token)
+		if err != nil {
+			instanceOf(info).log().Warn("推送订阅失败，回退为轮询", "channel", string(info.Channel), "error", err.Error())
+		} else {
+			notify, unsubscribe = ch, cancel
+			interval = opts.PushFallbackInterval
+			if interval <= 0 {
+				interval = defaultWatchPushFallback
+			}
+			instanceOf(info).log().Debug("已订阅推送", "channel", string(info.Channel), "email", info.Email)
+		}
+	}
+
+	out := make(chan Email, buffer)
+	go watchLoop(ctx, info, opts, interval, notify, unsubscribe, out)
+	return out, nil
+}
+
+/*
+ * watchLoop 监听主循环：定时器或推送信号触发一次拉取，新邮件写入 out
+ * notify 为 nil 时 select 永不命中该分支，即退化为纯轮询
+ */
+func watchLoop(ctx context.Context, info *EmailInfo, opts *WatchOptions, interval time.Duration, notify <-chan struct{}, unsubscribe func(), out chan<- Email) {
+	defer close(out)
+	if unsubscribe != nil {
+		defer unsubscribe()
+	}
+
+	seen := make(map[string]bool)
+	first := true
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case _, ok := <-notify:
+			if !ok {
+				notify = nil
+				continue
+			}
+			timer.Stop()
+		}
+
+		result, err := GetEmailsContext(ctx, info, &GetEmailsOptions{Retry: opts.Retry})
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			instanceOf(info).log().Warn("监听收件箱：拉取出错", "channel", string(info.Channel), "error", err.Error())
+		} else if !result.Success && isMailboxGone(result.Err) {
+			instanceOf(info).log().Warn("监听收件箱：邮箱已失效，停止监听", "channel", string(info.Channel), "error", result.Err.Error())
+			return
+		} else if result.Success {
+			for _, em := range result.Emails {
+				key := emailDedupeKey(em)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				if first && opts.SkipExisting {
+					continue
+				}
+				select {
+				case out <- em:
+				case <-ctx.Done():
+					return
+				}
+			}
+			first = false
+		}
+		timer.Reset(interval)
+	}
+}
+
+/* EmailInfo.Watch 监听当前邮箱，见 Watch */
+func (info *EmailInfo) Watch(ctx context.Context, opts *WatchOptions) (<-chan Email, error) {
+	return Watch(ctx, info, opts)
+}
+
+/* Client.Watch 监听当前缓存的邮箱，必须先调用 Generate() */
+func (c *Client) Watch(ctx context.Context, opts *WatchOptions) (<-chan Email, error) {
+	info := c.GetEmailInfo()
+	if info == nil {
+		return nil, fmt.Errorf("no email generated. Call Generate() first")
+	}
+	return Watch(ctx, info, opts)
+}
@@ -0,0 +1,204 @@
+package tempemail
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/* liveInbox 内容可在测试中随时修改的离线收件箱 */
+type liveInbox struct {
+	mu     sync.Mutex
+	emails []Email
+	err    error
+	calls  atomic.Int32
+}
+
+func (l *liveInbox) get(ctx context.Context, email, token string) ([]Email, error) {
+	l.calls.Add(1)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Email(nil), l.emails...), l.err
+}
+
+func (l *liveInbox) set(err error, emails ...Email) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.emails, l.err = emails, err
+}
+
+/* fakePush 离线推送订阅：signal 模拟服务端推送，记录订阅是否已取消 */
+type fakePush struct {
+	ch        chan struct{}
+	cancelled atomic.Bool
+}
+
+func newFakePush() *fakePush { return &fakePush{ch: make(chan struct{}, 1)} }
+
+func (p *fakePush) subscribe(ctx context.Context, email, token string) (<-chan struct{}, func(), error) {
+	return p.ch, func() { p.cancelled.Store(true) }, nil
+}
+
+func (p *fakePush) signal() {
+	select {
+	case p.ch <- struct{}{}:
+	default:
+	}
+}
+
+/* newWatchMailbox 注册离线渠道（subscribe 为 nil 表示轮询型）并返回绑定到独立实例的邮箱 */
+func newWatchMailbox(t *testing.T, l *liveInbox, subscribe func(context.Context, string, string) (<-chan struct{}, func(), error)) *EmailInfo {
+	t.Helper()
+	registerFakeChannel(t, ChannelSpec{Channel: "test-watch", GetEmails: l.get, Subscribe: subscribe})
+	return &EmailInfo{Channel: "test-watch", Email: "me@test.invalid", inst: newOfflineClient().instance()}
+}
+
+/* recvEmail 在 d 内从 ch 读取一封邮件 */
+func recvEmail(t *testing.T, ch <-chan Email, d time.Duration) Email {
+	t.Helper()
+	select {
+	case e, ok := <-ch:
+		if !ok {
+			t.Fatal("通道意外关闭")
+		}
+		return e
+	case <-time.After(d):
+		t.Fatalf("%v 内未收到邮件", d)
+	}
+	return Email{}
+}
+
+/* expectClosed 断言 ch 在 d 内关闭且之前不再输出邮件 */
+func expectClosed(t *testing.T, ch <-chan Email, d time.Duration) {
+	t.Helper()
+	select {
+	case e, ok := <-ch:
+		if ok {
+			t.Fatalf("期望通道关闭，收到邮件 %+v", e)
+		}
+	case <-time.After(d):
+		t.Fatalf("%v 内通道未关闭", d)
+	}
+}
+
+/* TestWatchPushTriggersFetch 校验推送信号立即触发拉取，而不等待兜底间隔 */
+func TestWatchPushTriggersFetch(t *testing.T) {
+	l := &liveInbox{}
+	push := newFakePush()
+	info := newWatchMailbox(t, l, push.subscribe)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := Watch(ctx, info, &WatchOptions{Interval: time.Millisecond, PushFallbackInterval: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for l.calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	l.set(nil, Email{ID: "pushed"})
+	push.signal()
+	if e := recvEmail(t, ch, time.Second); e.ID != "pushed" {
+		t.Fatalf("期望收到 pushed，实际 %+v", e)
+	}
+	if n := l.calls.Load(); n != 2 {
+		t.Fatalf("推送型渠道不应按 Interval 轮询，拉取 %d 次", n)
+	}
+}
+
+/* TestWatchFallsBackToPolling 校验渠道无推送或订阅失败时按 Interval 轮询 */
+func TestWatchFallsBackToPolling(t *testing.T) {
+	broken := func(ctx context.Context, email, token string) (<-chan struct{}, func(), error) {
+		return nil, nil, errors.New("subscribe failed")
+	}
+	for name, subscribe := range map[string]func(context.Context, string, string) (<-chan struct{}, func(), error){
+		"无推送":  nil,
+		"订阅失败": broken,
+	} {
+		l := &liveInbox{}
+		info := newWatchMailbox(t, l, subscribe)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch, err := Watch(ctx, info, &WatchOptions{Interval: 10 * time.Millisecond, PushFallbackInterval: time.Hour})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		l.set(nil, Email{ID: "polled"})
+		if e := recvEmail(t, ch, time.Second); e.ID != "polled" {
+			t.Fatalf("%s: 期望轮询到 polled，实际 %+v", name, e)
+		}
+		cancel()
+	}
+}
+
+/* TestWatchDedupAcrossPushAndPoll 校验推送触发与兜底轮询拉到的同一封邮件只输出一次，SkipExisting 跳过已有邮件 */
+func TestWatchDedupAcrossPushAndPoll(t *testing.T) {
+	l := &liveInbox{}
+	l.set(nil, Email{ID: "old"})
+	push := newFakePush()
+	info := newWatchMailbox(t, l, push.subscribe)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := Watch(ctx, info, &WatchOptions{PushFallbackInterval: 5 * time.Millisecond, SkipExisting: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for l.calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	l.set(nil, Email{ID: "old"}, Email{ID: "a"})
+	push.signal()
+	if e := recvEmail(t, ch, time.Second); e.ID != "a" {
+		t.Fatalf("期望收到 a，实际 %+v", e)
+	}
+	deadline := time.Now().Add(100 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		push.signal()
+		select {
+		case e := <-ch:
+			t.Fatalf("重复输出邮件 %+v", e)
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+	if l.calls.Load() < 4 {
+		t.Fatalf("期望推送与兜底轮询多次拉取，实际 %d 次", l.calls.Load())
+	}
+	l.set(nil, Email{ID: "old"}, Email{ID: "a"}, Email{ID: "b"})
+	if e := recvEmail(t, ch, time.Second); e.ID != "b" {
+		t.Fatalf("期望收到 b，实际 %+v", e)
+	}
+}
+
+/* TestWatchCloses 校验 ctx 取消与邮箱失效时关闭通道并取消推送订阅 */
+func TestWatchCloses(t *testing.T) {
+	l := &liveInbox{}
+	push := newFakePush()
+	info := newWatchMailbox(t, l, push.subscribe)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := Watch(ctx, info, &WatchOptions{PushFallbackInterval: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	expectClosed(t, ch, time.Second)
+	if !push.cancelled.Load() {
+		t.Fatal("ctx 取消后应取消推送订阅")
+	}
+
+	for _, gone := range []error{ErrMailboxExpired, ErrInvalidToken} {
+		l := &liveInbox{}
+		l.set(gone)
+		info := newWatchMailbox(t, l, nil)
+		ch, err := Watch(context.Background(), info, &WatchOptions{Interval: time.Millisecond})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectClosed(t, ch, time.Second)
+		if n := l.calls.Load(); n != 1 {
+			t.Fatalf("%v: 邮箱失效后不应继续拉取，实际 %d 次", gone, n)
+		}
+	}
+}
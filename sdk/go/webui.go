@@ -137,10 +137,10 @@ func (h *WebUIHandler) WithGroup(name string) slog.Handler {
 	return &WebUIHandler{inner: h.inner.WithGroup(name)}
 }
 
-/* wrapLoggerForWebUI 将当前 sdkLogger 包装为 WebUIHandler tee 模式 */
+/* wrapLoggerForWebUI 将默认实例的 logger 包装为 WebUIHandler tee 模式 */
 func wrapLoggerForWebUI() {
-	handler := &WebUIHandler{inner: sdkLogger.Handler()}
-	sdkLogger = slog.New(handler).With("module", "tempmail-sdk")
+	handler := &WebUIHandler{inner: defaultInstance.log().Handler()}
+	defaultInstance.setLogger(slog.New(handler).With("module", "tempmail-sdk"))
 }
 
 /*
@@ -172,7 +172,7 @@ func StartWebUIOn(host, port string) {
 
 		ln, err := net.Listen("tcp", addr)
 		if err != nil {
-			sdkLogger.Error("WebUI 监听失败", "error", err.Error())
+			defaultInstance.log().Error("WebUI 监听失败", "error", err.Error())
 			return
 		}
 
@@ -187,10 +187,10 @@ func StartWebUIOn(host, port string) {
 		mux.HandleFunc("/api/channels", webuiHandleChannels)
 		mux.HandleFunc("/api/logs/stream", webuiHandleSSE)
 
-		sdkLogger.Info("WebUI 已启动", "host", host, "port", webuiActualPort)
+		defaultInstance.log().Info("WebUI 已启动", "host", host, "port", webuiActualPort)
 		go func() {
 			if err := http.Serve(ln, mux); err != nil {
-				sdkLogger.Error("WebUI 服务退出", "error", err.Error())
+				defaultInstance.log().Error("WebUI 服务退出", "error", err.Error())
 			}
 		}()
 	})